@@ -392,6 +392,42 @@ func BenchmarkLinkedListRemove(b *testing.B) {
 	}
 }
 
+// Removing every other element by index walks the list for each removal,
+// while the list iterator removes at its cursor.
+func BenchmarkLinkedListRemoveEvensByIndex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list := lists.NewLinkedList[int]()
+		for j := 0; j < MediumSize; j++ {
+			list.Add(j)
+		}
+		b.StartTimer()
+
+		for j := 0; j < list.Size(); j++ {
+			list.RemoveAtIndex(j)
+		}
+	}
+}
+
+func BenchmarkLinkedListRemoveEvensByListIterator(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list := lists.NewLinkedList[int]()
+		for j := 0; j < MediumSize; j++ {
+			list.Add(j)
+		}
+		b.StartTimer()
+
+		iterator, _ := list.ListIterator(0)
+		for iterator.HasNext() {
+			val, _ := iterator.Next()
+			if *val%2 == 0 {
+				iterator.Remove()
+			}
+		}
+	}
+}
+
 func BenchmarkLinkedListContains(b *testing.B) {
 	list := lists.NewLinkedList[int]()
 	// Pre-populate with data
@@ -713,3 +749,49 @@ func BenchmarkLinkedListPop(b *testing.B) {
 		}
 	}
 }
+
+// Unsynchronized List Benchmarks
+
+func BenchmarkUnsynchronizedArrayListAdd(b *testing.B) {
+	list := lists.NewUnsynchronizedArrayList[int]()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		list.Add(i)
+	}
+}
+
+func BenchmarkUnsynchronizedArrayListGet(b *testing.B) {
+	list := lists.NewUnsynchronizedArrayList[int]()
+	// Pre-populate with data
+	for i := 0; i < MediumSize; i++ {
+		list.Add(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Get(i % MediumSize)
+	}
+}
+
+func BenchmarkUnsynchronizedLinkedListAdd(b *testing.B) {
+	list := lists.NewUnsynchronizedLinkedList[int]()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		list.Add(i)
+	}
+}
+
+func BenchmarkUnsynchronizedLinkedListGet(b *testing.B) {
+	list := lists.NewUnsynchronizedLinkedList[int]()
+	// Pre-populate with data
+	for i := 0; i < MediumSize; i++ {
+		list.Add(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Get(i % MediumSize)
+	}
+}
@@ -452,6 +452,127 @@ func BenchmarkTreeMapRemove(b *testing.B) {
 	}
 }
 
+// Parallel Benchmarks (write-heavy: three puts for every get)
+
+func BenchmarkHashMapParallelPutGet(b *testing.B) {
+	hashMap := maps.NewHashMap[int, int]()
+	benchmarkParallelPutGet(b, hashMap.Put, func(key int) { hashMap.Get(key) })
+}
+
+func BenchmarkConcurrentHashMapParallelPutGet(b *testing.B) {
+	concurrentMap := maps.NewConcurrentHashMap[int, int]()
+	benchmarkParallelPutGet(b, concurrentMap.Put, func(key int) { concurrentMap.Get(key) })
+}
+
+func BenchmarkHashMapParallelMerge(b *testing.B) {
+	hashMap := maps.NewHashMap[int, int]()
+	benchmarkParallelMerge(b, hashMap.Merge)
+}
+
+func BenchmarkConcurrentHashMapParallelMerge(b *testing.B) {
+	concurrentMap := maps.NewConcurrentHashMap[int, int]()
+	benchmarkParallelMerge(b, concurrentMap.Merge)
+}
+
+// Read-only parallel benchmarks over a pre-populated map
+
+func BenchmarkHashMapParallelGet(b *testing.B) {
+	hashMap := maps.NewHashMap[int, int]()
+	benchmarkParallelGet(b, hashMap.Put, func(key int) { hashMap.Get(key) })
+}
+
+func BenchmarkConcurrentHashMapParallelGet(b *testing.B) {
+	concurrentMap := maps.NewConcurrentHashMap[int, int]()
+	benchmarkParallelGet(b, concurrentMap.Put, func(key int) { concurrentMap.Get(key) })
+}
+
+func benchmarkParallelPutGet(b *testing.B, put func(int, int) int, get func(int)) {
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := i % LargeSize
+			if i%4 == 0 {
+				get(key)
+			} else {
+				put(key, i)
+			}
+			i++
+		}
+	})
+}
+
+func benchmarkParallelMerge(b *testing.B, merge func(int, int, func(int, int) (int, bool)) (*int, error)) {
+	sum := func(old, value int) (int, bool) { return old + value, true }
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			_, _ = merge(i%MediumSize, 1, sum)
+			i++
+		}
+	})
+}
+
+func benchmarkParallelGet(b *testing.B, put func(int, int) int, get func(int)) {
+	for i := 0; i < LargeSize; i++ {
+		put(i, i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			get(i % LargeSize)
+			i++
+		}
+	})
+}
+
+// Unsynchronized HashMap Benchmarks
+
+func BenchmarkUnsynchronizedHashMapPut(b *testing.B) {
+	hashMap := maps.NewHashMap[string, int](maps.Unsynchronized())
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		key := string(rune(i%26 + 'a'))
+		hashMap.Put(key, i)
+	}
+}
+
+func BenchmarkUnsynchronizedHashMapGet(b *testing.B) {
+	hashMap := maps.NewHashMap[string, int](maps.Unsynchronized())
+	// Pre-populate with data
+	for i := 0; i < MediumSize; i++ {
+		key := string(rune(i%26 + 'a'))
+		hashMap.Put(key, i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		key := string(rune(i%26 + 'a'))
+		hashMap.Get(key)
+	}
+}
+
+func BenchmarkUnsynchronizedHashMapRemove(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Create a fresh map for each iteration
+		hashMap := maps.NewHashMap[string, int](maps.Unsynchronized())
+		// Pre-populate with data
+		for j := 0; j < MediumSize; j++ {
+			key := string(rune(j%26 + 'a'))
+			hashMap.Put(key, j)
+		}
+		// Remove all elements
+		for j := 0; j < MediumSize; j++ {
+			key := string(rune(j%26 + 'a'))
+			hashMap.Remove(key)
+		}
+	}
+}
+
 // StringComparator for TreeMap
 type StringComparator struct{}
 
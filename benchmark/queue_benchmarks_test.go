@@ -118,10 +118,49 @@ func BenchmarkStackPeek(b *testing.B) {
 	}
 }
 
-// LinkedList as Queue Benchmarks
+func BenchmarkUnsynchronizedStackPush(b *testing.B) {
+	stack := lists.NewUnsynchronizedStack[int]()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stack.Push(i)
+	}
+}
+
+func BenchmarkUnsynchronizedStackPop(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Create a fresh stack for each iteration
+		stack := lists.NewUnsynchronizedStack[int]()
+		// Pre-populate with data
+		for j := 0; j < MediumSize; j++ {
+			stack.Push(j)
+		}
+		// Pop all elements
+		for stack.Size() > 0 {
+			stack.Pop()
+		}
+	}
+}
+
+func BenchmarkUnsynchronizedStackPeek(b *testing.B) {
+	stack := lists.NewUnsynchronizedStack[int]()
+	// Pre-populate with data
+	for i := 0; i < MediumSize; i++ {
+		stack.Push(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stack.Peek()
+	}
+}
+
+// LinkedList as Queue Benchmarks (the ArrayDeque Benchmarks below repeat these cases)
 
 func BenchmarkLinkedListAsQueueOffer(b *testing.B) {
 	queue := lists.NewLinkedList[int]()
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -130,6 +169,7 @@ func BenchmarkLinkedListAsQueueOffer(b *testing.B) {
 }
 
 func BenchmarkLinkedListAsQueuePoll(b *testing.B) {
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		// Create a fresh queue for each iteration
@@ -152,12 +192,130 @@ func BenchmarkLinkedListAsQueuePeek(b *testing.B) {
 		queue.Offer(i)
 	}
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		queue.Peek()
 	}
 }
 
+// ArrayDeque Benchmarks
+
+func BenchmarkArrayDequeOffer(b *testing.B) {
+	deque := queues.NewArrayDeque[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		deque.Offer(i)
+	}
+}
+
+func BenchmarkArrayDequePoll(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Create a fresh deque for each iteration
+		deque := queues.NewArrayDeque[int]()
+		// Pre-populate with data
+		for j := 0; j < MediumSize; j++ {
+			deque.Offer(j)
+		}
+		// Poll all elements
+		for deque.Size() > 0 {
+			deque.Poll()
+		}
+	}
+}
+
+func BenchmarkArrayDequePeek(b *testing.B) {
+	deque := queues.NewArrayDeque[int]()
+	// Pre-populate with data
+	for i := 0; i < MediumSize; i++ {
+		deque.Offer(i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		deque.Peek()
+	}
+}
+
+// Steady-state comparisons between LinkedList and ArrayDeque used as a queue and as a stack
+
+func BenchmarkLinkedListAsQueueOfferPoll(b *testing.B) {
+	queue := lists.NewLinkedList[int]()
+	for i := 0; i < MediumSize; i++ {
+		queue.Offer(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		queue.Offer(i)
+		queue.Poll()
+	}
+}
+
+func BenchmarkArrayDequeOfferPoll(b *testing.B) {
+	deque := queues.NewArrayDeque[int]()
+	for i := 0; i < MediumSize; i++ {
+		deque.Offer(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		deque.Offer(i)
+		deque.Poll()
+	}
+}
+
+func BenchmarkLinkedListPushPop(b *testing.B) {
+	stack := lists.NewLinkedList[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stack.Push(i)
+		stack.Pop()
+	}
+}
+
+func BenchmarkArrayDequePushPop(b *testing.B) {
+	stack := queues.NewArrayDeque[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stack.Push(i)
+		stack.Pop()
+	}
+}
+
+func BenchmarkLinkedListOfferFirstPollLast(b *testing.B) {
+	deque := lists.NewLinkedList[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		deque.OfferFirst(i)
+		deque.PollLast()
+	}
+}
+
+func BenchmarkArrayDequeOfferFirstPollLast(b *testing.B) {
+	deque := queues.NewArrayDeque[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		deque.OfferFirst(i)
+		deque.PollLast()
+	}
+}
+
 // IntComparator for PriorityQueue
 type IntComparator struct{}
 
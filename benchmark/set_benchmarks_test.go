@@ -476,3 +476,40 @@ func BenchmarkLinkedHashSetIterator(b *testing.B) {
 		}
 	}
 }
+
+// Unsynchronized HashSet Benchmarks
+
+func BenchmarkUnsynchronizedHashSetAdd(b *testing.B) {
+	set := sets.NewUnsynchronizedHashSet[int]()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		set.Add(i)
+	}
+}
+
+func BenchmarkUnsynchronizedHashSetContains(b *testing.B) {
+	set := sets.NewUnsynchronizedHashSet[int]()
+	// Pre-populate with data
+	for i := 0; i < MediumSize; i++ {
+		set.Add(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set.Contains(i % MediumSize)
+	}
+}
+
+func BenchmarkUnsynchronizedHashSetRemove(b *testing.B) {
+	set := sets.NewUnsynchronizedHashSet[int]()
+	// Pre-populate with data
+	for i := 0; i < MediumSize; i++ {
+		set.Add(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set.Remove(i % MediumSize)
+	}
+}
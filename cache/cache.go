@@ -0,0 +1,625 @@
+// Package cache provides bounded and expiring maps for caching: a least recently used
+// cache, bounded by number of entries or by total weight, a least frequently used cache,
+// and a cache whose entries expire after a time to live.
+//
+// Every cache is a collections.Map, so it can be used wherever a map is expected. Get is
+// the read that counts: it records hits and misses and, for LRU and LFU caches, the use
+// that decides which entry is evicted next. HasKey, HasValue and iteration do not.
+//
+// All caches are safe for concurrent use. Expired entries are removed lazily by the
+// operations on the cache and, if StartCleanup has been called, in the background.
+package cache
+
+import (
+	"container/heap"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/equality"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/chiranjeevipavurala/gocollections/sets"
+)
+
+// EvictionReason tells an OnEvict callback why an entry left the cache.
+type EvictionReason int
+
+const (
+	// Capacity means the entry was evicted to make room for others.
+	Capacity EvictionReason = iota
+	// Expired means the entry's time to live ran out.
+	Expired
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case Capacity:
+		return "Capacity"
+	case Expired:
+		return "Expired"
+	}
+	return "Unknown"
+}
+
+// Stats counts how a cache has been used since it was created.
+type Stats struct {
+	Hits        uint64 // Calls to Get that found the key
+	Misses      uint64 // Calls to Get that did not
+	Evictions   uint64 // Entries evicted to make room for others
+	Expirations uint64 // Entries removed because their time to live ran out
+}
+
+// HitRate returns the fraction of calls to Get that were hits, or 0 if there were none.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// entry is a cached key-value pair. The links are used by the eviction policy.
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	weight    int64
+	expires   time.Time // Zero if the entry does not expire
+	heapIndex int       // Position in the expiry heap, or -1
+	prev      *entry[K, V]
+	next      *entry[K, V]
+	bucket    *bucket[K, V] // Frequency bucket of an LFU cache
+}
+
+// policy decides which entry to evict when a cache is over its capacity.
+type policy[K comparable, V any] interface {
+	// add starts tracking a new entry.
+	add(e *entry[K, V])
+	// access records a use of e.
+	access(e *entry[K, V])
+	// remove stops tracking e.
+	remove(e *entry[K, V])
+	// victim returns the entry to evict next, or nil if there is none.
+	victim() *entry[K, V]
+	// each calls visit for every entry, from the next to be evicted to the last.
+	each(visit func(e *entry[K, V]))
+	// clear stops tracking every entry.
+	clear()
+}
+
+// evicted is an entry waiting to be passed to the OnEvict callback.
+type evicted[K comparable, V any] struct {
+	key    K
+	value  V
+	reason EvictionReason
+}
+
+// Cache is a map that evicts entries when it grows past its capacity and removes entries
+// whose time to live has run out. Create one with NewLRU, NewWeightedLRU, NewLFU or NewTTL.
+type Cache[K comparable, V any] struct {
+	entries   map[K]*entry[K, V]
+	policy    policy[K, V] // Nil if the cache is unbounded
+	weigher   func(key K, value V) int64
+	maxWeight int64
+	weight    int64
+	ttl       time.Duration // Time to live of entries added by Put; 0 if they do not expire
+	expiry    expiryHeap[K, V]
+	clock     Clock
+	onEvict   func(key K, value V, reason EvictionReason)
+	pending   []evicted[K, V] // Evictions to report once the lock is released
+	stats     Stats
+	mu        sync.Mutex // Not a read-write mutex, because Get updates the policy
+}
+
+func newCache[K comparable, V any](p policy[K, V], maxWeight int64, weigher func(K, V) int64, ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		entries:   make(map[K]*entry[K, V]),
+		policy:    p,
+		weigher:   weigher,
+		maxWeight: maxWeight,
+		ttl:       ttl,
+		clock:     SystemClock(),
+	}
+}
+
+// SetOnEvict sets the function called with each entry that is evicted or expires. It is
+// not called for entries removed by Remove, Clear or similar methods. It runs without the
+// cache's lock held, after the operation that evicted the entry, so it may use the cache.
+func (c *Cache[K, V]) SetOnEvict(onEvict func(key K, value V, reason EvictionReason)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onEvict = onEvict
+}
+
+// SetClock sets the clock that decides when entries expire, which by default is the
+// system clock. Entries added earlier keep the expiry time they were given.
+func (c *Cache[K, V]) SetClock(clock Clock) {
+	if clock == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clock = clock
+}
+
+// Stats returns the hit, miss, eviction and expiration counts of the cache.
+func (c *Cache[K, V]) Stats() Stats {
+	defer c.lock()()
+
+	return c.stats
+}
+
+// Weight returns the total weight of the entries in the cache. Unless the cache was
+// created by NewWeightedLRU, every entry weighs 1.
+func (c *Cache[K, V]) Weight() int64 {
+	defer c.lock()()
+
+	return c.weight
+}
+
+func (c *Cache[K, V]) Clear() {
+	defer c.lock()()
+
+	c.entries = make(map[K]*entry[K, V])
+	if c.policy != nil {
+		c.policy.clear()
+	}
+	c.expiry = nil
+	c.weight = 0
+}
+
+func (c *Cache[K, V]) HasKey(key K) bool {
+	defer c.lock()()
+
+	_, ok := c.entries[key]
+	return ok
+}
+
+func (c *Cache[K, V]) HasValue(value V) bool {
+	defer c.lock()()
+
+	for _, e := range c.entries {
+		if equality.Equal(e.value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// EntrySet returns a snapshot of the entries in the cache.
+func (c *Cache[K, V]) EntrySet() collections.Set[collections.MapEntry[K, V]] {
+	pairs := c.snapshot()
+	entries := make([]collections.MapEntry[K, V], len(pairs))
+	for i, p := range pairs {
+		entries[i] = collections.NewHashMapEntry(p.key, p.value)
+	}
+
+	set := sets.NewHashSet[collections.MapEntry[K, V]]()
+	if hashSet, ok := set.(*sets.HashSet[collections.MapEntry[K, V]]); ok {
+		hashSet.AddAllBatch(entries)
+	}
+	return set
+}
+
+// Equals reports whether obj is a map with the same entries. It looks the entries up with
+// the other map's Get, so when obj is a cache, this counts as a use of its entries.
+func (c *Cache[K, V]) Equals(obj any) bool {
+	if obj == nil {
+		return false
+	}
+	mapObj, ok := obj.(collections.Map[K, V])
+	if !ok {
+		return false
+	}
+
+	// Compare a snapshot, so that the lock is not held while calling the other map
+	pairs := c.snapshot()
+	if len(pairs) != mapObj.Size() {
+		return false
+	}
+	for _, p := range pairs {
+		value := mapObj.Get(p.key)
+		if value == nil || !equality.Equal(*value, p.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns a pointer to a copy of the value mapped to key, or nil if the cache does not
+// contain it. It counts as a hit or a miss and as a use of the entry.
+func (c *Cache[K, V]) Get(key K) *V {
+	defer c.lock()()
+
+	e, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil
+	}
+	c.stats.Hits++
+	if c.policy != nil {
+		c.policy.access(e)
+	}
+	value := e.value
+	return &value
+}
+
+func (c *Cache[K, V]) IsEmpty() bool {
+	defer c.lock()()
+
+	return len(c.entries) == 0
+}
+
+// KeySet returns a snapshot of the keys in the cache.
+func (c *Cache[K, V]) KeySet() collections.Set[K] {
+	pairs := c.snapshot()
+	keys := make([]K, len(pairs))
+	for i, p := range pairs {
+		keys[i] = p.key
+	}
+
+	set := sets.NewHashSet[K]()
+	if hashSet, ok := set.(*sets.HashSet[K]); ok {
+		hashSet.AddAllBatch(keys)
+	}
+	return set
+}
+
+// Put maps key to value, with the cache's default time to live, and returns the previous
+// value, or the zero value if there was none. It may evict other entries.
+func (c *Cache[K, V]) Put(key K, value V) V {
+	defer c.lock()()
+
+	oldValue, _ := c.put(key, value, c.ttl)
+	return oldValue
+}
+
+// PutWithTTL maps key to value until ttl has passed, and returns the previous value, or the
+// zero value if there was none. An entry with a ttl of 0 or less does not expire.
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) V {
+	defer c.lock()()
+
+	oldValue, _ := c.put(key, value, ttl)
+	return oldValue
+}
+
+func (c *Cache[K, V]) PutAll(m collections.Map[K, V]) {
+	if m == nil {
+		return
+	}
+
+	// Get all entries first to minimize lock time
+	var pairs []pair[K, V]
+	for k, v := range m.All() {
+		pairs = append(pairs, pair[K, V]{key: k, value: v})
+	}
+
+	defer c.lock()()
+	for _, p := range pairs {
+		c.put(p.key, p.value, c.ttl)
+	}
+}
+
+// PutIfAbsent maps key to value if the cache does not contain key, and returns the zero
+// value. Otherwise it returns the current value, which counts as a use of the entry.
+func (c *Cache[K, V]) PutIfAbsent(key K, value V) V {
+	defer c.lock()()
+
+	if e, ok := c.entries[key]; ok {
+		if c.policy != nil {
+			c.policy.access(e)
+		}
+		return e.value
+	}
+	c.put(key, value, c.ttl)
+	var zero V
+	return zero
+}
+
+func (c *Cache[K, V]) Remove(key K) V {
+	defer c.lock()()
+
+	e, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero
+	}
+	c.remove(e)
+	return e.value
+}
+
+func (c *Cache[K, V]) RemoveKeyWithValue(key K, value V) bool {
+	defer c.lock()()
+
+	e, ok := c.entries[key]
+	if !ok || !equality.Equal(e.value, value) {
+		return false
+	}
+	c.remove(e)
+	return true
+}
+
+// Replace maps key to value, with the cache's default time to live, only if the cache
+// already contains key, and returns the previous value.
+func (c *Cache[K, V]) Replace(key K, value V) V {
+	defer c.lock()()
+
+	if _, ok := c.entries[key]; !ok {
+		var zero V
+		return zero
+	}
+	oldValue, _ := c.put(key, value, c.ttl)
+	return oldValue
+}
+
+func (c *Cache[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bool {
+	defer c.lock()()
+
+	e, ok := c.entries[key]
+	if !ok || !equality.Equal(e.value, oldValue) {
+		return false
+	}
+	c.put(key, newValue, c.ttl)
+	return true
+}
+
+// Compute maps key to the value that remappingFunction computes from the current value, or
+// from nil if key is absent, with the cache's default time to live. If the function returns
+// false, the entry is removed, or not added. It returns the new value, or nil if the cache
+// no longer contains key. The function runs while the cache is locked, so it must not use it.
+func (c *Cache[K, V]) Compute(key K, remappingFunction func(K, *V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "Cache", "Compute")
+	}
+	defer c.lock()()
+
+	e, ok := c.entries[key]
+	var current *V
+	if ok {
+		value := e.value
+		current = &value
+	}
+	value, keep := remappingFunction(key, current)
+	return c.store(key, e, value, keep), nil
+}
+
+// ComputeIfPresent is like Compute, but only calls remappingFunction if key is present.
+func (c *Cache[K, V]) ComputeIfPresent(key K, remappingFunction func(K, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "Cache", "ComputeIfPresent")
+	}
+	defer c.lock()()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	value, keep := remappingFunction(key, e.value)
+	return c.store(key, e, value, keep), nil
+}
+
+// Merge maps key to value if key is absent, and otherwise to the value that
+// remappingFunction computes from the current value and value, removing the entry if the
+// function returns false. New values get the cache's default time to live.
+func (c *Cache[K, V]) Merge(key K, value V, remappingFunction func(V, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "Cache", "Merge")
+	}
+	defer c.lock()()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return c.store(key, nil, value, true), nil
+	}
+	merged, keep := remappingFunction(e.value, value)
+	return c.store(key, e, merged, keep), nil
+}
+
+func (c *Cache[K, V]) Size() int {
+	defer c.lock()()
+
+	return len(c.entries)
+}
+
+// Values returns a snapshot of the values in a list, which, unlike a set, keeps duplicates.
+func (c *Cache[K, V]) Values() collections.Collection[V] {
+	pairs := c.snapshot()
+	values := make([]V, len(pairs))
+	for i, p := range pairs {
+		values[i] = p.value
+	}
+
+	list := lists.NewArrayListWithEquality[V](collections.EqualerFunc[V](equality.Equal[V]))
+	list.AddAllBatch(values)
+	return list
+}
+
+// All returns a sequence over a snapshot of the entries in the cache. LRU and LFU caches
+// yield their entries in eviction order, starting with the entry that would be evicted
+// next; other caches yield them in no particular order. Iterating does not count as a use.
+// The snapshot is taken when iteration starts, so the loop body may modify the cache.
+func (c *Cache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, p := range c.snapshot() {
+			if !yield(p.key, p.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence over a snapshot of the keys in the cache, in the order of All.
+func (c *Cache[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range c.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over a snapshot of the values in the cache, in the order of All.
+func (c *Cache[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range c.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// pair is a key-value pair copied out of the cache so that it can be used without holding the lock.
+type pair[K any, V any] struct {
+	key   K
+	value V
+}
+
+func (c *Cache[K, V]) snapshot() []pair[K, V] {
+	defer c.lock()()
+
+	pairs := make([]pair[K, V], 0, len(c.entries))
+	if c.policy == nil {
+		for _, e := range c.entries {
+			pairs = append(pairs, pair[K, V]{key: e.key, value: e.value})
+		}
+		return pairs
+	}
+	c.policy.each(func(e *entry[K, V]) {
+		pairs = append(pairs, pair[K, V]{key: e.key, value: e.value})
+	})
+	return pairs
+}
+
+// Helper methods. Unless noted otherwise, they assume the lock is already held.
+
+// lock locks the cache, removes the entries that have expired, and returns the function
+// that unlocks it and then reports the evicted entries to the OnEvict callback.
+func (c *Cache[K, V]) lock() func() {
+	c.mu.Lock()
+	c.expire()
+	return c.unlock
+}
+
+// unlock releases the lock and then reports the entries evicted or expired while it was
+// held to the OnEvict callback. Every method that locks with lock must unlock with it.
+func (c *Cache[K, V]) unlock() {
+	pending, onEvict := c.pending, c.onEvict
+	c.pending = nil
+	c.mu.Unlock()
+
+	for _, e := range pending {
+		onEvict(e.key, e.value, e.reason)
+	}
+}
+
+// put maps key to value with the given time to live, evicting entries as needed, and
+// returns the previous value and whether there was one.
+func (c *Cache[K, V]) put(key K, value V, ttl time.Duration) (V, bool) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.clock.Now().Add(ttl)
+	}
+	weight := c.weigh(key, value)
+
+	if e, ok := c.entries[key]; ok {
+		oldValue := e.value
+		e.value = value
+		c.weight += weight - e.weight
+		e.weight = weight
+		c.setExpiry(e, expires)
+		if c.policy != nil {
+			if weight > c.maxWeight {
+				c.remove(e)
+				c.evicted(e, Capacity)
+			} else {
+				c.policy.access(e)
+				for c.weight > c.maxWeight {
+					c.evictVictim()
+				}
+			}
+		}
+		return oldValue, true
+	}
+
+	e := &entry[K, V]{key: key, value: value, weight: weight, heapIndex: -1}
+	if c.policy != nil {
+		if weight > c.maxWeight {
+			// The entry could never fit, so it is evicted straight away
+			c.evicted(e, Capacity)
+			var zero V
+			return zero, false
+		}
+		for c.weight+weight > c.maxWeight {
+			c.evictVictim()
+		}
+	}
+	c.entries[key] = e
+	c.weight += weight
+	c.setExpiry(e, expires)
+	if c.policy != nil {
+		c.policy.add(e)
+	}
+	var zero V
+	return zero, false
+}
+
+// store applies the result of a remapping function to e, the entry of key or nil if the key
+// is absent, and returns a pointer to the stored value, or nil if the cache no longer
+// contains key.
+func (c *Cache[K, V]) store(key K, e *entry[K, V], value V, keep bool) *V {
+	if !keep {
+		if e != nil {
+			c.remove(e)
+		}
+		return nil
+	}
+	c.put(key, value, c.ttl)
+	if _, ok := c.entries[key]; !ok {
+		// Evicted straight away for being heavier than the whole cache
+		return nil
+	}
+	return &value
+}
+
+// evictVictim evicts the entry chosen by the policy.
+func (c *Cache[K, V]) evictVictim() {
+	victim := c.policy.victim()
+	c.remove(victim)
+	c.evicted(victim, Capacity)
+}
+
+func (c *Cache[K, V]) weigh(key K, value V) int64 {
+	if c.weigher == nil {
+		return 1
+	}
+	return max(c.weigher(key, value), 0)
+}
+
+// remove removes e from the cache.
+func (c *Cache[K, V]) remove(e *entry[K, V]) {
+	delete(c.entries, e.key)
+	c.weight -= e.weight
+	if e.heapIndex >= 0 {
+		heap.Remove(&c.expiry, e.heapIndex)
+	}
+	if c.policy != nil {
+		c.policy.remove(e)
+	}
+}
+
+// evicted counts the eviction of e and queues it for the OnEvict callback.
+func (c *Cache[K, V]) evicted(e *entry[K, V], reason EvictionReason) {
+	if reason == Expired {
+		c.stats.Expirations++
+	} else {
+		c.stats.Evictions++
+	}
+	if c.onEvict != nil {
+		c.pending = append(c.pending, evicted[K, V]{key: e.key, value: e.value, reason: reason})
+	}
+}
@@ -0,0 +1,235 @@
+package cache
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/maps"
+)
+
+func TestCache_IsMap(t *testing.T) {
+	var m collections.Map[string, int] = NewLRU[string, int](10)
+
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, 0, m.Put("a", 1))
+	assert.Equal(t, 1, m.Put("a", 2))
+	m.Put("b", 3)
+	assert.Equal(t, 2, m.Size())
+	assert.True(t, m.HasKey("a"))
+	assert.False(t, m.HasKey("z"))
+	assert.True(t, m.HasValue(3))
+	assert.False(t, m.HasValue(1))
+	assert.Equal(t, 2, *m.Get("a"))
+	assert.Nil(t, m.Get("z"))
+
+	assert.Equal(t, 2, m.PutIfAbsent("a", 10))
+	assert.Equal(t, 0, m.PutIfAbsent("c", 4))
+	assert.Equal(t, 0, m.Replace("z", 1))
+	assert.False(t, m.HasKey("z"))
+	assert.Equal(t, 4, m.Replace("c", 5))
+	assert.False(t, m.ReplaceKeyWithValue("c", 4, 6))
+	assert.True(t, m.ReplaceKeyWithValue("c", 5, 6))
+	assert.False(t, m.RemoveKeyWithValue("c", 5))
+	assert.True(t, m.RemoveKeyWithValue("c", 6))
+	assert.Equal(t, 3, m.Remove("b"))
+	assert.Equal(t, 0, m.Remove("b"))
+	assert.Equal(t, 1, m.Size())
+
+	other := maps.NewHashMap[string, int]()
+	other.Put("x", 7)
+	other.Put("y", 8)
+	m.PutAll(other)
+	m.PutAll(nil)
+	assert.ElementsMatch(t, []string{"a", "x", "y"}, m.KeySet().ToArray())
+	assert.ElementsMatch(t, []int{2, 7, 8}, m.Values().ToArray())
+	assert.Equal(t, 3, m.EntrySet().Size())
+	for _, entry := range m.EntrySet().ToArray() {
+		assert.Equal(t, *m.Get(entry.GetKey()), entry.GetValue())
+	}
+
+	other.Put("a", 2)
+	assert.True(t, m.Equals(other))
+	assert.True(t, other.Equals(m))
+	other.Put("a", 3)
+	assert.False(t, m.Equals(other))
+	assert.False(t, m.Equals(nil))
+	assert.False(t, m.Equals("a"))
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Nil(t, m.Get("a"))
+}
+
+func TestCache_Sequences(t *testing.T) {
+	c := NewLRU[string, int](10)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(c.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(c.AllValues()))
+
+	// The loop body may modify the cache
+	for k := range c.All() {
+		c.Remove(k)
+	}
+	assert.True(t, c.IsEmpty())
+
+	c.Put("d", 4)
+	c.Put("e", 5)
+	for k := range c.Keys() {
+		assert.Equal(t, "d", k)
+		break
+	}
+	for v := range c.AllValues() {
+		assert.Equal(t, 4, v)
+		break
+	}
+}
+
+func TestCache_NonComparableValues(t *testing.T) {
+	c := NewLRU[string, []int](4)
+	c.Put("a", []int{1, 2})
+	c.Put("b", []int{1, 2})
+
+	assert.True(t, c.HasValue([]int{1, 2}))
+	assert.False(t, c.HasValue([]int{2, 1}))
+	assert.True(t, c.Values().Contains([]int{1, 2}))
+	assert.Equal(t, 2, c.Values().Size())
+	assert.True(t, c.ReplaceKeyWithValue("a", []int{1, 2}, []int{3}))
+	assert.True(t, c.RemoveKeyWithValue("a", []int{3}))
+	assert.Equal(t, []string{"b"}, slices.Collect(c.Keys()))
+}
+
+func TestCache_Stats(t *testing.T) {
+	c := NewLRU[string, int](2)
+	assert.Equal(t, Stats{}, c.Stats())
+	assert.Equal(t, 0.0, c.Stats().HitRate())
+
+	c.Put("a", 1)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.HasKey("b") // Not counted
+	c.Put("b", 2)
+	c.Put("c", 3)
+
+	stats := c.Stats()
+	assert.Equal(t, Stats{Hits: 2, Misses: 1, Evictions: 1}, stats)
+	assert.InDelta(t, 2.0/3.0, stats.HitRate(), 1e-9)
+}
+
+func TestCache_OnEvict(t *testing.T) {
+	c := NewLRU[string, int](2)
+	var keys []string
+	var reasons []EvictionReason
+	c.SetOnEvict(func(key string, value int, reason EvictionReason) {
+		// The callback runs without the lock held, so it may use the cache
+		assert.Equal(t, 2, c.Size())
+		keys = append(keys, key)
+		reasons = append(reasons, reason)
+	})
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Remove("b") // Removals are not evictions
+	c.Put("d", 4)
+	c.Put("e", 5)
+
+	assert.Equal(t, []string{"a", "c"}, keys)
+	assert.Equal(t, []EvictionReason{Capacity, Capacity}, reasons)
+
+	c.SetOnEvict(nil)
+	c.Put("f", 6)
+	assert.Equal(t, []string{"a", "c"}, keys)
+	assert.Equal(t, uint64(3), c.Stats().Evictions)
+}
+
+func TestEvictionReason_String(t *testing.T) {
+	assert.Equal(t, "Capacity", Capacity.String())
+	assert.Equal(t, "Expired", Expired.String())
+	assert.Equal(t, "Unknown", EvictionReason(9).String())
+}
+
+func TestCache_Concurrent(t *testing.T) {
+	c := NewLRU[int, int](100)
+	var evictions sync.Map
+	c.SetOnEvict(func(key int, value int, reason EvictionReason) {
+		evictions.Store(key, value)
+	})
+
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				c.Put(g*1000+i, i)
+				c.Get(g*1000 + i/2)
+				if i%10 == 0 {
+					c.Remove(g*1000 + i - 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100, c.Size())
+	assert.Equal(t, int64(100), c.Weight())
+	stats := c.Stats()
+	assert.Equal(t, uint64(8000), stats.Hits+stats.Misses)
+}
+
+func TestCache_ComputeAndMerge(t *testing.T) {
+	c := NewLRU[string, int](2)
+	var evicted []string
+	c.SetOnEvict(func(key string, value int, reason EvictionReason) {
+		evicted = append(evicted, key)
+	})
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	value, err := c.Merge("a", 1, sum)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *value)
+	value, err = c.Compute("b", func(k string, v *int) (int, bool) {
+		assert.Nil(t, v)
+		return 2, true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *value)
+	value, err = c.Merge("a", 10, sum)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, *value)
+	assert.Equal(t, []string{"b", "a"}, slices.Collect(c.Keys()))
+
+	// Inserting into a full cache evicts
+	value, err = c.Compute("c", func(k string, v *int) (int, bool) { return 3, true })
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *value)
+	assert.Equal(t, []string{"b"}, evicted)
+
+	value, err = c.ComputeIfPresent("b", func(k string, v int) (int, bool) { return v, true })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = c.ComputeIfPresent("a", func(k string, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = c.Merge("c", 0, func(old, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.True(t, c.IsEmpty())
+	assert.Equal(t, []string{"b"}, evicted)
+
+	_, err = c.Compute("a", nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+	_, err = c.ComputeIfPresent("a", nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+	_, err = c.Merge("a", 1, nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+}
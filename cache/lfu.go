@@ -0,0 +1,126 @@
+package cache
+
+// NewLFU returns a cache that holds at most maxEntries entries and, when it is full, evicts
+// the least frequently used one, or, among entries used equally often, the least recently
+// used. Adding an entry, and each use of it, counts towards its frequency. Every operation
+// takes constant time. It returns nil if maxEntries is less than 1.
+func NewLFU[K comparable, V any](maxEntries int) *Cache[K, V] {
+	if maxEntries < 1 {
+		return nil
+	}
+	return newCache[K, V](&lfuPolicy[K, V]{}, int64(maxEntries), nil, 0)
+}
+
+// bucket holds the entries of an LFU cache that have been used count times, from least to
+// most recently used. The buckets form a list in ascending order of count, and only buckets
+// with entries are in it.
+type bucket[K comparable, V any] struct {
+	count uint64
+	head  *entry[K, V]
+	tail  *entry[K, V]
+	prev  *bucket[K, V]
+	next  *bucket[K, V]
+}
+
+// lfuPolicy keeps the entries in frequency buckets, so that it can find the least
+// frequently used entry and move an entry to the next frequency in constant time.
+type lfuPolicy[K comparable, V any] struct {
+	lowest *bucket[K, V]
+}
+
+func (p *lfuPolicy[K, V]) add(e *entry[K, V]) {
+	b := p.lowest
+	if b == nil || b.count != 1 {
+		b = p.insertBucket(nil, 1)
+	}
+	b.push(e)
+}
+
+func (p *lfuPolicy[K, V]) access(e *entry[K, V]) {
+	from := e.bucket
+	to := from.next
+	if to == nil || to.count != from.count+1 {
+		to = p.insertBucket(from, from.count+1)
+	}
+	p.remove(e)
+	to.push(e)
+}
+
+func (p *lfuPolicy[K, V]) remove(e *entry[K, V]) {
+	b := e.bucket
+	b.unlink(e)
+	if b.head != nil {
+		return
+	}
+	if b.prev != nil {
+		b.prev.next = b.next
+	} else {
+		p.lowest = b.next
+	}
+	if b.next != nil {
+		b.next.prev = b.prev
+	}
+}
+
+func (p *lfuPolicy[K, V]) victim() *entry[K, V] {
+	if p.lowest == nil {
+		return nil
+	}
+	return p.lowest.head
+}
+
+func (p *lfuPolicy[K, V]) each(visit func(e *entry[K, V])) {
+	for b := p.lowest; b != nil; b = b.next {
+		for e := b.head; e != nil; e = e.next {
+			visit(e)
+		}
+	}
+}
+
+func (p *lfuPolicy[K, V]) clear() {
+	p.lowest = nil
+}
+
+// insertBucket adds an empty bucket for count after the bucket after, or first if after is nil.
+func (p *lfuPolicy[K, V]) insertBucket(after *bucket[K, V], count uint64) *bucket[K, V] {
+	b := &bucket[K, V]{count: count, prev: after}
+	if after == nil {
+		b.next = p.lowest
+		p.lowest = b
+	} else {
+		b.next = after.next
+		after.next = b
+	}
+	if b.next != nil {
+		b.next.prev = b
+	}
+	return b
+}
+
+// push adds e as the most recently used entry of b.
+func (b *bucket[K, V]) push(e *entry[K, V]) {
+	e.bucket = b
+	e.prev = b.tail
+	e.next = nil
+	if b.tail == nil {
+		b.head = e
+	} else {
+		b.tail.next = e
+	}
+	b.tail = e
+}
+
+// unlink takes e out of b.
+func (b *bucket[K, V]) unlink(e *entry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		b.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		b.tail = e.prev
+	}
+	e.prev, e.next, e.bucket = nil, nil, nil
+}
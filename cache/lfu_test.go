@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLFU(t *testing.T) {
+	assert.Nil(t, NewLFU[string, int](0))
+
+	c := NewLFU[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+
+	// c is the least frequently used
+	c.Put("d", 4)
+	assert.False(t, c.HasKey("c"))
+	assert.Equal(t, []string{"d", "b", "a"}, slices.Collect(c.Keys()))
+
+	// Among entries used equally often, the least recently used goes first
+	c.Get("d")
+	c.Put("e", 5)
+	assert.Equal(t, []string{"e", "d", "a"}, slices.Collect(c.Keys()))
+
+	// A new entry can be evicted by the next one
+	c.Put("f", 6)
+	assert.False(t, c.HasKey("e"))
+	assert.Equal(t, []string{"f", "d", "a"}, slices.Collect(c.Keys()))
+	assert.Equal(t, uint64(3), c.Stats().Evictions)
+}
+
+func TestNewLFU_Updates(t *testing.T) {
+	c := NewLFU[string, int](2)
+	c.Put("a", 1)
+	c.Put("a", 2)
+	c.Replace("a", 3)
+	c.Put("b", 1)
+	c.Put("c", 1)
+
+	assert.Equal(t, []string{"c", "a"}, slices.Collect(c.Keys()))
+	assert.Equal(t, 3, *c.Get("a"))
+
+	c.Remove("a")
+	c.Put("d", 1)
+	assert.Equal(t, []string{"c", "d"}, slices.Collect(c.Keys()))
+	c.Clear()
+	c.Put("e", 1)
+	assert.Equal(t, []string{"e"}, slices.Collect(c.Keys()))
+}
+
+// TestNewLFU_MatchesModel checks the evictions against a straightforward count of uses.
+func TestNewLFU_MatchesModel(t *testing.T) {
+	const capacity = 8
+	c := NewLFU[int, int](capacity)
+	counts := make(map[int]int)
+	lastUse := make(map[int]int)
+	rng := rand.New(rand.NewSource(1))
+
+	for step := range 5000 {
+		key := rng.Intn(20)
+		if rng.Intn(4) == 0 {
+			if _, ok := counts[key]; ok {
+				c.Get(key)
+				counts[key]++
+				lastUse[key] = step
+			}
+			continue
+		}
+		if _, ok := counts[key]; ok {
+			c.Put(key, step)
+			counts[key]++
+			lastUse[key] = step
+			continue
+		}
+		if len(counts) == capacity {
+			victim := -1
+			for k := range counts {
+				if victim < 0 || counts[k] < counts[victim] ||
+					counts[k] == counts[victim] && lastUse[k] < lastUse[victim] {
+					victim = k
+				}
+			}
+			delete(counts, victim)
+			delete(lastUse, victim)
+		}
+		c.Put(key, step)
+		counts[key] = 1
+		lastUse[key] = step
+	}
+
+	keys := make([]int, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	assert.ElementsMatch(t, keys, slices.Collect(c.Keys()))
+}
@@ -0,0 +1,73 @@
+package cache
+
+// NewLRU returns a cache that holds at most maxEntries entries and, when it is full, evicts
+// the least recently used one. It returns nil if maxEntries is less than 1.
+func NewLRU[K comparable, V any](maxEntries int) *Cache[K, V] {
+	if maxEntries < 1 {
+		return nil
+	}
+	return newCache[K, V](&lruPolicy[K, V]{}, int64(maxEntries), nil, 0)
+}
+
+// NewWeightedLRU returns a cache whose entries, as weighed by weigher, weigh at most
+// maxWeight in total, and which evicts the least recently used entries to stay within it.
+// An entry that weighs more than maxWeight on its own is evicted as soon as it is put.
+// Negative weights count as 0. It returns nil if maxWeight is less than 1 or weigher is nil.
+func NewWeightedLRU[K comparable, V any](maxWeight int64, weigher func(key K, value V) int64) *Cache[K, V] {
+	if maxWeight < 1 || weigher == nil {
+		return nil
+	}
+	return newCache(&lruPolicy[K, V]{}, maxWeight, weigher, 0)
+}
+
+// lruPolicy keeps the entries in a list from least to most recently used.
+type lruPolicy[K comparable, V any] struct {
+	head *entry[K, V] // Least recently used
+	tail *entry[K, V] // Most recently used
+}
+
+func (p *lruPolicy[K, V]) add(e *entry[K, V]) {
+	e.prev = p.tail
+	e.next = nil
+	if p.tail == nil {
+		p.head = e
+	} else {
+		p.tail.next = e
+	}
+	p.tail = e
+}
+
+func (p *lruPolicy[K, V]) access(e *entry[K, V]) {
+	if e != p.tail {
+		p.remove(e)
+		p.add(e)
+	}
+}
+
+func (p *lruPolicy[K, V]) remove(e *entry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		p.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		p.tail = e.prev
+	}
+	e.prev, e.next = nil, nil
+}
+
+func (p *lruPolicy[K, V]) victim() *entry[K, V] {
+	return p.head
+}
+
+func (p *lruPolicy[K, V]) each(visit func(e *entry[K, V])) {
+	for e := p.head; e != nil; e = e.next {
+		visit(e)
+	}
+}
+
+func (p *lruPolicy[K, V]) clear() {
+	p.head, p.tail = nil, nil
+}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLRU(t *testing.T) {
+	assert.Nil(t, NewLRU[string, int](0))
+	assert.Nil(t, NewLRU[string, int](-1))
+
+	c := NewLRU[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Put("d", 4)
+
+	assert.False(t, c.HasKey("b"))
+	assert.Equal(t, []string{"c", "a", "d"}, slices.Collect(c.Keys()))
+
+	// Updating a value and PutIfAbsent on a present key count as uses
+	c.Put("c", 30)
+	c.PutIfAbsent("a", 10)
+	assert.Equal(t, []string{"d", "c", "a"}, slices.Collect(c.Keys()))
+
+	// HasKey, HasValue and iteration do not
+	c.HasKey("d")
+	c.HasValue(4)
+	for range c.All() {
+	}
+	c.Put("e", 5)
+	assert.Equal(t, []string{"c", "a", "e"}, slices.Collect(c.Keys()))
+	assert.Equal(t, int64(3), c.Weight())
+}
+
+func TestNewLRU_SingleEntry(t *testing.T) {
+	c := NewLRU[string, int](1)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("b")
+	c.Put("b", 3)
+
+	assert.Equal(t, 1, c.Size())
+	assert.Equal(t, 3, *c.Get("b"))
+	assert.Equal(t, uint64(1), c.Stats().Evictions)
+}
+
+func TestNewWeightedLRU(t *testing.T) {
+	assert.Nil(t, NewWeightedLRU[string, string](0, func(string, string) int64 { return 1 }))
+	assert.Nil(t, NewWeightedLRU[string, string](10, nil))
+
+	c := NewWeightedLRU(10, func(key string, value string) int64 { return int64(len(value)) })
+	var evicted []string
+	c.SetOnEvict(func(key string, value string, reason EvictionReason) {
+		evicted = append(evicted, key)
+	})
+
+	c.Put("a", "xxxx")
+	c.Put("b", "xxxx")
+	assert.Equal(t, int64(8), c.Weight())
+	c.Get("a")
+
+	// Evicts the least recently used entries until the new entry fits
+	c.Put("c", "xxxxx")
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, int64(9), c.Weight())
+
+	// Growing an entry evicts others
+	c.Put("a", "xxxxxx")
+	assert.Equal(t, []string{"b", "c"}, evicted)
+	assert.Equal(t, int64(6), c.Weight())
+
+	// Shrinking one does not
+	c.Put("a", "x")
+	c.Put("d", "xxxx")
+	assert.Equal(t, int64(5), c.Weight())
+	assert.Equal(t, 2, c.Size())
+
+	// An entry heavier than the whole cache is evicted as soon as it is put
+	c.Put("e", "xxxxxxxxxxx")
+	assert.False(t, c.HasKey("e"))
+	assert.Equal(t, []string{"b", "c", "e"}, evicted)
+	assert.Equal(t, int64(5), c.Weight())
+	c.Put("a", "xxxxxxxxxxx")
+	assert.False(t, c.HasKey("a"))
+	assert.Equal(t, []string{"b", "c", "e", "a"}, evicted)
+	assert.Equal(t, int64(4), c.Weight())
+
+	c.Remove("d")
+	assert.Equal(t, int64(0), c.Weight())
+}
+
+func TestNewWeightedLRU_NegativeWeight(t *testing.T) {
+	c := NewWeightedLRU(2, func(key string, value int) int64 { return int64(value) })
+	c.Put("a", -5)
+	c.Put("b", 2)
+
+	assert.Equal(t, int64(2), c.Weight())
+	assert.Equal(t, 2, c.Size())
+}
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// NewTTL returns an unbounded cache whose entries expire once ttl has passed since they
+// were last put. PutWithTTL gives an entry a time to live of its own. It returns nil if ttl
+// is not positive.
+func NewTTL[K comparable, V any](ttl time.Duration) *Cache[K, V] {
+	if ttl <= 0 {
+		return nil
+	}
+	return newCache[K, V](nil, 0, nil, ttl)
+}
+
+// Cleanup removes the entries that have expired. Every operation on the cache does this
+// first, so Cleanup is only needed to free expired entries of a cache that is not in use.
+func (c *Cache[K, V]) Cleanup() {
+	defer c.lock()()
+}
+
+// StartCleanup starts a goroutine that calls Cleanup every interval, and returns the function
+// that stops it. It returns a function that does nothing if interval is not positive.
+func (c *Cache[K, V]) StartCleanup(interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		return func() {}
+	}
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				c.Cleanup()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+		})
+	}
+}
+
+// setExpiry sets when e expires, which is never if expires is zero.
+func (c *Cache[K, V]) setExpiry(e *entry[K, V], expires time.Time) {
+	e.expires = expires
+	switch {
+	case e.heapIndex >= 0 && expires.IsZero():
+		heap.Remove(&c.expiry, e.heapIndex)
+	case e.heapIndex >= 0:
+		heap.Fix(&c.expiry, e.heapIndex)
+	case !expires.IsZero():
+		heap.Push(&c.expiry, e)
+	}
+}
+
+// expire removes the entries that have expired.
+func (c *Cache[K, V]) expire() {
+	if len(c.expiry) == 0 {
+		return
+	}
+	now := c.clock.Now()
+	for len(c.expiry) > 0 && !c.expiry[0].expires.After(now) {
+		e := c.expiry[0]
+		c.remove(e)
+		c.evicted(e, Expired)
+	}
+}
+
+// expiryHeap orders the entries that expire by expiry time, soonest first.
+// It implements heap.Interface.
+type expiryHeap[K comparable, V any] []*entry[K, V]
+
+func (h expiryHeap[K, V]) Len() int { return len(h) }
+
+func (h expiryHeap[K, V]) Less(i, j int) bool { return h[i].expires.Before(h[j].expires) }
+
+func (h expiryHeap[K, V]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIndex = i
+	h[j].heapIndex = j
+}
+
+func (h *expiryHeap[K, V]) Push(x any) {
+	e := x.(*entry[K, V])
+	e.heapIndex = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *expiryHeap[K, V]) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	e.heapIndex = -1
+	return e
+}
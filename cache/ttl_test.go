@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTTL(t *testing.T, ttl time.Duration) (*Cache[string, int], *ManualClock) {
+	t.Helper()
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	c := NewTTL[string, int](ttl)
+	c.SetClock(clock)
+	return c, clock
+}
+
+func TestNewTTL(t *testing.T) {
+	assert.Nil(t, NewTTL[string, int](0))
+	assert.Nil(t, NewTTL[string, int](-time.Second))
+
+	c, clock := newTestTTL(t, time.Minute)
+	c.Put("a", 1)
+	clock.Advance(30 * time.Second)
+	c.Put("b", 2)
+
+	clock.Advance(29 * time.Second)
+	assert.Equal(t, 1, *c.Get("a"))
+
+	// Reading does not extend the time to live
+	clock.Advance(time.Second)
+	assert.Nil(t, c.Get("a"))
+	assert.True(t, c.HasKey("b"))
+	assert.Equal(t, 1, c.Size())
+
+	// Writing does
+	clock.Advance(20 * time.Second)
+	c.Put("b", 3)
+	clock.Advance(50 * time.Second)
+	assert.Equal(t, 3, *c.Get("b"))
+	clock.Advance(10 * time.Second)
+	assert.True(t, c.IsEmpty())
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(2), stats.Expirations)
+	assert.Equal(t, uint64(0), stats.Evictions)
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+}
+
+func TestCache_PutWithTTL(t *testing.T) {
+	c, clock := newTestTTL(t, time.Minute)
+	c.PutWithTTL("short", 1, time.Second)
+	c.PutWithTTL("forever", 2, 0)
+	c.Put("default", 3)
+
+	clock.Advance(time.Second)
+	assert.Equal(t, []string{"default", "forever"}, sortedKeys(c))
+	clock.Advance(time.Hour)
+	assert.Equal(t, []string{"forever"}, sortedKeys(c))
+
+	// A ttl can be added to and taken away from an entry
+	c.PutWithTTL("forever", 2, time.Second)
+	c.Put("default", 3)
+	c.PutWithTTL("default", 3, 0)
+	clock.Advance(time.Second)
+	assert.Equal(t, []string{"default"}, sortedKeys(c))
+
+	// Caches that evict by capacity can expire entries too
+	lru := NewLRU[string, int](2)
+	lru.SetClock(clock)
+	lru.PutWithTTL("a", 1, time.Second)
+	lru.Put("b", 2)
+	clock.Advance(time.Second)
+	lru.Put("c", 3)
+	assert.Equal(t, []string{"b", "c"}, slices.Collect(lru.Keys()))
+	assert.Equal(t, Stats{Expirations: 1}, lru.Stats())
+}
+
+func TestCache_ExpiryOnEvict(t *testing.T) {
+	c, clock := newTestTTL(t, time.Minute)
+	var expired []string
+	c.SetOnEvict(func(key string, value int, reason EvictionReason) {
+		assert.Equal(t, Expired, reason)
+		expired = append(expired, key)
+	})
+
+	c.Put("a", 1)
+	clock.Advance(10 * time.Second)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Remove("c")
+	clock.Advance(time.Minute)
+
+	// Nothing is removed until the cache is used
+	assert.Empty(t, expired)
+	c.Cleanup()
+	assert.Equal(t, []string{"a", "b"}, expired)
+	assert.True(t, c.IsEmpty())
+
+	c.Put("d", 4)
+	c.Clear()
+	clock.Advance(time.Hour)
+	c.Cleanup()
+	assert.Equal(t, []string{"a", "b"}, expired)
+}
+
+func TestCache_StartCleanup(t *testing.T) {
+	c, clock := newTestTTL(t, time.Minute)
+	var mu sync.Mutex
+	var expired []string
+	c.SetOnEvict(func(key string, value int, reason EvictionReason) {
+		mu.Lock()
+		defer mu.Unlock()
+		expired = append(expired, key)
+	})
+	c.Put("a", 1)
+
+	stop := c.StartCleanup(time.Millisecond)
+	defer stop()
+	clock.Advance(time.Minute)
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Equal(expired, []string{"a"})
+	}, time.Second, time.Millisecond)
+
+	stop()
+	stop()
+	c.StartCleanup(0)()
+}
+
+func sortedKeys(c *Cache[string, int]) []string {
+	keys := slices.Collect(c.Keys())
+	slices.Sort(keys)
+	return keys
+}
@@ -2,7 +2,12 @@
 package collections
 
 import (
-	"errors"
+	"context"
+	"iter"
+	"time"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/equality"
 )
 
 // Iterable represents a collection that can be iterated over.
@@ -38,6 +43,9 @@ type Collection[E any] interface {
 	Size() int
 	// ToArray returns a slice containing all elements in this collection.
 	ToArray() []E
+	// All returns a sequence over the elements in this collection, for use with range.
+	// The collection's lock is not held while the loop body runs, so the body may modify the collection.
+	All() iter.Seq[E]
 }
 
 // SequencedCollection represents a collection with a defined encounter order.
@@ -57,6 +65,8 @@ type SequencedCollection[E any] interface {
 	RemoveLast() (*E, error)
 	// Reversed returns a view of this collection in reverse order.
 	Reversed() Collection[E]
+	// Backward returns a sequence over the elements in this collection from last to first.
+	Backward() iter.Seq[E]
 }
 
 // List represents an ordered collection of elements.
@@ -82,6 +92,8 @@ type List[E any] interface {
 	Sort(comparator Comparator[E])
 	// SubList returns a view of the portion of this list between the specified fromIndex and toIndex.
 	SubList(fromIndex int, toIndex int) (List[E], error)
+	// ListIterator returns a list iterator over the elements in this list, starting at the specified position.
+	ListIterator(index int) (ListIterator[E], error)
 }
 
 // Queue represents a collection designed for holding elements prior to processing.
@@ -110,6 +122,14 @@ type BlockingQueue[E any] interface {
 	RemainingCapacity() int
 	// DrainTo removes all available elements from this queue and adds them to the given collection.
 	DrainTo(c Collection[E]) int
+	// OfferTimeout inserts the specified element into this queue, waiting up to the timeout for space to become available.
+	OfferTimeout(element E, timeout time.Duration) error
+	// PollTimeout retrieves and removes the head of this queue, waiting up to the timeout for an element to become available.
+	PollTimeout(timeout time.Duration) (*E, error)
+	// PutContext inserts the specified element into this queue, waiting for space until the context is done.
+	PutContext(ctx context.Context, element E) error
+	// TakeContext retrieves and removes the head of this queue, waiting for an element until the context is done.
+	TakeContext(ctx context.Context) (*E, error)
 }
 
 // Deque represents a linear collection that supports element insertion and removal at both ends.
@@ -148,12 +168,50 @@ type Iterator[E any] interface {
 	Next() (*E, error)
 }
 
+// ListIterator represents an iterator over a list that can traverse it in either direction
+// and modify it during iteration. Its cursor always lies between two elements.
+type ListIterator[E any] interface {
+	Iterator[E]
+	// HasPrevious returns true if the iteration has more elements when traversing the list in reverse.
+	HasPrevious() bool
+	// Previous returns the previous element in the list and moves the cursor backwards.
+	Previous() (*E, error)
+	// NextIndex returns the index of the element that would be returned by a subsequent call to Next.
+	NextIndex() int
+	// PreviousIndex returns the index of the element that would be returned by a subsequent call to Previous.
+	PreviousIndex() int
+	// Remove removes the element last returned by Next or Previous.
+	Remove() error
+	// Set replaces the element last returned by Next or Previous with the specified element.
+	Set(element E) error
+	// Add inserts the specified element immediately before the element that would be returned by Next.
+	Add(element E) error
+}
+
 // Comparator represents a function that compares two elements.
 type Comparator[E any] interface {
 	// Compare compares its two arguments for order.
 	Compare(a, b E) int
 }
 
+// Equaler decides whether two elements are equal. It lets a collection hold elements
+// that are not comparable, or compare them by something other than ==. ArrayList, the
+// hash set and map built with a Hasher, and the values of the maps accept one; LinkedList,
+// Stack, LinkedHashSet, TreeSet, PriorityQueue, ArrayDeque and the blocking queues still
+// require comparable elements and compare them with ==.
+type Equaler[E any] interface {
+	// Equal reports whether a and b are equal.
+	Equal(a, b E) bool
+}
+
+// Hasher is an Equaler that can also hash elements, as hash-based collections need.
+// Elements that are equal must have the same hash.
+type Hasher[E any] interface {
+	Equaler[E]
+	// Hash returns the hash of the element.
+	Hash(element E) uint64
+}
+
 // Set represents a collection that contains no duplicate elements.
 type Set[E any] interface {
 	Collection[E]
@@ -195,6 +253,8 @@ type NavigableSet[E any] interface {
 	DescendingSet() NavigableSet[E]
 	// DescendingIterator returns an iterator over the elements in this set in descending order.
 	DescendingIterator() Iterator[E]
+	// Backward returns a sequence over the elements in this set in descending order.
+	Backward() iter.Seq[E]
 }
 
 // MapEntry represents a key-value pair in a Map.
@@ -237,10 +297,29 @@ type Map[K any, V any] interface {
 	Replace(key K, value V) V
 	// ReplaceKeyWithValue replaces the entry for the specified key only if currently mapped to the given old value.
 	ReplaceKeyWithValue(key K, oldValue V, newValue V) bool
+	// Compute maps key to the value that remappingFunction computes from the current value,
+	// or from nil if key is absent. If the function returns false, the entry is removed, or
+	// not added. It returns the new value, or nil if the map no longer contains key.
+	// The function runs under the map's lock, so the update is atomic, and it must not use the map.
+	Compute(key K, remappingFunction func(key K, oldValue *V) (V, bool)) (*V, error)
+	// ComputeIfPresent is like Compute, but only calls remappingFunction if key is present.
+	ComputeIfPresent(key K, remappingFunction func(key K, oldValue V) (V, bool)) (*V, error)
+	// Merge maps key to value if key is absent, and otherwise to the value that
+	// remappingFunction computes from the current value and value, removing the entry if the
+	// function returns false. It returns the new value, or nil if the map no longer contains key.
+	Merge(key K, value V, remappingFunction func(oldValue V, value V) (V, bool)) (*V, error)
 	// Size returns the number of key-value mappings in this map.
 	Size() int
 	// Values returns a Collection view of the values contained in this map.
 	Values() Collection[V]
+	// All returns a sequence over the key-value pairs in this map, for use with range.
+	// The map's lock is not held while the loop body runs, so the body may modify the map.
+	All() iter.Seq2[K, V]
+	// Keys returns a sequence over the keys in this map.
+	Keys() iter.Seq[K]
+	// AllValues returns a sequence over the values in this map.
+	// It is the range-over-func counterpart of Values, which returns a Collection.
+	AllValues() iter.Seq[V]
 }
 
 // SortedMap represents a Map that maintains its entries in ascending order.
@@ -293,6 +372,8 @@ type NavigableMap[K any, V any] interface {
 	PollFirstEntry() (MapEntry[K, V], error)
 	// PollLastEntry removes and returns a key-value mapping associated with the greatest key in this map.
 	PollLastEntry() (MapEntry[K, V], error)
+	// Backward returns a sequence over the key-value pairs in this map in descending key order.
+	Backward() iter.Seq2[K, V]
 }
 
 // ConcurrentMap represents a Map that supports concurrent access.
@@ -307,13 +388,13 @@ type ConcurrentMap[K any, V any] interface {
 }
 
 // HashMapEntry represents a key-value pair in a HashMap.
-type HashMapEntry[K comparable, V comparable] struct {
+type HashMapEntry[K comparable, V any] struct {
 	Key   K
 	Value V
 }
 
 // NewHashMapEntry creates a new HashMapEntry with the specified key and value.
-func NewHashMapEntry[K comparable, V comparable](key K, value V) *HashMapEntry[K, V] {
+func NewHashMapEntry[K comparable, V any](key K, value V) *HashMapEntry[K, V] {
 	return &HashMapEntry[K, V]{
 		Key:   key,
 		Value: value,
@@ -331,6 +412,7 @@ func (h *HashMapEntry[K, V]) GetValue() V {
 }
 
 // Equal returns true if this entry is equal to the specified object.
+// Values that are not comparable, such as slices, are compared with reflect.DeepEqual.
 func (h *HashMapEntry[K, V]) Equals(obj any) bool {
 	if obj == nil {
 		return false
@@ -339,8 +421,11 @@ func (h *HashMapEntry[K, V]) Equals(obj any) bool {
 	if !ok {
 		return false
 	}
-	return h.Key == entry.Key && h.Value == entry.Value
+	return h.Key == entry.Key && equality.Equal(h.Value, entry.Value)
 }
 
 // ErrNoSuchElement is returned when an iterator has no more elements.
-var ErrNoSuchElement = errors.New("no such element")
+//
+// Deprecated: Use ErrNoSuchElement from github.com/chiranjeevipavurala/gocollections/errors,
+// which this variable is now equal to.
+var ErrNoSuchElement = errcodes.ErrNoSuchElement
@@ -0,0 +1,25 @@
+package collections
+
+// EqualerFunc adapts an ordinary equality function to the Equaler interface.
+type EqualerFunc[E any] func(a, b E) bool
+
+// Equal calls the underlying function.
+func (f EqualerFunc[E]) Equal(a, b E) bool {
+	return f(a, b)
+}
+
+// funcHasher is a Hasher made of a hash function and an equality function.
+type funcHasher[E any] struct {
+	hash  func(E) uint64
+	equal func(a, b E) bool
+}
+
+func (h funcHasher[E]) Hash(element E) uint64 { return h.hash(element) }
+
+func (h funcHasher[E]) Equal(a, b E) bool { return h.equal(a, b) }
+
+// NewHasher returns a Hasher that hashes elements with hash and compares them with equal.
+// Elements that equal reports as equal must have the same hash.
+func NewHasher[E any](hash func(E) uint64, equal func(a, b E) bool) Hasher[E] {
+	return funcHasher[E]{hash: hash, equal: equal}
+}
@@ -0,0 +1,28 @@
+package collections_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+)
+
+func TestEqualerFunc(t *testing.T) {
+	var equaler collections.Equaler[string] = collections.EqualerFunc[string](strings.EqualFold)
+	assert.True(t, equaler.Equal("Go", "GO"))
+	assert.False(t, equaler.Equal("Go", "Rust"))
+}
+
+func TestNewHasher(t *testing.T) {
+	hasher := collections.NewHasher(
+		func(s string) uint64 { return uint64(len(s)) },
+		strings.EqualFold,
+	)
+	assert.Equal(t, uint64(2), hasher.Hash("go"))
+	assert.True(t, hasher.Equal("go", "GO"))
+
+	var equaler collections.Equaler[string] = hasher
+	assert.False(t, equaler.Equal("go", "rust"))
+}
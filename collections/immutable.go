@@ -0,0 +1,515 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/equality"
+)
+
+// The immutable collections are built once from their elements and never change, so
+// unlike the other collections they take no locks and are safe for concurrent use as they
+// are. Their mutators behave like those of the unmodifiable views: they fail with
+// UnsupportedOperationError, return false or do nothing.
+
+// ImmutableListOf returns an immutable list of the given elements, in order.
+func ImmutableListOf[E comparable](elements ...E) List[E] {
+	return &immutableList[E]{values: slices.Clone(elements), equal: equalComparable[E]}
+}
+
+// ImmutableSetOf returns an immutable set of the given elements. Duplicates are dropped,
+// and the set iterates over the remaining elements in the order they were given.
+func ImmutableSetOf[E comparable](elements ...E) Set[E] {
+	set := &immutableSet[E]{index: make(map[E]int, len(elements))}
+	for _, element := range elements {
+		if _, exists := set.index[element]; !exists {
+			set.index[element] = len(set.values)
+			set.values = append(set.values, element)
+		}
+	}
+	return set
+}
+
+// ImmutableMapOf returns an immutable map of the given entries, which iterates over its
+// keys in the order they were given. When a key appears more than once, the last value
+// wins. Nil entries are ignored.
+func ImmutableMapOf[K comparable, V comparable](entries ...MapEntry[K, V]) Map[K, V] {
+	return immutableMapOf(equalComparable[V], entries)
+}
+
+// ImmutableMapOfWithValueEquality is like ImmutableMapOf, but the values may be of any type.
+// HasValue, Equals and the Contains and IndexOf methods of Values compare values with
+// equaler, or, if it is nil, with == where possible and reflect.DeepEqual otherwise.
+func ImmutableMapOfWithValueEquality[K comparable, V any](equaler Equaler[V], entries ...MapEntry[K, V]) Map[K, V] {
+	equal := equality.Equal[V]
+	if equaler != nil {
+		equal = equaler.Equal
+	}
+	return immutableMapOf(equal, entries)
+}
+
+// immutableMapOf returns an immutable map of entries that compares values with equal.
+func immutableMapOf[K comparable, V any](equal func(a, b V) bool, entries []MapEntry[K, V]) Map[K, V] {
+	m := &immutableMap[K, V]{index: make(map[K]int, len(entries)), equal: equal}
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+		key := entry.GetKey()
+		if i, exists := m.index[key]; exists {
+			m.values[i] = entry.GetValue()
+			continue
+		}
+		m.index[key] = len(m.keys)
+		m.keys = append(m.keys, key)
+		m.values = append(m.values, entry.GetValue())
+	}
+	return m
+}
+
+// equalComparable compares a and b with ==.
+func equalComparable[E comparable](a, b E) bool {
+	return a == b
+}
+
+type immutableList[E any] struct {
+	values []E
+	equal  func(a, b E) bool
+}
+
+func (l *immutableList[E]) readOnly() {}
+
+func (l *immutableList[E]) Iterator() Iterator[E] {
+	return NewSnapshotIterator(l.values)
+}
+
+func (l *immutableList[E]) Add(element E) bool {
+	return false
+}
+
+func (l *immutableList[E]) AddAll(collection Collection[E]) bool {
+	return false
+}
+
+func (l *immutableList[E]) Clear() {}
+
+func (l *immutableList[E]) Contains(element E) bool {
+	return l.IndexOf(element) >= 0
+}
+
+func (l *immutableList[E]) ContainsAll(collection Collection[E]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "ImmutableList", "ContainsAll")
+	}
+	for _, element := range collection.ToArray() {
+		if !l.Contains(element) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (l *immutableList[E]) Equals(collection Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	return slices.EqualFunc(l.values, collection.ToArray(), l.equal)
+}
+
+func (l *immutableList[E]) IsEmpty() bool {
+	return len(l.values) == 0
+}
+
+func (l *immutableList[E]) Remove(element E) bool {
+	return false
+}
+
+func (l *immutableList[E]) RemoveAll(collection Collection[E]) bool {
+	return false
+}
+
+func (l *immutableList[E]) Size() int {
+	return len(l.values)
+}
+
+func (l *immutableList[E]) ToArray() []E {
+	return slices.Clone(l.values)
+}
+
+func (l *immutableList[E]) All() iter.Seq[E] {
+	return slices.Values(l.values)
+}
+
+func (l *immutableList[E]) AddFirst(val E) {}
+
+func (l *immutableList[E]) AddLast(val E) {}
+
+func (l *immutableList[E]) GetFirst() (*E, error) {
+	if len(l.values) == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ImmutableList", "GetFirst")
+	}
+	value := l.values[0]
+	return &value, nil
+}
+
+func (l *immutableList[E]) GetLast() (*E, error) {
+	if len(l.values) == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ImmutableList", "GetLast")
+	}
+	value := l.values[len(l.values)-1]
+	return &value, nil
+}
+
+func (l *immutableList[E]) RemoveFirst() (*E, error) {
+	return nil, unsupported("ImmutableList", "RemoveFirst")
+}
+
+func (l *immutableList[E]) RemoveLast() (*E, error) {
+	return nil, unsupported("ImmutableList", "RemoveLast")
+}
+
+// Reversed returns an immutable list of the elements in reverse order.
+func (l *immutableList[E]) Reversed() Collection[E] {
+	values := slices.Clone(l.values)
+	slices.Reverse(values)
+	return &immutableList[E]{values: values, equal: l.equal}
+}
+
+func (l *immutableList[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, value := range slices.Backward(l.values) {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func (l *immutableList[E]) AddAtIndex(index int, element E) error {
+	return unsupported("ImmutableList", "AddAtIndex")
+}
+
+func (l *immutableList[E]) AddAllAtIndex(index int, elements Collection[E]) (bool, error) {
+	return false, unsupported("ImmutableList", "AddAllAtIndex")
+}
+
+// CopyOf returns an immutable list of the elements of collection, or nil if collection is nil.
+func (l *immutableList[E]) CopyOf(collection Collection[E]) List[E] {
+	if collection == nil {
+		return nil
+	}
+	return &immutableList[E]{values: collection.ToArray(), equal: l.equal}
+}
+
+func (l *immutableList[E]) Get(index int) (*E, error) {
+	if index < 0 || index >= len(l.values) {
+		return nil, errcodes.NewIndexError("ImmutableList", "Get", index, len(l.values))
+	}
+	value := l.values[index]
+	return &value, nil
+}
+
+func (l *immutableList[E]) IndexOf(element E) int {
+	return slices.IndexFunc(l.values, func(value E) bool { return l.equal(value, element) })
+}
+
+func (l *immutableList[E]) LastIndexOf(element E) int {
+	for i, value := range slices.Backward(l.values) {
+		if l.equal(value, element) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (l *immutableList[E]) RemoveAtIndex(index int) (*E, error) {
+	return nil, unsupported("ImmutableList", "RemoveAtIndex")
+}
+
+func (l *immutableList[E]) Set(index int, element E) (*E, error) {
+	return nil, unsupported("ImmutableList", "Set")
+}
+
+func (l *immutableList[E]) Sort(comparator Comparator[E]) {}
+
+// SubList returns an immutable list of the elements between fromIndex, inclusive, and
+// toIndex, exclusive. It shares the elements of this list instead of copying them.
+func (l *immutableList[E]) SubList(fromIndex int, toIndex int) (List[E], error) {
+	if fromIndex < 0 || toIndex > len(l.values) || fromIndex > toIndex {
+		return nil, errcodes.NewRangeError("ImmutableList", "SubList", fromIndex, toIndex, len(l.values))
+	}
+	return &immutableList[E]{values: l.values[fromIndex:toIndex:toIndex], equal: l.equal}, nil
+}
+
+func (l *immutableList[E]) ListIterator(index int) (ListIterator[E], error) {
+	if index < 0 || index > len(l.values) {
+		return nil, errcodes.NewIndexError("ImmutableList", "ListIterator", index, len(l.values))
+	}
+	return &immutableListIterator[E]{values: l.values, cursor: index}, nil
+}
+
+type immutableListIterator[E any] struct {
+	values []E
+	cursor int
+}
+
+func (it *immutableListIterator[E]) HasNext() bool {
+	return it.cursor < len(it.values)
+}
+
+func (it *immutableListIterator[E]) Next() (*E, error) {
+	if it.cursor >= len(it.values) {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ImmutableList", "ListIterator.Next")
+	}
+	value := it.values[it.cursor]
+	it.cursor++
+	return &value, nil
+}
+
+func (it *immutableListIterator[E]) HasPrevious() bool {
+	return it.cursor > 0
+}
+
+func (it *immutableListIterator[E]) Previous() (*E, error) {
+	if it.cursor <= 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ImmutableList", "ListIterator.Previous")
+	}
+	it.cursor--
+	value := it.values[it.cursor]
+	return &value, nil
+}
+
+func (it *immutableListIterator[E]) NextIndex() int {
+	return it.cursor
+}
+
+func (it *immutableListIterator[E]) PreviousIndex() int {
+	return it.cursor - 1
+}
+
+func (it *immutableListIterator[E]) Remove() error {
+	return unsupported("ImmutableList", "ListIterator.Remove")
+}
+
+func (it *immutableListIterator[E]) Set(element E) error {
+	return unsupported("ImmutableList", "ListIterator.Set")
+}
+
+func (it *immutableListIterator[E]) Add(element E) error {
+	return unsupported("ImmutableList", "ListIterator.Add")
+}
+
+// immutableSet keeps its elements in order, with the position of each in index.
+type immutableSet[E comparable] struct {
+	values []E
+	index  map[E]int
+}
+
+func (s *immutableSet[E]) readOnly() {}
+
+func (s *immutableSet[E]) Iterator() Iterator[E] {
+	return NewSnapshotIterator(s.values)
+}
+
+func (s *immutableSet[E]) Add(element E) bool {
+	return false
+}
+
+func (s *immutableSet[E]) AddAll(collection Collection[E]) bool {
+	return false
+}
+
+func (s *immutableSet[E]) Clear() {}
+
+func (s *immutableSet[E]) Contains(element E) bool {
+	_, exists := s.index[element]
+	return exists
+}
+
+func (s *immutableSet[E]) ContainsAll(collection Collection[E]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "ImmutableSet", "ContainsAll")
+	}
+	for _, element := range collection.ToArray() {
+		if !s.Contains(element) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *immutableSet[E]) Equals(collection Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	if len(s.values) != len(elements) {
+		return false
+	}
+	for _, element := range elements {
+		if !s.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *immutableSet[E]) IsEmpty() bool {
+	return len(s.values) == 0
+}
+
+func (s *immutableSet[E]) Remove(element E) bool {
+	return false
+}
+
+func (s *immutableSet[E]) RemoveAll(collection Collection[E]) bool {
+	return false
+}
+
+func (s *immutableSet[E]) Size() int {
+	return len(s.values)
+}
+
+func (s *immutableSet[E]) ToArray() []E {
+	return slices.Clone(s.values)
+}
+
+func (s *immutableSet[E]) All() iter.Seq[E] {
+	return slices.Values(s.values)
+}
+
+// immutableMap keeps its keys and values in order, with the position of each key in index.
+type immutableMap[K comparable, V any] struct {
+	keys   []K
+	values []V
+	index  map[K]int
+	equal  func(a, b V) bool
+}
+
+func (m *immutableMap[K, V]) readOnly() {}
+
+func (m *immutableMap[K, V]) Clear() {}
+
+func (m *immutableMap[K, V]) HasKey(key K) bool {
+	_, exists := m.index[key]
+	return exists
+}
+
+func (m *immutableMap[K, V]) HasValue(value V) bool {
+	return slices.ContainsFunc(m.values, func(v V) bool { return m.equal(v, value) })
+}
+
+func (m *immutableMap[K, V]) EntrySet() Set[MapEntry[K, V]] {
+	set := &immutableSet[MapEntry[K, V]]{
+		values: make([]MapEntry[K, V], len(m.keys)),
+		index:  make(map[MapEntry[K, V]]int, len(m.keys)),
+	}
+	for i, key := range m.keys {
+		entry := NewHashMapEntry(key, m.values[i])
+		set.values[i] = entry
+		set.index[entry] = i
+	}
+	return set
+}
+
+func (m *immutableMap[K, V]) Equals(obj any) bool {
+	other, ok := obj.(Map[K, V])
+	if !ok || other.Size() != len(m.keys) {
+		return false
+	}
+	for i, key := range m.keys {
+		value := other.Get(key)
+		if value == nil || !m.equal(*value, m.values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *immutableMap[K, V]) Get(key K) *V {
+	i, exists := m.index[key]
+	if !exists {
+		return nil
+	}
+	value := m.values[i]
+	return &value
+}
+
+func (m *immutableMap[K, V]) IsEmpty() bool {
+	return len(m.keys) == 0
+}
+
+// KeySet returns an immutable set of the keys, which shares the storage of this map.
+func (m *immutableMap[K, V]) KeySet() Set[K] {
+	return &immutableSet[K]{values: m.keys, index: m.index}
+}
+
+func (m *immutableMap[K, V]) Put(key K, value V) V {
+	var zero V
+	return zero
+}
+
+func (m *immutableMap[K, V]) PutAll(other Map[K, V]) {}
+
+func (m *immutableMap[K, V]) PutIfAbsent(key K, value V) V {
+	var zero V
+	return zero
+}
+
+func (m *immutableMap[K, V]) Remove(key K) V {
+	var zero V
+	return zero
+}
+
+func (m *immutableMap[K, V]) RemoveKeyWithValue(key K, value V) bool {
+	return false
+}
+
+func (m *immutableMap[K, V]) Replace(key K, value V) V {
+	var zero V
+	return zero
+}
+
+func (m *immutableMap[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bool {
+	return false
+}
+
+func (m *immutableMap[K, V]) Compute(key K, remappingFunction func(key K, oldValue *V) (V, bool)) (*V, error) {
+	return nil, unsupported("ImmutableMap", "Compute")
+}
+
+func (m *immutableMap[K, V]) ComputeIfPresent(key K, remappingFunction func(key K, oldValue V) (V, bool)) (*V, error) {
+	return nil, unsupported("ImmutableMap", "ComputeIfPresent")
+}
+
+func (m *immutableMap[K, V]) Merge(key K, value V, remappingFunction func(oldValue V, value V) (V, bool)) (*V, error) {
+	return nil, unsupported("ImmutableMap", "Merge")
+}
+
+func (m *immutableMap[K, V]) Size() int {
+	return len(m.keys)
+}
+
+// Values returns an immutable list of the values, in the order of their keys.
+func (m *immutableMap[K, V]) Values() Collection[V] {
+	return &immutableList[V]{values: m.values, equal: m.equal}
+}
+
+func (m *immutableMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i, key := range m.keys {
+			if !yield(key, m.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (m *immutableMap[K, V]) Keys() iter.Seq[K] {
+	return slices.Values(m.keys)
+}
+
+func (m *immutableMap[K, V]) AllValues() iter.Seq[V] {
+	return slices.Values(m.values)
+}
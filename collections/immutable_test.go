@@ -0,0 +1,236 @@
+package collections_test
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/chiranjeevipavurala/gocollections/maps"
+)
+
+func TestImmutableListOf(t *testing.T) {
+	values := []int{1, 2, 3, 2}
+	list := collections.ImmutableListOf(values...)
+	values[0] = 100
+
+	assert.Equal(t, 4, list.Size())
+	assert.False(t, list.IsEmpty())
+	assert.Equal(t, []int{1, 2, 3, 2}, list.ToArray())
+	assert.Equal(t, 1, list.IndexOf(2))
+	assert.Equal(t, 3, list.LastIndexOf(2))
+	assert.Equal(t, -1, list.LastIndexOf(9))
+	assert.True(t, list.Contains(3))
+	assert.True(t, list.Equals(lists.NewArrayListWithInitialCollection([]int{1, 2, 3, 2})))
+	assert.Equal(t, []int{2, 3, 2, 1}, slices.Collect(list.Backward()))
+	assert.Equal(t, []int{2, 3, 2, 1}, list.Reversed().ToArray())
+
+	contains, err := list.ContainsAll(collections.ImmutableListOf(1, 3))
+	assert.NoError(t, err)
+	assert.True(t, contains)
+	_, err = list.ContainsAll(nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+
+	value, err := list.Get(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *value)
+	*value = 100
+	assert.Equal(t, []int{1, 2, 3, 2}, list.ToArray())
+
+	_, err = list.Get(4)
+	var indexErr *errcodes.IndexError
+	if assert.ErrorAs(t, err, &indexErr) {
+		assert.Equal(t, "ImmutableList", indexErr.Type)
+		assert.Equal(t, 4, indexErr.Index)
+	}
+
+	_, err = collections.ImmutableListOf[int]().GetFirst()
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+}
+
+func TestImmutableListOf_RejectsMutators(t *testing.T) {
+	list := collections.ImmutableListOf(1, 2, 3)
+	other := collections.ImmutableListOf(4)
+
+	assert.False(t, list.Add(4))
+	assert.False(t, list.AddAll(other))
+	assert.False(t, list.Remove(1))
+	assert.False(t, list.RemoveAll(other))
+	list.Clear()
+	list.AddFirst(0)
+	list.AddLast(4)
+	list.Sort(nil)
+
+	_, err := list.RemoveFirst()
+	assertUnsupported(t, err, "ImmutableList", "RemoveFirst")
+	_, err = list.RemoveLast()
+	assertUnsupported(t, err, "ImmutableList", "RemoveLast")
+	assertUnsupported(t, list.AddAtIndex(0, 0), "ImmutableList", "AddAtIndex")
+	_, err = list.AddAllAtIndex(0, other)
+	assertUnsupported(t, err, "ImmutableList", "AddAllAtIndex")
+	_, err = list.RemoveAtIndex(0)
+	assertUnsupported(t, err, "ImmutableList", "RemoveAtIndex")
+	_, err = list.Set(0, 0)
+	assertUnsupported(t, err, "ImmutableList", "Set")
+
+	assert.Equal(t, []int{1, 2, 3}, list.ToArray())
+}
+
+func TestImmutableListOf_SubListAndListIterator(t *testing.T) {
+	list := collections.ImmutableListOf(1, 2, 3, 4)
+
+	subList, err := list.SubList(1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, subList.ToArray())
+	assert.False(t, subList.Add(5))
+	_, err = list.SubList(3, 1)
+	assert.ErrorIs(t, err, errcodes.ErrIndexOutOfBounds)
+
+	it, err := list.ListIterator(2)
+	assert.NoError(t, err)
+	value, err := it.Previous()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *value)
+	value, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *value)
+	assert.Equal(t, 2, it.NextIndex())
+	assert.Equal(t, 1, it.PreviousIndex())
+	assertUnsupported(t, it.Remove(), "ImmutableList", "ListIterator.Remove")
+	assertUnsupported(t, it.Set(0), "ImmutableList", "ListIterator.Set")
+	assertUnsupported(t, it.Add(0), "ImmutableList", "ListIterator.Add")
+
+	it, err = list.ListIterator(4)
+	assert.NoError(t, err)
+	_, err = it.Next()
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+	_, err = list.ListIterator(5)
+	assert.ErrorIs(t, err, errcodes.ErrIndexOutOfBounds)
+
+	copied := list.CopyOf(lists.NewArrayListWithInitialCollection([]int{7, 8}))
+	assert.Equal(t, []int{7, 8}, copied.ToArray())
+	assert.False(t, copied.Add(9))
+}
+
+func TestImmutableSetOf(t *testing.T) {
+	set := collections.ImmutableSetOf("b", "a", "b", "c")
+
+	assert.Equal(t, 3, set.Size())
+	assert.Equal(t, []string{"b", "a", "c"}, set.ToArray())
+	assert.True(t, set.Contains("a"))
+	assert.False(t, set.Contains("d"))
+	assert.True(t, set.Equals(collections.ImmutableSetOf("a", "b", "c")))
+	assert.False(t, set.Equals(collections.ImmutableSetOf("a", "b")))
+
+	assert.False(t, set.Add("d"))
+	assert.False(t, set.Remove("a"))
+	set.Clear()
+	assert.Equal(t, 3, set.Size())
+}
+
+func TestImmutableMapOf(t *testing.T) {
+	m := collections.ImmutableMapOf[string, int](
+		collections.NewHashMapEntry("b", 2),
+		collections.NewHashMapEntry("a", 1),
+		nil,
+		collections.NewHashMapEntry("b", 3),
+	)
+
+	assert.Equal(t, 2, m.Size())
+	assert.Equal(t, 3, *m.Get("b"))
+	assert.Nil(t, m.Get("c"))
+	assert.True(t, m.HasKey("a"))
+	assert.True(t, m.HasValue(3))
+	assert.False(t, m.HasValue(2))
+	assert.Equal(t, []string{"b", "a"}, slices.Collect(m.Keys()))
+	assert.Equal(t, []int{3, 1}, slices.Collect(m.AllValues()))
+	assert.Equal(t, []string{"b", "a"}, m.KeySet().ToArray())
+	assert.Equal(t, []int{3, 1}, m.Values().ToArray())
+	assert.Equal(t, 2, m.EntrySet().Size())
+
+	other := maps.NewHashMap[string, int]()
+	other.Put("a", 1)
+	other.Put("b", 3)
+	assert.True(t, m.Equals(other))
+	assert.True(t, other.Equals(m))
+	other.Put("b", 4)
+	assert.False(t, m.Equals(other))
+	assert.False(t, m.Equals("map"))
+
+	assert.Equal(t, 0, m.Put("c", 4))
+	assert.Equal(t, 0, m.PutIfAbsent("c", 4))
+	assert.Equal(t, 0, m.Remove("a"))
+	assert.Equal(t, 0, m.Replace("a", 4))
+	assert.False(t, m.RemoveKeyWithValue("a", 1))
+	assert.False(t, m.ReplaceKeyWithValue("a", 1, 4))
+	m.PutAll(other)
+	m.Clear()
+	assert.False(t, m.KeySet().Add("c"))
+	*m.Get("a") = 100
+	_, err := m.Compute("a", func(string, *int) (int, bool) { return 4, true })
+	assert.ErrorIs(t, err, errcodes.ErrUnsupportedOperation)
+	_, err = m.ComputeIfPresent("a", func(string, int) (int, bool) { return 4, true })
+	assert.ErrorIs(t, err, errcodes.ErrUnsupportedOperation)
+	_, err = m.Merge("c", 4, func(int, int) (int, bool) { return 4, true })
+	assert.ErrorIs(t, err, errcodes.ErrUnsupportedOperation)
+
+	assert.Equal(t, 2, m.Size())
+	assert.Equal(t, 1, *m.Get("a"))
+}
+
+func TestImmutableMapOfWithValueEquality(t *testing.T) {
+	m := collections.ImmutableMapOfWithValueEquality[string, []byte](nil,
+		collections.NewHashMapEntry("a", []byte("x")),
+		collections.NewHashMapEntry("b", []byte("y")),
+	)
+
+	assert.True(t, m.HasValue([]byte("x")))
+	assert.False(t, m.HasValue([]byte("z")))
+	values := m.Values().(collections.List[[]byte])
+	assert.True(t, values.Contains([]byte("y")))
+	assert.Equal(t, 1, values.IndexOf([]byte("y")))
+	assert.Equal(t, 0, values.LastIndexOf([]byte("x")))
+	sub, err := values.SubList(1, 2)
+	assert.NoError(t, err)
+	assert.False(t, sub.Contains([]byte("x")))
+
+	other := maps.NewHashMapWithValueEquality[string, []byte](nil)
+	other.Put("a", []byte("x"))
+	other.Put("b", []byte("y"))
+	assert.True(t, m.Equals(other))
+	assert.True(t, other.Equals(m))
+	other.Put("b", []byte("z"))
+	assert.False(t, m.Equals(other))
+
+	folded := collections.ImmutableMapOfWithValueEquality[int, string](
+		collections.EqualerFunc[string](strings.EqualFold),
+		collections.NewHashMapEntry(1, "Go"),
+	)
+	assert.True(t, folded.HasValue("GO"))
+	assert.True(t, folded.Values().Contains("go"))
+}
+
+func TestImmutable_ConcurrentReads(t *testing.T) {
+	list := collections.ImmutableListOf(1, 2, 3)
+	m := collections.ImmutableMapOf[int, int](collections.NewHashMapEntry(1, 1))
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				_, _ = list.Get(1)
+				_ = list.ToArray()
+				_ = m.Get(1)
+				_ = m.KeySet().Contains(1)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,32 @@
+package collections
+
+import errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+
+// snapshotIterator iterates over a fixed slice of elements.
+type snapshotIterator[E any] struct {
+	elements []E
+	cursor   int
+}
+
+// NewSnapshotIterator returns an iterator over the given elements.
+// The iterator takes ownership of the slice, so callers should pass a copy of
+// their backing store. It never fails with ConcurrentModificationError because
+// later changes to the source collection are not visible to it.
+func NewSnapshotIterator[E any](elements []E) Iterator[E] {
+	return &snapshotIterator[E]{elements: elements}
+}
+
+// HasNext returns true if the iteration has more elements.
+func (it *snapshotIterator[E]) HasNext() bool {
+	return it.cursor < len(it.elements)
+}
+
+// Next returns the next element in the iteration.
+func (it *snapshotIterator[E]) Next() (*E, error) {
+	if it.cursor >= len(it.elements) {
+		return nil, errcodes.ErrNoSuchElement
+	}
+	value := it.elements[it.cursor]
+	it.cursor++
+	return &value, nil
+}
@@ -0,0 +1,558 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// The synchronized views guard the collection or map they wrap with a read-write mutex,
+// so that a collection created without locks, such as one from NewUnsynchronizedArrayList,
+// can be shared between goroutines as long as every access goes through the view.
+// Iterator, All and the other sequences work on a copy of the elements taken under the
+// lock. Sub-lists, list iterators, reversed views and key, value and entry sets share the
+// lock of the view they came from. Wrapping a synchronized view again returns it unchanged.
+
+// guardedCollection is implemented by the synchronized views of collections.
+type guardedCollection[E any] interface {
+	guarded() (Collection[E], *sync.RWMutex)
+}
+
+// guardedMap is implemented by the synchronized views of maps.
+type guardedMap[K any, V any] interface {
+	guarded() (Map[K, V], *sync.RWMutex)
+}
+
+// SynchronizedCollection returns a synchronized view of collection, or nil if collection is nil.
+func SynchronizedCollection[E any](collection Collection[E]) Collection[E] {
+	if _, ok := collection.(guardedCollection[E]); ok || collection == nil {
+		return collection
+	}
+	return &synchronizedCollection[E]{c: collection, mu: &sync.RWMutex{}}
+}
+
+// Synchronized returns a synchronized view of list, or nil if list is nil.
+func Synchronized[E any](list List[E]) List[E] {
+	if _, ok := list.(guardedCollection[E]); ok || list == nil {
+		return list
+	}
+	return newSynchronizedList(list, &sync.RWMutex{})
+}
+
+// SynchronizedSet returns a synchronized view of set, or nil if set is nil.
+func SynchronizedSet[E any](set Set[E]) Set[E] {
+	return SynchronizedCollection[E](set)
+}
+
+// SynchronizedMap returns a synchronized view of m, or nil if m is nil.
+func SynchronizedMap[K any, V any](m Map[K, V]) Map[K, V] {
+	if _, ok := m.(guardedMap[K, V]); ok || m == nil {
+		return m
+	}
+	return &synchronizedMap[K, V]{m: m, mu: &sync.RWMutex{}}
+}
+
+// synchronizedOf returns a view of collection guarded by mu, which is a List when
+// collection is one.
+func synchronizedOf[E any](collection Collection[E], mu *sync.RWMutex) Collection[E] {
+	if list, ok := collection.(List[E]); ok {
+		return newSynchronizedList(list, mu)
+	}
+	return &synchronizedCollection[E]{c: collection, mu: mu}
+}
+
+// unguarded returns the collection wrapped by collection if it is a view guarded by mu,
+// whose methods would deadlock if they were called while mu is held, and collection
+// itself otherwise.
+func unguarded[E any](collection Collection[E], mu *sync.RWMutex) Collection[E] {
+	if g, ok := collection.(guardedCollection[E]); ok {
+		if c, guard := g.guarded(); guard == mu {
+			return c
+		}
+	}
+	return collection
+}
+
+type synchronizedCollection[E any] struct {
+	c  Collection[E]
+	mu *sync.RWMutex
+}
+
+func (s *synchronizedCollection[E]) guarded() (Collection[E], *sync.RWMutex) {
+	return s.c, s.mu
+}
+
+// Iterator returns an iterator over a copy of the elements taken now.
+func (s *synchronizedCollection[E]) Iterator() Iterator[E] {
+	return NewSnapshotIterator(s.ToArray())
+}
+
+func (s *synchronizedCollection[E]) Add(element E) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Add(element)
+}
+
+func (s *synchronizedCollection[E]) AddAll(collection Collection[E]) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.AddAll(unguarded(collection, s.mu))
+}
+
+func (s *synchronizedCollection[E]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Clear()
+}
+
+func (s *synchronizedCollection[E]) Contains(element E) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Contains(element)
+}
+
+func (s *synchronizedCollection[E]) ContainsAll(collection Collection[E]) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.ContainsAll(unguarded(collection, s.mu))
+}
+
+func (s *synchronizedCollection[E]) Equals(collection Collection[E]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Equals(unguarded(collection, s.mu))
+}
+
+func (s *synchronizedCollection[E]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.IsEmpty()
+}
+
+func (s *synchronizedCollection[E]) Remove(element E) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Remove(element)
+}
+
+func (s *synchronizedCollection[E]) RemoveAll(collection Collection[E]) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.RemoveAll(unguarded(collection, s.mu))
+}
+
+func (s *synchronizedCollection[E]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Size()
+}
+
+func (s *synchronizedCollection[E]) ToArray() []E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.ToArray()
+}
+
+// All returns a sequence over a copy of the elements taken when iteration starts.
+func (s *synchronizedCollection[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, element := range s.ToArray() {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+type synchronizedList[E any] struct {
+	*synchronizedCollection[E]
+	l List[E]
+}
+
+func newSynchronizedList[E any](list List[E], mu *sync.RWMutex) *synchronizedList[E] {
+	return &synchronizedList[E]{
+		synchronizedCollection: &synchronizedCollection[E]{c: list, mu: mu},
+		l:                      list,
+	}
+}
+
+func (s *synchronizedList[E]) AddFirst(val E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.AddFirst(val)
+}
+
+func (s *synchronizedList[E]) AddLast(val E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.AddLast(val)
+}
+
+func (s *synchronizedList[E]) GetFirst() (*E, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.GetFirst()
+}
+
+func (s *synchronizedList[E]) GetLast() (*E, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.GetLast()
+}
+
+func (s *synchronizedList[E]) RemoveFirst() (*E, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.RemoveFirst()
+}
+
+func (s *synchronizedList[E]) RemoveLast() (*E, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.RemoveLast()
+}
+
+func (s *synchronizedList[E]) Reversed() Collection[E] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return synchronizedOf(s.l.Reversed(), s.mu)
+}
+
+// Backward returns a sequence over a copy of the elements taken when iteration starts,
+// from last to first.
+func (s *synchronizedList[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, element := range slices.Backward(s.ToArray()) {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+func (s *synchronizedList[E]) AddAtIndex(index int, element E) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.AddAtIndex(index, element)
+}
+
+func (s *synchronizedList[E]) AddAllAtIndex(index int, elements Collection[E]) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.AddAllAtIndex(index, unguarded(elements, s.mu))
+}
+
+func (s *synchronizedList[E]) CopyOf(collection Collection[E]) List[E] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.CopyOf(unguarded(collection, s.mu))
+}
+
+func (s *synchronizedList[E]) Get(index int) (*E, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Get(index)
+}
+
+func (s *synchronizedList[E]) IndexOf(element E) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.IndexOf(element)
+}
+
+func (s *synchronizedList[E]) LastIndexOf(element E) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.LastIndexOf(element)
+}
+
+func (s *synchronizedList[E]) RemoveAtIndex(index int) (*E, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.RemoveAtIndex(index)
+}
+
+func (s *synchronizedList[E]) Set(index int, element E) (*E, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.Set(index, element)
+}
+
+func (s *synchronizedList[E]) Sort(comparator Comparator[E]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Sort(comparator)
+}
+
+func (s *synchronizedList[E]) SubList(fromIndex int, toIndex int) (List[E], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	subList, err := s.l.SubList(fromIndex, toIndex)
+	if err != nil {
+		return nil, err
+	}
+	return newSynchronizedList(subList, s.mu), nil
+}
+
+func (s *synchronizedList[E]) ListIterator(index int) (ListIterator[E], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	it, err := s.l.ListIterator(index)
+	if err != nil {
+		return nil, err
+	}
+	return &synchronizedListIterator[E]{it: it, mu: s.mu}, nil
+}
+
+// synchronizedListIterator calls the list iterator it wraps under the lock of its list.
+// Like other iterators, it must not itself be shared between goroutines.
+type synchronizedListIterator[E any] struct {
+	it ListIterator[E]
+	mu *sync.RWMutex
+}
+
+func (s *synchronizedListIterator[E]) HasNext() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.HasNext()
+}
+
+func (s *synchronizedListIterator[E]) Next() (*E, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.Next()
+}
+
+func (s *synchronizedListIterator[E]) HasPrevious() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.HasPrevious()
+}
+
+func (s *synchronizedListIterator[E]) Previous() (*E, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.Previous()
+}
+
+func (s *synchronizedListIterator[E]) NextIndex() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.NextIndex()
+}
+
+func (s *synchronizedListIterator[E]) PreviousIndex() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.PreviousIndex()
+}
+
+func (s *synchronizedListIterator[E]) Remove() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.it.Remove()
+}
+
+func (s *synchronizedListIterator[E]) Set(element E) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.it.Set(element)
+}
+
+func (s *synchronizedListIterator[E]) Add(element E) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.it.Add(element)
+}
+
+type synchronizedMap[K any, V any] struct {
+	m  Map[K, V]
+	mu *sync.RWMutex
+}
+
+func (s *synchronizedMap[K, V]) guarded() (Map[K, V], *sync.RWMutex) {
+	return s.m, s.mu
+}
+
+// unguarded returns the map wrapped by m if it is a view guarded by the lock of s, and m
+// itself otherwise.
+func (s *synchronizedMap[K, V]) unguarded(m Map[K, V]) Map[K, V] {
+	if g, ok := m.(guardedMap[K, V]); ok {
+		if inner, guard := g.guarded(); guard == s.mu {
+			return inner
+		}
+	}
+	return m
+}
+
+func (s *synchronizedMap[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Clear()
+}
+
+func (s *synchronizedMap[K, V]) HasKey(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.HasKey(key)
+}
+
+func (s *synchronizedMap[K, V]) HasValue(value V) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.HasValue(value)
+}
+
+func (s *synchronizedMap[K, V]) EntrySet() Set[MapEntry[K, V]] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &synchronizedCollection[MapEntry[K, V]]{c: s.m.EntrySet(), mu: s.mu}
+}
+
+func (s *synchronizedMap[K, V]) Equals(obj any) bool {
+	if m, ok := obj.(Map[K, V]); ok {
+		obj = s.unguarded(m)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Equals(obj)
+}
+
+func (s *synchronizedMap[K, V]) Get(key K) *V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Get(key)
+}
+
+func (s *synchronizedMap[K, V]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.IsEmpty()
+}
+
+func (s *synchronizedMap[K, V]) KeySet() Set[K] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &synchronizedCollection[K]{c: s.m.KeySet(), mu: s.mu}
+}
+
+func (s *synchronizedMap[K, V]) Put(key K, value V) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Put(key, value)
+}
+
+func (s *synchronizedMap[K, V]) PutAll(m Map[K, V]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.PutAll(s.unguarded(m))
+}
+
+func (s *synchronizedMap[K, V]) PutIfAbsent(key K, value V) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.PutIfAbsent(key, value)
+}
+
+func (s *synchronizedMap[K, V]) Remove(key K) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Remove(key)
+}
+
+func (s *synchronizedMap[K, V]) RemoveKeyWithValue(key K, value V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.RemoveKeyWithValue(key, value)
+}
+
+func (s *synchronizedMap[K, V]) Replace(key K, value V) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Replace(key, value)
+}
+
+func (s *synchronizedMap[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.ReplaceKeyWithValue(key, oldValue, newValue)
+}
+
+func (s *synchronizedMap[K, V]) Compute(key K, remappingFunction func(key K, oldValue *V) (V, bool)) (*V, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Compute(key, remappingFunction)
+}
+
+func (s *synchronizedMap[K, V]) ComputeIfPresent(key K, remappingFunction func(key K, oldValue V) (V, bool)) (*V, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.ComputeIfPresent(key, remappingFunction)
+}
+
+func (s *synchronizedMap[K, V]) Merge(key K, value V, remappingFunction func(oldValue V, value V) (V, bool)) (*V, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Merge(key, value, remappingFunction)
+}
+
+func (s *synchronizedMap[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Size()
+}
+
+func (s *synchronizedMap[K, V]) Values() Collection[V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return synchronizedOf(s.m.Values(), s.mu)
+}
+
+// All returns a sequence over a copy of the mappings taken when iteration starts.
+func (s *synchronizedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		keys, values := s.snapshot()
+		for i, key := range keys {
+			if !yield(key, values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence over a copy of the keys taken when iteration starts.
+func (s *synchronizedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		keys, _ := s.snapshot()
+		for _, key := range keys {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over a copy of the values taken when iteration starts.
+func (s *synchronizedMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		_, values := s.snapshot()
+		for _, value := range values {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns the keys and values of the map, in iteration order, under the read lock.
+func (s *synchronizedMap[K, V]) snapshot() ([]K, []V) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]K, 0, s.m.Size())
+	values := make([]V, 0, s.m.Size())
+	for key, value := range s.m.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	return keys, values
+}
@@ -0,0 +1,203 @@
+package collections_test
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/chiranjeevipavurala/gocollections/maps"
+	"github.com/chiranjeevipavurala/gocollections/sets"
+)
+
+func TestSynchronized_ConcurrentWrites(t *testing.T) {
+	list := collections.Synchronized[int](lists.NewUnsynchronizedArrayList[int]())
+
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				list.Add(g*100 + i)
+				_ = list.Contains(i)
+				_, _ = list.GetLast()
+				for range list.All() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	values := list.ToArray()
+	sort.Ints(values)
+	assert.Len(t, values, 800)
+	for i, v := range values {
+		assert.Equal(t, i, v)
+	}
+}
+
+func TestSynchronized_List(t *testing.T) {
+	backing := lists.NewUnsynchronizedArrayList[int]()
+	list := collections.Synchronized[int](backing)
+	assert.Same(t, list, collections.Synchronized(list))
+	assert.Nil(t, collections.Synchronized[int](nil))
+
+	list.AddLast(3)
+	list.AddFirst(1)
+	assert.NoError(t, list.AddAtIndex(1, 2))
+	assert.Equal(t, []int{1, 2, 3}, backing.ToArray())
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(list.Backward()))
+	assert.Equal(t, 1, list.IndexOf(2))
+
+	old, err := list.Set(0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *old)
+	list.Sort(comparators.Reverse(comparators.Natural[int]()))
+	assert.Equal(t, []int{3, 2, 0}, list.ToArray())
+
+	_, err = list.Get(3)
+	assert.ErrorIs(t, err, errcodes.ErrIndexOutOfBounds)
+
+	subList, err := list.SubList(0, 2)
+	assert.NoError(t, err)
+	subList.Clear()
+	assert.Equal(t, []int{0}, list.ToArray())
+
+	it, err := list.ListIterator(0)
+	assert.NoError(t, err)
+	_, err = it.Next()
+	assert.NoError(t, err)
+	assert.NoError(t, it.Set(5))
+	assert.NoError(t, it.Add(6))
+	assert.Equal(t, []int{5, 6}, list.ToArray())
+
+	reversed := list.Reversed()
+	assert.Equal(t, []int{6, 5}, reversed.ToArray())
+}
+
+func TestSynchronized_SelfArgumentsDoNotDeadlock(t *testing.T) {
+	list := collections.Synchronized[int](lists.NewUnsynchronizedArrayList[int]())
+	list.Add(1)
+	list.Add(2)
+
+	assert.True(t, list.AddAll(list))
+	assert.Equal(t, []int{1, 2, 1, 2}, list.ToArray())
+	contains, err := list.ContainsAll(list)
+	assert.NoError(t, err)
+	assert.True(t, contains)
+	assert.True(t, list.Equals(list))
+
+	subList, err := list.SubList(0, 2)
+	assert.NoError(t, err)
+	_, err = list.AddAllAtIndex(0, subList)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 1, 2, 1, 2}, list.ToArray())
+
+	m := collections.SynchronizedMap(maps.NewHashMap[string, int](maps.Unsynchronized()))
+	m.Put("a", 1)
+	m.PutAll(m)
+	assert.True(t, m.Equals(m))
+	assert.Equal(t, 1, m.Size())
+}
+
+func TestSynchronized_SetAndCollection(t *testing.T) {
+	set := collections.SynchronizedSet(sets.NewUnsynchronizedHashSet[string]())
+	assert.True(t, set.Add("a"))
+	assert.False(t, set.Add("a"))
+	assert.True(t, set.Contains("a"))
+	assert.True(t, set.Remove("a"))
+	assert.True(t, set.IsEmpty())
+
+	collection := collections.SynchronizedCollection[int](lists.NewUnsynchronizedLinkedList[int]())
+	collection.Add(1)
+	collection.Add(2)
+	it := collection.Iterator()
+	collection.Clear()
+	var seen []int
+	for it.HasNext() {
+		value, err := it.Next()
+		assert.NoError(t, err)
+		seen = append(seen, *value)
+	}
+	assert.Equal(t, []int{1, 2}, seen)
+	assert.Equal(t, 0, collection.Size())
+}
+
+func TestSynchronized_Map(t *testing.T) {
+	backing := maps.NewHashMap[string, int](maps.Unsynchronized())
+	m := collections.SynchronizedMap(backing)
+	assert.Same(t, m, collections.SynchronizedMap(m))
+
+	var wg sync.WaitGroup
+	for g := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 50 {
+				key := string(rune('a' + g))
+				m.Put(key, i)
+				_ = m.Get(key)
+				for range m.All() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 4, m.Size())
+	assert.Equal(t, 49, *m.Get("a"))
+	assert.True(t, m.HasValue(49))
+	assert.Equal(t, 49, m.PutIfAbsent("a", 0))
+	assert.Equal(t, 49, m.Replace("a", 1))
+	assert.True(t, m.ReplaceKeyWithValue("a", 1, 2))
+	assert.True(t, m.RemoveKeyWithValue("a", 2))
+	assert.Equal(t, 49, m.Remove("b"))
+
+	keys := slices.Sorted(m.Keys())
+	assert.Equal(t, []string{"c", "d"}, keys)
+	assert.Equal(t, []int{49, 49}, slices.Collect(m.AllValues()))
+	assert.True(t, m.KeySet().Contains("c"))
+	assert.Equal(t, 2, m.EntrySet().Size())
+	assert.True(t, m.Values().Contains(49))
+	assert.True(t, m.Equals(backing))
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+}
+
+func TestSynchronized_MapCompute(t *testing.T) {
+	m := collections.SynchronizedMap(maps.NewHashMap[string, int](maps.Unsynchronized()))
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				_, _ = m.Merge("count", 1, sum)
+				_, _ = m.Compute("computed", func(k string, v *int) (int, bool) {
+					if v == nil {
+						return 1, true
+					}
+					return *v + 1, true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 800, *m.Get("count"))
+	assert.Equal(t, 800, *m.Get("computed"))
+	value, err := m.ComputeIfPresent("count", func(k string, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.False(t, m.HasKey("count"))
+}
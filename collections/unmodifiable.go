@@ -0,0 +1,761 @@
+package collections
+
+import (
+	"iter"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// The unmodifiable views read through to the collection or map they wrap, so they see the
+// changes made to it by its owner, but they cannot change it themselves. A mutator that
+// returns an error fails with UnsupportedOperationError, one that returns a bool returns
+// false, and the others do nothing and return the zero value. Elements are returned as
+// pointers to copies, and the iterators, sub-lists, views and key, value and entry sets
+// they return are unmodifiable as well. Wrapping a view, or an immutable collection, again
+// returns it unchanged.
+
+// readOnly is implemented by the unmodifiable views and the immutable collections.
+type readOnly interface {
+	readOnly()
+}
+
+// UnmodifiableCollection returns an unmodifiable view of collection, or nil if collection is nil.
+func UnmodifiableCollection[E any](collection Collection[E]) Collection[E] {
+	if _, ok := collection.(readOnly); ok || collection == nil {
+		return collection
+	}
+	return &unmodifiableCollection[E]{c: collection, name: "UnmodifiableCollection"}
+}
+
+// UnmodifiableSequencedCollection returns an unmodifiable view of collection, or nil if
+// collection is nil.
+func UnmodifiableSequencedCollection[E any](collection SequencedCollection[E]) SequencedCollection[E] {
+	if _, ok := collection.(readOnly); ok || collection == nil {
+		return collection
+	}
+	return newUnmodifiableSequencedCollection(collection, "UnmodifiableSequencedCollection")
+}
+
+// Unmodifiable returns an unmodifiable view of list, or nil if list is nil.
+func Unmodifiable[E any](list List[E]) List[E] {
+	if _, ok := list.(readOnly); ok || list == nil {
+		return list
+	}
+	return &unmodifiableList[E]{
+		unmodifiableSequencedCollection: newUnmodifiableSequencedCollection[E](list, "UnmodifiableList"),
+		l:                               list,
+	}
+}
+
+// UnmodifiableQueue returns an unmodifiable view of queue, or nil if queue is nil.
+func UnmodifiableQueue[E any](queue Queue[E]) Queue[E] {
+	if _, ok := queue.(readOnly); ok || queue == nil {
+		return queue
+	}
+	return newUnmodifiableQueue(queue, "UnmodifiableQueue")
+}
+
+// UnmodifiableDeque returns an unmodifiable view of deque, or nil if deque is nil.
+func UnmodifiableDeque[E any](deque Deque[E]) Deque[E] {
+	if _, ok := deque.(readOnly); ok || deque == nil {
+		return deque
+	}
+	return &unmodifiableDeque[E]{
+		unmodifiableQueue: newUnmodifiableQueue[E](deque, "UnmodifiableDeque"),
+		d:                 deque,
+	}
+}
+
+// UnmodifiableSet returns an unmodifiable view of set, or nil if set is nil.
+func UnmodifiableSet[E any](set Set[E]) Set[E] {
+	if _, ok := set.(readOnly); ok || set == nil {
+		return set
+	}
+	return &unmodifiableCollection[E]{c: set, name: "UnmodifiableSet"}
+}
+
+// UnmodifiableSortedSet returns an unmodifiable view of set, or nil if set is nil.
+func UnmodifiableSortedSet[E any](set SortedSet[E]) SortedSet[E] {
+	if _, ok := set.(readOnly); ok || set == nil {
+		return set
+	}
+	return newUnmodifiableSortedSet(set, "UnmodifiableSortedSet")
+}
+
+// UnmodifiableNavigableSet returns an unmodifiable view of set, or nil if set is nil.
+func UnmodifiableNavigableSet[E any](set NavigableSet[E]) NavigableSet[E] {
+	if _, ok := set.(readOnly); ok || set == nil {
+		return set
+	}
+	return &unmodifiableNavigableSet[E]{
+		unmodifiableSortedSet: newUnmodifiableSortedSet[E](set, "UnmodifiableNavigableSet"),
+		n:                     set,
+	}
+}
+
+// UnmodifiableMap returns an unmodifiable view of m, or nil if m is nil.
+func UnmodifiableMap[K any, V any](m Map[K, V]) Map[K, V] {
+	if _, ok := m.(readOnly); ok || m == nil {
+		return m
+	}
+	return &unmodifiableMap[K, V]{m: m, name: "UnmodifiableMap"}
+}
+
+// UnmodifiableSortedMap returns an unmodifiable view of m, or nil if m is nil.
+func UnmodifiableSortedMap[K any, V any](m SortedMap[K, V]) SortedMap[K, V] {
+	if _, ok := m.(readOnly); ok || m == nil {
+		return m
+	}
+	return newUnmodifiableSortedMap(m, "UnmodifiableSortedMap")
+}
+
+// UnmodifiableNavigableMap returns an unmodifiable view of m, or nil if m is nil.
+func UnmodifiableNavigableMap[K any, V any](m NavigableMap[K, V]) NavigableMap[K, V] {
+	if _, ok := m.(readOnly); ok || m == nil {
+		return m
+	}
+	return &unmodifiableNavigableMap[K, V]{
+		unmodifiableSortedMap: newUnmodifiableSortedMap[K, V](m, "UnmodifiableNavigableMap"),
+		n:                     m,
+	}
+}
+
+// unmodifiableOf returns an unmodifiable view of collection that is a List or a
+// SequencedCollection when collection is one.
+func unmodifiableOf[E any](collection Collection[E]) Collection[E] {
+	switch c := collection.(type) {
+	case List[E]:
+		return Unmodifiable(c)
+	case SequencedCollection[E]:
+		return UnmodifiableSequencedCollection(c)
+	default:
+		return UnmodifiableCollection(collection)
+	}
+}
+
+// detach returns a pointer to a copy of the element p points to, so that the caller cannot
+// change the wrapped collection through it.
+func detach[E any](p *E, err error) (*E, error) {
+	if p == nil {
+		return nil, err
+	}
+	value := *p
+	return &value, err
+}
+
+// unsupported returns the error of a mutator called on the view name.
+func unsupported(name, op string) error {
+	return errcodes.New(errcodes.UnsupportedOperationError, name, op)
+}
+
+type unmodifiableCollection[E any] struct {
+	c    Collection[E]
+	name string
+}
+
+func (u *unmodifiableCollection[E]) readOnly() {}
+
+func (u *unmodifiableCollection[E]) Iterator() Iterator[E] {
+	return &unmodifiableIterator[E]{it: u.c.Iterator()}
+}
+
+func (u *unmodifiableCollection[E]) Add(element E) bool {
+	return false
+}
+
+func (u *unmodifiableCollection[E]) AddAll(collection Collection[E]) bool {
+	return false
+}
+
+func (u *unmodifiableCollection[E]) Clear() {}
+
+func (u *unmodifiableCollection[E]) Contains(element E) bool {
+	return u.c.Contains(element)
+}
+
+func (u *unmodifiableCollection[E]) ContainsAll(collection Collection[E]) (bool, error) {
+	return u.c.ContainsAll(collection)
+}
+
+func (u *unmodifiableCollection[E]) Equals(collection Collection[E]) bool {
+	return u.c.Equals(collection)
+}
+
+func (u *unmodifiableCollection[E]) IsEmpty() bool {
+	return u.c.IsEmpty()
+}
+
+func (u *unmodifiableCollection[E]) Remove(element E) bool {
+	return false
+}
+
+func (u *unmodifiableCollection[E]) RemoveAll(collection Collection[E]) bool {
+	return false
+}
+
+func (u *unmodifiableCollection[E]) Size() int {
+	return u.c.Size()
+}
+
+func (u *unmodifiableCollection[E]) ToArray() []E {
+	return u.c.ToArray()
+}
+
+func (u *unmodifiableCollection[E]) All() iter.Seq[E] {
+	return u.c.All()
+}
+
+type unmodifiableSequencedCollection[E any] struct {
+	*unmodifiableCollection[E]
+	s SequencedCollection[E]
+}
+
+func newUnmodifiableSequencedCollection[E any](collection SequencedCollection[E], name string) *unmodifiableSequencedCollection[E] {
+	return &unmodifiableSequencedCollection[E]{
+		unmodifiableCollection: &unmodifiableCollection[E]{c: collection, name: name},
+		s:                      collection,
+	}
+}
+
+func (u *unmodifiableSequencedCollection[E]) AddFirst(val E) {}
+
+func (u *unmodifiableSequencedCollection[E]) AddLast(val E) {}
+
+func (u *unmodifiableSequencedCollection[E]) GetFirst() (*E, error) {
+	return detach(u.s.GetFirst())
+}
+
+func (u *unmodifiableSequencedCollection[E]) GetLast() (*E, error) {
+	return detach(u.s.GetLast())
+}
+
+func (u *unmodifiableSequencedCollection[E]) RemoveFirst() (*E, error) {
+	return nil, unsupported(u.name, "RemoveFirst")
+}
+
+func (u *unmodifiableSequencedCollection[E]) RemoveLast() (*E, error) {
+	return nil, unsupported(u.name, "RemoveLast")
+}
+
+func (u *unmodifiableSequencedCollection[E]) Reversed() Collection[E] {
+	return unmodifiableOf(u.s.Reversed())
+}
+
+func (u *unmodifiableSequencedCollection[E]) Backward() iter.Seq[E] {
+	return u.s.Backward()
+}
+
+type unmodifiableList[E any] struct {
+	*unmodifiableSequencedCollection[E]
+	l List[E]
+}
+
+func (u *unmodifiableList[E]) AddAtIndex(index int, element E) error {
+	return unsupported(u.name, "AddAtIndex")
+}
+
+func (u *unmodifiableList[E]) AddAllAtIndex(index int, elements Collection[E]) (bool, error) {
+	return false, unsupported(u.name, "AddAllAtIndex")
+}
+
+// CopyOf returns the result of CopyOf on the wrapped list, which is a new list that does
+// not share its elements with the wrapped list.
+func (u *unmodifiableList[E]) CopyOf(collection Collection[E]) List[E] {
+	return u.l.CopyOf(collection)
+}
+
+func (u *unmodifiableList[E]) Get(index int) (*E, error) {
+	return detach(u.l.Get(index))
+}
+
+func (u *unmodifiableList[E]) IndexOf(element E) int {
+	return u.l.IndexOf(element)
+}
+
+func (u *unmodifiableList[E]) LastIndexOf(element E) int {
+	return u.l.LastIndexOf(element)
+}
+
+func (u *unmodifiableList[E]) RemoveAtIndex(index int) (*E, error) {
+	return nil, unsupported(u.name, "RemoveAtIndex")
+}
+
+func (u *unmodifiableList[E]) Set(index int, element E) (*E, error) {
+	return nil, unsupported(u.name, "Set")
+}
+
+func (u *unmodifiableList[E]) Sort(comparator Comparator[E]) {}
+
+func (u *unmodifiableList[E]) SubList(fromIndex int, toIndex int) (List[E], error) {
+	subList, err := u.l.SubList(fromIndex, toIndex)
+	if err != nil {
+		return nil, err
+	}
+	return Unmodifiable(subList), nil
+}
+
+func (u *unmodifiableList[E]) ListIterator(index int) (ListIterator[E], error) {
+	it, err := u.l.ListIterator(index)
+	if err != nil {
+		return nil, err
+	}
+	return &unmodifiableListIterator[E]{it: it, name: u.name}, nil
+}
+
+type unmodifiableQueue[E any] struct {
+	*unmodifiableCollection[E]
+	q Queue[E]
+}
+
+func newUnmodifiableQueue[E any](queue Queue[E], name string) *unmodifiableQueue[E] {
+	return &unmodifiableQueue[E]{
+		unmodifiableCollection: &unmodifiableCollection[E]{c: queue, name: name},
+		q:                      queue,
+	}
+}
+
+func (u *unmodifiableQueue[E]) Element() (*E, error) {
+	return detach(u.q.Element())
+}
+
+func (u *unmodifiableQueue[E]) Offer(val E) bool {
+	return false
+}
+
+func (u *unmodifiableQueue[E]) Peek() (*E, error) {
+	return detach(u.q.Peek())
+}
+
+func (u *unmodifiableQueue[E]) Poll() (*E, error) {
+	return nil, unsupported(u.name, "Poll")
+}
+
+func (u *unmodifiableQueue[E]) RemoveHead() (*E, error) {
+	return nil, unsupported(u.name, "RemoveHead")
+}
+
+type unmodifiableDeque[E any] struct {
+	*unmodifiableQueue[E]
+	d Deque[E]
+}
+
+func (u *unmodifiableDeque[E]) AddFirst(val E) {}
+
+func (u *unmodifiableDeque[E]) AddLast(val E) {}
+
+func (u *unmodifiableDeque[E]) GetFirst() (*E, error) {
+	return detach(u.d.GetFirst())
+}
+
+func (u *unmodifiableDeque[E]) GetLast() (*E, error) {
+	return detach(u.d.GetLast())
+}
+
+func (u *unmodifiableDeque[E]) RemoveFirst() (*E, error) {
+	return nil, unsupported(u.name, "RemoveFirst")
+}
+
+func (u *unmodifiableDeque[E]) RemoveLast() (*E, error) {
+	return nil, unsupported(u.name, "RemoveLast")
+}
+
+func (u *unmodifiableDeque[E]) Reversed() Collection[E] {
+	return unmodifiableOf(u.d.Reversed())
+}
+
+func (u *unmodifiableDeque[E]) Backward() iter.Seq[E] {
+	return u.d.Backward()
+}
+
+func (u *unmodifiableDeque[E]) DescendingIterator() Iterator[E] {
+	return &unmodifiableIterator[E]{it: u.d.DescendingIterator()}
+}
+
+func (u *unmodifiableDeque[E]) OfferFirst(val E) bool {
+	return false
+}
+
+func (u *unmodifiableDeque[E]) OfferLast(val E) bool {
+	return false
+}
+
+func (u *unmodifiableDeque[E]) PeekFirst() (*E, error) {
+	return detach(u.d.PeekFirst())
+}
+
+func (u *unmodifiableDeque[E]) PeekLast() (*E, error) {
+	return detach(u.d.PeekLast())
+}
+
+func (u *unmodifiableDeque[E]) PollFirst() (*E, error) {
+	return nil, unsupported(u.name, "PollFirst")
+}
+
+func (u *unmodifiableDeque[E]) PollLast() (*E, error) {
+	return nil, unsupported(u.name, "PollLast")
+}
+
+func (u *unmodifiableDeque[E]) Pop() (*E, error) {
+	return nil, unsupported(u.name, "Pop")
+}
+
+func (u *unmodifiableDeque[E]) Push(val E) {}
+
+func (u *unmodifiableDeque[E]) RemoveFirstOccurrence(val E) bool {
+	return false
+}
+
+func (u *unmodifiableDeque[E]) RemoveLastOccurrence(val E) bool {
+	return false
+}
+
+type unmodifiableSortedSet[E any] struct {
+	*unmodifiableCollection[E]
+	s SortedSet[E]
+}
+
+func newUnmodifiableSortedSet[E any](set SortedSet[E], name string) *unmodifiableSortedSet[E] {
+	return &unmodifiableSortedSet[E]{
+		unmodifiableCollection: &unmodifiableCollection[E]{c: set, name: name},
+		s:                      set,
+	}
+}
+
+func (u *unmodifiableSortedSet[E]) Comparator() Comparator[E] {
+	return u.s.Comparator()
+}
+
+func (u *unmodifiableSortedSet[E]) First() (*E, error) {
+	return detach(u.s.First())
+}
+
+func (u *unmodifiableSortedSet[E]) Last() (*E, error) {
+	return detach(u.s.Last())
+}
+
+func (u *unmodifiableSortedSet[E]) HeadSet(toElement E) (SortedSet[E], error) {
+	return unmodifiableSortedSetOf(u.s.HeadSet(toElement))
+}
+
+func (u *unmodifiableSortedSet[E]) TailSet(fromElement E) (SortedSet[E], error) {
+	return unmodifiableSortedSetOf(u.s.TailSet(fromElement))
+}
+
+func (u *unmodifiableSortedSet[E]) SubSet(fromElement E, toElement E) (SortedSet[E], error) {
+	return unmodifiableSortedSetOf(u.s.SubSet(fromElement, toElement))
+}
+
+// unmodifiableSortedSetOf wraps the view returned by HeadSet, TailSet or SubSet.
+func unmodifiableSortedSetOf[E any](set SortedSet[E], err error) (SortedSet[E], error) {
+	if err != nil {
+		return nil, err
+	}
+	return UnmodifiableSortedSet(set), nil
+}
+
+type unmodifiableNavigableSet[E any] struct {
+	*unmodifiableSortedSet[E]
+	n NavigableSet[E]
+}
+
+func (u *unmodifiableNavigableSet[E]) Ceiling(e E) (*E, error) {
+	return detach(u.n.Ceiling(e))
+}
+
+func (u *unmodifiableNavigableSet[E]) Floor(e E) (*E, error) {
+	return detach(u.n.Floor(e))
+}
+
+func (u *unmodifiableNavigableSet[E]) Higher(e E) (*E, error) {
+	return detach(u.n.Higher(e))
+}
+
+func (u *unmodifiableNavigableSet[E]) Lower(e E) (*E, error) {
+	return detach(u.n.Lower(e))
+}
+
+func (u *unmodifiableNavigableSet[E]) PollFirst() (*E, error) {
+	return nil, unsupported(u.name, "PollFirst")
+}
+
+func (u *unmodifiableNavigableSet[E]) PollLast() (*E, error) {
+	return nil, unsupported(u.name, "PollLast")
+}
+
+func (u *unmodifiableNavigableSet[E]) DescendingSet() NavigableSet[E] {
+	return UnmodifiableNavigableSet(u.n.DescendingSet())
+}
+
+func (u *unmodifiableNavigableSet[E]) DescendingIterator() Iterator[E] {
+	return &unmodifiableIterator[E]{it: u.n.DescendingIterator()}
+}
+
+func (u *unmodifiableNavigableSet[E]) Backward() iter.Seq[E] {
+	return u.n.Backward()
+}
+
+type unmodifiableMap[K any, V any] struct {
+	m    Map[K, V]
+	name string
+}
+
+func (u *unmodifiableMap[K, V]) readOnly() {}
+
+func (u *unmodifiableMap[K, V]) Clear() {}
+
+func (u *unmodifiableMap[K, V]) HasKey(key K) bool {
+	return u.m.HasKey(key)
+}
+
+func (u *unmodifiableMap[K, V]) HasValue(value V) bool {
+	return u.m.HasValue(value)
+}
+
+func (u *unmodifiableMap[K, V]) EntrySet() Set[MapEntry[K, V]] {
+	return UnmodifiableSet(u.m.EntrySet())
+}
+
+func (u *unmodifiableMap[K, V]) Equals(obj any) bool {
+	return u.m.Equals(obj)
+}
+
+func (u *unmodifiableMap[K, V]) Get(key K) *V {
+	value, _ := detach(u.m.Get(key), nil)
+	return value
+}
+
+func (u *unmodifiableMap[K, V]) IsEmpty() bool {
+	return u.m.IsEmpty()
+}
+
+func (u *unmodifiableMap[K, V]) KeySet() Set[K] {
+	return UnmodifiableSet(u.m.KeySet())
+}
+
+func (u *unmodifiableMap[K, V]) Put(key K, value V) V {
+	var zero V
+	return zero
+}
+
+func (u *unmodifiableMap[K, V]) PutAll(m Map[K, V]) {}
+
+func (u *unmodifiableMap[K, V]) PutIfAbsent(key K, value V) V {
+	var zero V
+	return zero
+}
+
+func (u *unmodifiableMap[K, V]) Remove(key K) V {
+	var zero V
+	return zero
+}
+
+func (u *unmodifiableMap[K, V]) RemoveKeyWithValue(key K, value V) bool {
+	return false
+}
+
+func (u *unmodifiableMap[K, V]) Replace(key K, value V) V {
+	var zero V
+	return zero
+}
+
+func (u *unmodifiableMap[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bool {
+	return false
+}
+
+func (u *unmodifiableMap[K, V]) Compute(key K, remappingFunction func(key K, oldValue *V) (V, bool)) (*V, error) {
+	return nil, unsupported(u.name, "Compute")
+}
+
+func (u *unmodifiableMap[K, V]) ComputeIfPresent(key K, remappingFunction func(key K, oldValue V) (V, bool)) (*V, error) {
+	return nil, unsupported(u.name, "ComputeIfPresent")
+}
+
+func (u *unmodifiableMap[K, V]) Merge(key K, value V, remappingFunction func(oldValue V, value V) (V, bool)) (*V, error) {
+	return nil, unsupported(u.name, "Merge")
+}
+
+func (u *unmodifiableMap[K, V]) Size() int {
+	return u.m.Size()
+}
+
+func (u *unmodifiableMap[K, V]) Values() Collection[V] {
+	return unmodifiableOf(u.m.Values())
+}
+
+func (u *unmodifiableMap[K, V]) All() iter.Seq2[K, V] {
+	return u.m.All()
+}
+
+func (u *unmodifiableMap[K, V]) Keys() iter.Seq[K] {
+	return u.m.Keys()
+}
+
+func (u *unmodifiableMap[K, V]) AllValues() iter.Seq[V] {
+	return u.m.AllValues()
+}
+
+type unmodifiableSortedMap[K any, V any] struct {
+	*unmodifiableMap[K, V]
+	s SortedMap[K, V]
+}
+
+func newUnmodifiableSortedMap[K any, V any](m SortedMap[K, V], name string) *unmodifiableSortedMap[K, V] {
+	return &unmodifiableSortedMap[K, V]{
+		unmodifiableMap: &unmodifiableMap[K, V]{m: m, name: name},
+		s:               m,
+	}
+}
+
+func (u *unmodifiableSortedMap[K, V]) Comparator() Comparator[K] {
+	return u.s.Comparator()
+}
+
+func (u *unmodifiableSortedMap[K, V]) FirstKey() (K, error) {
+	return u.s.FirstKey()
+}
+
+func (u *unmodifiableSortedMap[K, V]) LastKey() (K, error) {
+	return u.s.LastKey()
+}
+
+func (u *unmodifiableSortedMap[K, V]) HeadMap(toKey K) (SortedMap[K, V], error) {
+	return unmodifiableSortedMapOf(u.s.HeadMap(toKey))
+}
+
+func (u *unmodifiableSortedMap[K, V]) TailMap(fromKey K) (SortedMap[K, V], error) {
+	return unmodifiableSortedMapOf(u.s.TailMap(fromKey))
+}
+
+func (u *unmodifiableSortedMap[K, V]) SubMap(fromKey K, toKey K) (SortedMap[K, V], error) {
+	return unmodifiableSortedMapOf(u.s.SubMap(fromKey, toKey))
+}
+
+// unmodifiableSortedMapOf wraps the view returned by HeadMap, TailMap or SubMap.
+func unmodifiableSortedMapOf[K any, V any](m SortedMap[K, V], err error) (SortedMap[K, V], error) {
+	if err != nil {
+		return nil, err
+	}
+	return UnmodifiableSortedMap(m), nil
+}
+
+type unmodifiableNavigableMap[K any, V any] struct {
+	*unmodifiableSortedMap[K, V]
+	n NavigableMap[K, V]
+}
+
+func (u *unmodifiableNavigableMap[K, V]) CeilingEntry(key K) (MapEntry[K, V], error) {
+	return u.n.CeilingEntry(key)
+}
+
+func (u *unmodifiableNavigableMap[K, V]) CeilingKey(key K) (K, error) {
+	return u.n.CeilingKey(key)
+}
+
+func (u *unmodifiableNavigableMap[K, V]) DescendingKeySet() NavigableSet[K] {
+	return UnmodifiableNavigableSet(u.n.DescendingKeySet())
+}
+
+func (u *unmodifiableNavigableMap[K, V]) DescendingMap() NavigableMap[K, V] {
+	return UnmodifiableNavigableMap(u.n.DescendingMap())
+}
+
+func (u *unmodifiableNavigableMap[K, V]) FirstEntry() (MapEntry[K, V], error) {
+	return u.n.FirstEntry()
+}
+
+func (u *unmodifiableNavigableMap[K, V]) FloorEntry(key K) (MapEntry[K, V], error) {
+	return u.n.FloorEntry(key)
+}
+
+func (u *unmodifiableNavigableMap[K, V]) FloorKey(key K) (K, error) {
+	return u.n.FloorKey(key)
+}
+
+func (u *unmodifiableNavigableMap[K, V]) HigherEntry(key K) (MapEntry[K, V], error) {
+	return u.n.HigherEntry(key)
+}
+
+func (u *unmodifiableNavigableMap[K, V]) HigherKey(key K) (K, error) {
+	return u.n.HigherKey(key)
+}
+
+func (u *unmodifiableNavigableMap[K, V]) LastEntry() (MapEntry[K, V], error) {
+	return u.n.LastEntry()
+}
+
+func (u *unmodifiableNavigableMap[K, V]) LowerEntry(key K) (MapEntry[K, V], error) {
+	return u.n.LowerEntry(key)
+}
+
+func (u *unmodifiableNavigableMap[K, V]) LowerKey(key K) (K, error) {
+	return u.n.LowerKey(key)
+}
+
+func (u *unmodifiableNavigableMap[K, V]) NavigableKeySet() NavigableSet[K] {
+	return UnmodifiableNavigableSet(u.n.NavigableKeySet())
+}
+
+func (u *unmodifiableNavigableMap[K, V]) PollFirstEntry() (MapEntry[K, V], error) {
+	return nil, unsupported(u.name, "PollFirstEntry")
+}
+
+func (u *unmodifiableNavigableMap[K, V]) PollLastEntry() (MapEntry[K, V], error) {
+	return nil, unsupported(u.name, "PollLastEntry")
+}
+
+func (u *unmodifiableNavigableMap[K, V]) Backward() iter.Seq2[K, V] {
+	return u.n.Backward()
+}
+
+type unmodifiableIterator[E any] struct {
+	it Iterator[E]
+}
+
+func (u *unmodifiableIterator[E]) HasNext() bool {
+	return u.it.HasNext()
+}
+
+func (u *unmodifiableIterator[E]) Next() (*E, error) {
+	return detach(u.it.Next())
+}
+
+type unmodifiableListIterator[E any] struct {
+	it   ListIterator[E]
+	name string
+}
+
+func (u *unmodifiableListIterator[E]) HasNext() bool {
+	return u.it.HasNext()
+}
+
+func (u *unmodifiableListIterator[E]) Next() (*E, error) {
+	return detach(u.it.Next())
+}
+
+func (u *unmodifiableListIterator[E]) HasPrevious() bool {
+	return u.it.HasPrevious()
+}
+
+func (u *unmodifiableListIterator[E]) Previous() (*E, error) {
+	return detach(u.it.Previous())
+}
+
+func (u *unmodifiableListIterator[E]) NextIndex() int {
+	return u.it.NextIndex()
+}
+
+func (u *unmodifiableListIterator[E]) PreviousIndex() int {
+	return u.it.PreviousIndex()
+}
+
+func (u *unmodifiableListIterator[E]) Remove() error {
+	return unsupported(u.name, "ListIterator.Remove")
+}
+
+func (u *unmodifiableListIterator[E]) Set(element E) error {
+	return unsupported(u.name, "ListIterator.Set")
+}
+
+func (u *unmodifiableListIterator[E]) Add(element E) error {
+	return unsupported(u.name, "ListIterator.Add")
+}
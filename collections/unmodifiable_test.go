@@ -0,0 +1,301 @@
+package collections_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/chiranjeevipavurala/gocollections/maps"
+	"github.com/chiranjeevipavurala/gocollections/queues"
+	"github.com/chiranjeevipavurala/gocollections/sets"
+)
+
+func assertUnsupported(t *testing.T, err error, typ, op string) {
+	t.Helper()
+	assert.ErrorIs(t, err, errcodes.ErrUnsupportedOperation)
+	var e *errcodes.Error
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, typ, e.Type)
+		assert.Equal(t, op, e.Op)
+	}
+}
+
+func TestUnmodifiable_ListReadsThrough(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{1, 2, 3})
+	view := collections.Unmodifiable[int](list)
+
+	assert.Equal(t, 3, view.Size())
+	assert.True(t, view.Contains(2))
+	assert.Equal(t, 1, view.IndexOf(2))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(view.All()))
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(view.Backward()))
+	assert.True(t, view.Equals(list))
+
+	list.Add(4)
+	assert.Equal(t, 4, view.Size())
+	last, err := view.GetLast()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, *last)
+}
+
+func TestUnmodifiable_ListRejectsMutators(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{1, 2, 3})
+	view := collections.Unmodifiable[int](list)
+	other := lists.NewArrayListWithInitialCollection([]int{9})
+
+	assert.False(t, view.Add(4))
+	assert.False(t, view.AddAll(other))
+	assert.False(t, view.Remove(1))
+	assert.False(t, view.RemoveAll(other))
+	view.Clear()
+	view.AddFirst(0)
+	view.AddLast(4)
+	view.Sort(comparators.Reverse(comparators.Natural[int]()))
+
+	_, err := view.RemoveFirst()
+	assertUnsupported(t, err, "UnmodifiableList", "RemoveFirst")
+	_, err = view.RemoveLast()
+	assertUnsupported(t, err, "UnmodifiableList", "RemoveLast")
+	assertUnsupported(t, view.AddAtIndex(0, 0), "UnmodifiableList", "AddAtIndex")
+	_, err = view.AddAllAtIndex(0, other)
+	assertUnsupported(t, err, "UnmodifiableList", "AddAllAtIndex")
+	_, err = view.RemoveAtIndex(0)
+	assertUnsupported(t, err, "UnmodifiableList", "RemoveAtIndex")
+	_, err = view.Set(0, 0)
+	assertUnsupported(t, err, "UnmodifiableList", "Set")
+
+	assert.Equal(t, []int{1, 2, 3}, list.ToArray())
+}
+
+func TestUnmodifiable_ListReturnsCopies(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{1, 2, 3})
+	view := collections.Unmodifiable[int](list)
+
+	value, err := view.Get(0)
+	assert.NoError(t, err)
+	*value = 100
+	value, err = view.GetFirst()
+	assert.NoError(t, err)
+	*value = 100
+	value, err = view.Iterator().Next()
+	assert.NoError(t, err)
+	*value = 100
+
+	assert.Equal(t, []int{1, 2, 3}, list.ToArray())
+}
+
+func TestUnmodifiable_ListViews(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{1, 2, 3, 4})
+	view := collections.Unmodifiable[int](list)
+
+	subList, err := view.SubList(1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, subList.ToArray())
+	assert.False(t, subList.Add(5))
+	_, err = subList.Set(0, 0)
+	assertUnsupported(t, err, "UnmodifiableList", "Set")
+
+	_, err = view.SubList(0, 9)
+	assert.ErrorIs(t, err, errcodes.ErrIndexOutOfBounds)
+
+	reversed := view.Reversed()
+	assert.Equal(t, []int{4, 3, 2, 1}, reversed.ToArray())
+	assert.False(t, reversed.Remove(1))
+	reversedList, ok := reversed.(collections.List[int])
+	if assert.True(t, ok) {
+		_, err = reversedList.Set(0, 0)
+		assertUnsupported(t, err, "UnmodifiableList", "Set")
+	}
+
+	it, err := view.ListIterator(0)
+	assert.NoError(t, err)
+	value, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *value)
+	assertUnsupported(t, it.Remove(), "UnmodifiableList", "ListIterator.Remove")
+	assertUnsupported(t, it.Set(0), "UnmodifiableList", "ListIterator.Set")
+	assertUnsupported(t, it.Add(0), "UnmodifiableList", "ListIterator.Add")
+	assert.Equal(t, 1, it.NextIndex())
+
+	_, err = view.ListIterator(5)
+	assert.ErrorIs(t, err, errcodes.ErrIndexOutOfBounds)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, list.ToArray())
+}
+
+func TestUnmodifiable_WrapsOnce(t *testing.T) {
+	view := collections.Unmodifiable[int](lists.NewArrayList[int]())
+	assert.Same(t, view, collections.Unmodifiable(view))
+	assert.Equal(t, view, collections.UnmodifiableCollection[int](view))
+	assert.Nil(t, collections.Unmodifiable[int](nil))
+	assert.Nil(t, collections.UnmodifiableMap[int, int](nil))
+
+	immutable := collections.ImmutableListOf(1, 2)
+	assert.Equal(t, immutable, collections.Unmodifiable(immutable))
+}
+
+func TestUnmodifiable_QueueAndDeque(t *testing.T) {
+	deque := queues.NewArrayDeque[int]()
+	deque.AddLast(1)
+	deque.AddLast(2)
+
+	queue := collections.UnmodifiableQueue[int](deque)
+	head, err := queue.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *head)
+	assert.False(t, queue.Offer(3))
+	_, err = queue.Poll()
+	assertUnsupported(t, err, "UnmodifiableQueue", "Poll")
+	_, err = queue.RemoveHead()
+	assertUnsupported(t, err, "UnmodifiableQueue", "RemoveHead")
+
+	view := collections.UnmodifiableDeque[int](deque)
+	last, err := view.PeekLast()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *last)
+	assert.False(t, view.OfferFirst(0))
+	assert.False(t, view.RemoveLastOccurrence(2))
+	view.Push(0)
+	view.AddFirst(0)
+	_, err = view.Pop()
+	assertUnsupported(t, err, "UnmodifiableDeque", "Pop")
+	_, err = view.PollLast()
+	assertUnsupported(t, err, "UnmodifiableDeque", "PollLast")
+	_, err = view.RemoveFirst()
+	assertUnsupported(t, err, "UnmodifiableDeque", "RemoveFirst")
+
+	value, err := view.DescendingIterator().Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *value)
+	assert.Equal(t, []int{2, 1}, slices.Collect(view.Backward()))
+	assert.Equal(t, []int{1, 2}, deque.ToArray())
+}
+
+func TestUnmodifiable_Set(t *testing.T) {
+	set := sets.NewHashSet[string]()
+	set.Add("a")
+	view := collections.UnmodifiableSet(set)
+
+	assert.True(t, view.Contains("a"))
+	assert.False(t, view.Add("b"))
+	assert.False(t, view.Remove("a"))
+	view.Clear()
+	assert.Equal(t, 1, set.Size())
+
+	set.Add("b")
+	assert.Equal(t, 2, view.Size())
+}
+
+func TestUnmodifiable_NavigableSet(t *testing.T) {
+	set := sets.NewTreeSet[int](comparators.Natural[int]())
+	for _, v := range []int{1, 3, 5, 7} {
+		set.Add(v)
+	}
+	view := collections.UnmodifiableNavigableSet[int](set)
+
+	ceiling, err := view.Ceiling(4)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, *ceiling)
+	first, err := view.First()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *first)
+
+	_, err = view.PollFirst()
+	assertUnsupported(t, err, "UnmodifiableNavigableSet", "PollFirst")
+	_, err = view.PollLast()
+	assertUnsupported(t, err, "UnmodifiableNavigableSet", "PollLast")
+
+	head, err := view.HeadSet(5)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, head.ToArray())
+	assert.False(t, head.Add(2))
+	assert.False(t, head.Remove(1))
+
+	descending := view.DescendingSet()
+	assert.Equal(t, []int{7, 5, 3, 1}, descending.ToArray())
+	_, err = descending.PollFirst()
+	assert.ErrorIs(t, err, errcodes.ErrUnsupportedOperation)
+
+	assert.Equal(t, []int{1, 3, 5, 7}, set.ToArray())
+}
+
+func TestUnmodifiable_Map(t *testing.T) {
+	m := maps.NewHashMap[string, int]()
+	m.Put("a", 1)
+	view := collections.UnmodifiableMap(m)
+
+	assert.True(t, view.HasKey("a"))
+	assert.True(t, view.HasValue(1))
+	assert.Equal(t, 1, *view.Get("a"))
+	assert.True(t, view.Equals(m))
+
+	assert.Equal(t, 0, view.Put("b", 2))
+	assert.Equal(t, 0, view.PutIfAbsent("b", 2))
+	assert.Equal(t, 0, view.Remove("a"))
+	assert.Equal(t, 0, view.Replace("a", 2))
+	assert.False(t, view.RemoveKeyWithValue("a", 1))
+	assert.False(t, view.ReplaceKeyWithValue("a", 1, 2))
+	view.PutAll(maps.NewHashMap[string, int]())
+	view.Clear()
+	*view.Get("a") = 100
+	_, err := view.Compute("a", func(string, *int) (int, bool) { return 2, true })
+	assert.ErrorIs(t, err, errcodes.ErrUnsupportedOperation)
+	_, err = view.ComputeIfPresent("a", func(string, int) (int, bool) { return 2, true })
+	assert.ErrorIs(t, err, errcodes.ErrUnsupportedOperation)
+	_, err = view.Merge("a", 2, func(int, int) (int, bool) { return 2, true })
+	assert.ErrorIs(t, err, errcodes.ErrUnsupportedOperation)
+
+	assert.Equal(t, 1, m.Size())
+	assert.Equal(t, 1, *m.Get("a"))
+
+	assert.False(t, view.KeySet().Add("c"))
+	assert.False(t, view.Values().Add(3))
+	assert.False(t, view.EntrySet().Remove(collections.NewHashMapEntry("a", 1)))
+
+	m.Put("b", 2)
+	assert.Equal(t, 2, view.Size())
+}
+
+func TestUnmodifiable_NavigableMap(t *testing.T) {
+	m := maps.NewTreeMap[int, string](comparators.Natural[int]())
+	m.Put(1, "one")
+	m.Put(2, "two")
+	m.Put(3, "three")
+	view := collections.UnmodifiableNavigableMap[int, string](m)
+
+	key, err := view.CeilingKey(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, key)
+	entry, err := view.FirstEntry()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, entry.GetKey())
+
+	_, err = view.PollFirstEntry()
+	assertUnsupported(t, err, "UnmodifiableNavigableMap", "PollFirstEntry")
+	_, err = view.PollLastEntry()
+	assertUnsupported(t, err, "UnmodifiableNavigableMap", "PollLastEntry")
+
+	head, err := view.HeadMap(3)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, head.Size())
+	assert.Equal(t, "", head.Remove(1))
+
+	keys := view.NavigableKeySet()
+	assert.False(t, keys.Remove(1))
+	_, err = keys.PollFirst()
+	assert.ErrorIs(t, err, errcodes.ErrUnsupportedOperation)
+	assert.False(t, view.KeySet().Remove(1))
+
+	descending := view.DescendingMap()
+	first, err := descending.FirstKey()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, first)
+	assert.Equal(t, "", descending.Put(4, "four"))
+
+	assert.Equal(t, 3, m.Size())
+}
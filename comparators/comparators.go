@@ -0,0 +1,104 @@
+// Package comparators provides ready-made comparators and functions for combining them.
+// Every comparator returned here implements collections.Comparator, so it can be passed
+// directly to Sort, BinarySearch, NewTreeMap, NewTreeSet and NewPriorityQueue.
+package comparators
+
+import (
+	"cmp"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+)
+
+// FuncComparator adapts an ordinary comparison function to the Comparator interface.
+// The function must return a negative number, zero or a positive number when a is
+// less than, equal to or greater than b.
+type FuncComparator[E any] func(a, b E) int
+
+// Compare calls the underlying function.
+func (f FuncComparator[E]) Compare(a, b E) int {
+	return f(a, b)
+}
+
+// Natural returns a comparator that orders values by their natural order, as defined by cmp.Compare.
+func Natural[T cmp.Ordered]() collections.Comparator[T] {
+	return FuncComparator[T](cmp.Compare[T])
+}
+
+// reverseComparator imposes the reverse ordering of the wrapped comparator.
+type reverseComparator[E any] struct {
+	comparator collections.Comparator[E]
+}
+
+// Compare compares its two arguments in reverse order.
+func (r *reverseComparator[E]) Compare(a, b E) int {
+	return r.comparator.Compare(b, a)
+}
+
+// Reverse returns a comparator that imposes the reverse ordering of comparator.
+// Reversing a comparator returned by Reverse gives back the original comparator.
+func Reverse[E any](comparator collections.Comparator[E]) collections.Comparator[E] {
+	if reversed, ok := comparator.(*reverseComparator[E]); ok {
+		return reversed.comparator
+	}
+	return &reverseComparator[E]{comparator: comparator}
+}
+
+// Comparing returns a comparator that orders values by the natural order of the key extracted from them.
+func Comparing[E any, K cmp.Ordered](key func(E) K) collections.Comparator[E] {
+	return FuncComparator[E](func(a, b E) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+// ComparingWith returns a comparator that orders values by the key extracted from them,
+// using keyComparator to compare the keys.
+func ComparingWith[E, K any](key func(E) K, keyComparator collections.Comparator[K]) collections.Comparator[E] {
+	return FuncComparator[E](func(a, b E) int {
+		return keyComparator.Compare(key(a), key(b))
+	})
+}
+
+// ThenComparing returns a comparator that compares with first and, when first considers
+// two values equal, falls back to each of the others in turn.
+func ThenComparing[E any](first collections.Comparator[E], others ...collections.Comparator[E]) collections.Comparator[E] {
+	chain := append([]collections.Comparator[E]{first}, others...)
+	return FuncComparator[E](func(a, b E) int {
+		for _, comparator := range chain {
+			if result := comparator.Compare(a, b); result != 0 {
+				return result
+			}
+		}
+		return 0
+	})
+}
+
+// NullsFirst returns a comparator for pointers that orders nil before any non-nil pointer.
+// Non-nil pointers are compared by the values they point to using comparator; if comparator
+// is nil, all non-nil pointers are considered equal.
+func NullsFirst[E any](comparator collections.Comparator[E]) collections.Comparator[*E] {
+	return nullsComparator(comparator, -1)
+}
+
+// NullsLast returns a comparator for pointers that orders nil after any non-nil pointer.
+// Non-nil pointers are compared by the values they point to using comparator; if comparator
+// is nil, all non-nil pointers are considered equal.
+func NullsLast[E any](comparator collections.Comparator[E]) collections.Comparator[*E] {
+	return nullsComparator(comparator, 1)
+}
+
+// nullsComparator orders nil pointers before non-nil ones when nilOrder is negative and after them when it is positive.
+func nullsComparator[E any](comparator collections.Comparator[E], nilOrder int) collections.Comparator[*E] {
+	return FuncComparator[*E](func(a, b *E) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilOrder
+		case b == nil:
+			return -nilOrder
+		case comparator == nil:
+			return 0
+		}
+		return comparator.Compare(*a, *b)
+	})
+}
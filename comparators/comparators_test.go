@@ -0,0 +1,129 @@
+package comparators_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/chiranjeevipavurala/gocollections/maps"
+	"github.com/chiranjeevipavurala/gocollections/queues"
+	"github.com/chiranjeevipavurala/gocollections/sets"
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestNatural(t *testing.T) {
+	ints := comparators.Natural[int]()
+	assert.Negative(t, ints.Compare(1, 2))
+	assert.Zero(t, ints.Compare(2, 2))
+	assert.Positive(t, ints.Compare(3, 2))
+
+	strs := comparators.Natural[string]()
+	assert.Negative(t, strs.Compare("a", "b"))
+	assert.Positive(t, comparators.Natural[float64]().Compare(1.5, 1.25))
+}
+
+func TestFuncComparator(t *testing.T) {
+	byLength := comparators.FuncComparator[string](func(a, b string) int {
+		return len(a) - len(b)
+	})
+	assert.Negative(t, byLength.Compare("go", "rust"))
+	assert.Zero(t, byLength.Compare("go", "js"))
+
+	list := lists.NewArrayListWithInitialCollection([]string{"ccc", "a", "bb"})
+	list.Sort(byLength)
+	assert.Equal(t, []string{"a", "bb", "ccc"}, list.ToArray())
+}
+
+func TestReverse(t *testing.T) {
+	natural := comparators.Natural[int]()
+	reversed := comparators.Reverse(natural)
+	assert.Positive(t, reversed.Compare(1, 2))
+	assert.Negative(t, reversed.Compare(2, 1))
+	assert.Zero(t, reversed.Compare(2, 2))
+
+	// Reversing twice gives back the original comparator
+	assert.Equal(t, natural.Compare(1, 2), comparators.Reverse(reversed).Compare(1, 2))
+	_, isFunc := comparators.Reverse(reversed).(comparators.FuncComparator[int])
+	assert.True(t, isFunc)
+}
+
+func TestComparing(t *testing.T) {
+	byAge := comparators.Comparing(func(p person) int { return p.age })
+	assert.Negative(t, byAge.Compare(person{"a", 20}, person{"b", 30}))
+	assert.Zero(t, byAge.Compare(person{"a", 20}, person{"b", 20}))
+
+	byNameIgnoringCase := comparators.ComparingWith(strings.ToLower, comparators.Natural[string]())
+	assert.Zero(t, byNameIgnoringCase.Compare("Go", "gO"))
+	assert.Negative(t, byNameIgnoringCase.Compare("alpha", "Beta"))
+}
+
+func TestThenComparing(t *testing.T) {
+	byAgeThenName := comparators.ThenComparing(
+		comparators.Comparing(func(p person) int { return p.age }),
+		comparators.Comparing(func(p person) string { return p.name }),
+	)
+	assert.Negative(t, byAgeThenName.Compare(person{"z", 20}, person{"a", 30}))
+	assert.Negative(t, byAgeThenName.Compare(person{"a", 20}, person{"b", 20}))
+	assert.Zero(t, byAgeThenName.Compare(person{"a", 20}, person{"a", 20}))
+
+	// With no further comparators it behaves like the first one
+	only := comparators.ThenComparing(comparators.Natural[int]())
+	assert.Negative(t, only.Compare(1, 2))
+}
+
+func TestNullsFirstAndLast(t *testing.T) {
+	one, two := 1, 2
+	nullsFirst := comparators.NullsFirst(comparators.Natural[int]())
+	assert.Negative(t, nullsFirst.Compare(nil, &one))
+	assert.Positive(t, nullsFirst.Compare(&one, nil))
+	assert.Zero(t, nullsFirst.Compare(nil, nil))
+	assert.Negative(t, nullsFirst.Compare(&one, &two))
+
+	nullsLast := comparators.NullsLast(comparators.Natural[int]())
+	assert.Positive(t, nullsLast.Compare(nil, &one))
+	assert.Negative(t, nullsLast.Compare(&one, nil))
+	assert.Positive(t, nullsLast.Compare(&two, &one))
+
+	// Without a value comparator all non-nil pointers are equal
+	assert.Zero(t, comparators.NullsFirst[int](nil).Compare(&one, &two))
+
+	list := lists.NewArrayListWithInitialCollection([]*int{&two, nil, &one})
+	list.Sort(nullsLast)
+	values := list.ToArray()
+	assert.Equal(t, []*int{&one, &two, nil}, values)
+}
+
+func TestComparators_WithCollections(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{5, 1, 4, 2, 3})
+	list.Sort(comparators.Reverse(comparators.Natural[int]()))
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, list.ToArray())
+	index, err := list.BinarySearch(2, comparators.Reverse(comparators.Natural[int]()))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, index)
+
+	tm := maps.NewTreeMap[string, int](comparators.Reverse(comparators.Natural[string]()))
+	tm.Put("a", 1)
+	tm.Put("c", 3)
+	tm.Put("b", 2)
+	first, err := tm.FirstKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "c", first)
+
+	set := sets.NewTreeSet(comparators.Natural[int]())
+	set.Add(2)
+	set.Add(1)
+	assert.Equal(t, []int{1, 2}, set.ToArray())
+
+	pq := queues.NewPriorityQueue(comparators.Comparing(func(p person) int { return p.age }))
+	pq.Add(person{"old", 70})
+	pq.Add(person{"young", 10})
+	head, err := pq.Poll()
+	assert.NoError(t, err)
+	assert.Equal(t, "young", head.name)
+}
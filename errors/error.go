@@ -1,7 +1,18 @@
+// Package errors defines the errors returned by the collections.
+//
+// Every failure has an ErrorCode, and the message of every error is its code, so code
+// that compares messages with the constants below keeps working. Use errors.Is with the
+// sentinel errors, such as ErrIndexOutOfBounds, to test for a kind of failure, and
+// errors.As with *Error or *IndexError to get at the operation that failed.
 package errors
 
 type ErrorCode string
 
+// Error returns the code itself, so that an ErrorCode can be used as an error.
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
 const IndexOutOfBoundsError ErrorCode = "INDEX_OUT_OF_BOUNDS_EXCEPTION"
 const NoSuchElementError ErrorCode = "NO_SUCH_ELEMENT_EXCEPTION"
 const NullPointerError ErrorCode = "NULL_POINTER_EXCEPTION"
@@ -13,4 +24,96 @@ const ClassCastError ErrorCode = "CLASS_CAST_EXCEPTION"
 const NoSuchMethodError ErrorCode = "NO_SUCH_METHOD_EXCEPTION"
 const IllegalStateError ErrorCode = "ILLEGAL_STATE_EXCEPTION"
 const ArithmeticError ErrorCode = "ARITHMETIC_EXCEPTION"
+
+// QueueIsEmptyError is not returned by any operation; an empty queue reports NoSuchElementError.
+//
+// Deprecated: Use NoSuchElementError.
 const QueueIsEmptyError ErrorCode = "QueueIsEmptyError"
+
+const TimeoutError ErrorCode = "TIMEOUT_EXCEPTION"
+const InterruptedError ErrorCode = "INTERRUPTED_EXCEPTION"
+const InvalidFormatError ErrorCode = "INVALID_FORMAT_EXCEPTION"
+
+// Sentinel errors, one per ErrorCode, for use with errors.Is.
+var (
+	ErrIndexOutOfBounds       error = IndexOutOfBoundsError
+	ErrNoSuchElement          error = NoSuchElementError
+	ErrNullPointer            error = NullPointerError
+	ErrIllegalArgument        error = IllegalArgumentError
+	ErrEmptyStack             error = EmptyStackError
+	ErrUnsupportedOperation   error = UnsupportedOperationError
+	ErrConcurrentModification error = ConcurrentModificationError
+	ErrClassCast              error = ClassCastError
+	ErrNoSuchMethod           error = NoSuchMethodError
+	ErrIllegalState           error = IllegalStateError
+	ErrArithmetic             error = ArithmeticError
+	ErrTimeout                error = TimeoutError
+	ErrInterrupted            error = InterruptedError
+	ErrInvalidFormat          error = InvalidFormatError
+)
+
+// Error is the error returned when an operation on a collection fails.
+type Error struct {
+	Code ErrorCode // The kind of failure
+	Type string    // The collection type, such as "ArrayList"
+	Op   string    // The operation, such as "GetFirst" or "Iterator.Next"
+	Err  error     // The underlying error, if any
+}
+
+// New returns an *Error for an operation on a collection type that failed with code.
+func New(code ErrorCode, typ, op string) error {
+	return &Error{Code: code, Type: typ, Op: op}
+}
+
+// Wrap returns an *Error like New that also records the underlying error err.
+func Wrap(code ErrorCode, typ, op string, err error) error {
+	return &Error{Code: code, Type: typ, Op: op, Err: err}
+}
+
+// Error returns the error code.
+func (e *Error) Error() string {
+	return string(e.Code)
+}
+
+// Is reports whether target is the code of e, so that errors.Is matches the sentinel errors.
+func (e *Error) Is(target error) bool {
+	return target == e.Code
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// IndexError is the error returned when an index or a range of indexes is out of bounds.
+type IndexError struct {
+	Type  string // The collection type, such as "ArrayList"
+	Op    string // The operation, such as "Get"
+	Index int    // The index, or the bound of the range, that is out of bounds
+	Size  int    // The size of the collection
+}
+
+// NewIndexError returns an *IndexError for an index outside a collection of the given size.
+func NewIndexError(typ, op string, index, size int) error {
+	return &IndexError{Type: typ, Op: op, Index: index, Size: size}
+}
+
+// NewRangeError returns an *IndexError for the range [from, to) of a collection of the
+// given size. Its Index is from if from is negative or after to, and to otherwise.
+func NewRangeError(typ, op string, from, to, size int) error {
+	index := to
+	if from < 0 || from > to {
+		index = from
+	}
+	return &IndexError{Type: typ, Op: op, Index: index, Size: size}
+}
+
+// Error returns the error code IndexOutOfBoundsError.
+func (e *IndexError) Error() string {
+	return string(IndexOutOfBoundsError)
+}
+
+// Is reports whether target is IndexOutOfBoundsError.
+func (e *IndexError) Is(target error) bool {
+	return target == IndexOutOfBoundsError
+}
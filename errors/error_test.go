@@ -0,0 +1,70 @@
+package errors_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCode(t *testing.T) {
+	var err error = errcodes.NoSuchElementError
+	assert.Equal(t, "NO_SUCH_ELEMENT_EXCEPTION", err.Error())
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+	assert.NotErrorIs(t, err, errcodes.ErrIllegalState)
+}
+
+func TestError(t *testing.T) {
+	err := errcodes.New(errcodes.NoSuchElementError, "ArrayList", "GetFirst")
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+	assert.ErrorIs(t, err, errcodes.NoSuchElementError)
+	assert.NotErrorIs(t, err, errcodes.ErrIndexOutOfBounds)
+
+	var e *errcodes.Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, errcodes.Error{Code: errcodes.NoSuchElementError, Type: "ArrayList", Op: "GetFirst"}, *e)
+	assert.Nil(t, errors.Unwrap(err))
+
+	// Two errors with the same code match the same sentinel but are not equal
+	assert.NotSame(t, err, errcodes.New(errcodes.NoSuchElementError, "ArrayList", "GetFirst"))
+}
+
+func TestWrap(t *testing.T) {
+	err := errcodes.Wrap(errcodes.TimeoutError, "ArrayBlockingQueue", "TakeContext", context.DeadlineExceeded)
+	assert.EqualError(t, err, string(errcodes.TimeoutError))
+	assert.ErrorIs(t, err, errcodes.ErrTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotErrorIs(t, err, errcodes.ErrInterrupted)
+}
+
+func TestIndexError(t *testing.T) {
+	err := errcodes.NewIndexError("LinkedList", "Get", 5, 3)
+	assert.EqualError(t, err, string(errcodes.IndexOutOfBoundsError))
+	assert.ErrorIs(t, err, errcodes.ErrIndexOutOfBounds)
+	assert.NotErrorIs(t, err, errcodes.ErrNoSuchElement)
+
+	var e *errcodes.IndexError
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, errcodes.IndexError{Type: "LinkedList", Op: "Get", Index: 5, Size: 3}, *e)
+	var other *errcodes.Error
+	assert.False(t, errors.As(err, &other))
+}
+
+func TestNewRangeError(t *testing.T) {
+	tests := []struct {
+		from, to, index int
+	}{
+		{from: -1, to: 2, index: -1},
+		{from: 0, to: 9, index: 9},
+		{from: 3, to: 2, index: 3},
+	}
+	for _, tt := range tests {
+		var e *errcodes.IndexError
+		assert.True(t, errors.As(errcodes.NewRangeError("ArrayList", "SubList", tt.from, tt.to, 4), &e))
+		assert.Equal(t, tt.index, e.Index)
+		assert.Equal(t, 4, e.Size)
+	}
+}
@@ -0,0 +1,274 @@
+// Package codec implements the binary format shared by the collection and map types.
+//
+// An encoded collection starts with a header: the magic bytes "GCOL", a format version
+// byte, a kind byte and the number of elements as a uvarint. The elements follow as a
+// gob stream of chunks of at most ChunkSize elements each, so encoding and decoding only
+// ever hold one chunk in memory on top of the collection itself.
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"io"
+	"iter"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// Version is the format version written by this package.
+const Version = 1
+
+// ChunkSize is the largest number of elements encoded as one gob value.
+const ChunkSize = 4096
+
+// Kind tells sequences and mappings apart, so that a map cannot be decoded into a list.
+type Kind byte
+
+const (
+	// Sequence is the kind of lists, sets, stacks and queues.
+	Sequence Kind = 1
+	// Mapping is the kind of maps. Their elements are Entry values.
+	Mapping Kind = 2
+)
+
+var magic = [4]byte{'G', 'C', 'O', 'L'}
+
+// Entry is the element type used to encode the mappings of a map.
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// Writer encodes a known number of elements to an io.Writer.
+type Writer[E any] struct {
+	out     *countingWriter
+	buf     *bufio.Writer
+	enc     *gob.Encoder
+	chunk   []E
+	pending int
+}
+
+// NewWriter writes the header for count elements of the given kind and returns a
+// Writer for the elements. Exactly count elements must be written before Close.
+func NewWriter[E any](w io.Writer, kind Kind, count int) (*Writer[E], error) {
+	out := &countingWriter{w: w}
+	buf := bufio.NewWriter(out)
+	header := append(magic[:], Version, byte(kind))
+	header = binary.AppendUvarint(header, uint64(count))
+	if _, err := buf.Write(header); err != nil {
+		return nil, err
+	}
+	return &Writer[E]{
+		out:     out,
+		buf:     buf,
+		enc:     gob.NewEncoder(buf),
+		chunk:   make([]E, 0, min(count, ChunkSize)),
+		pending: count,
+	}, nil
+}
+
+// Write adds an element, encoding the current chunk once it is full.
+func (w *Writer[E]) Write(element E) error {
+	if w.pending == 0 {
+		return errcodes.ErrIllegalState
+	}
+	w.pending--
+	w.chunk = append(w.chunk, element)
+	if len(w.chunk) == ChunkSize {
+		return w.flushChunk()
+	}
+	return nil
+}
+
+// Close encodes any remaining elements, flushes the output and returns the number of
+// bytes written. It returns IllegalStateError if fewer elements were written than promised.
+func (w *Writer[E]) Close() (int64, error) {
+	if w.pending != 0 {
+		return w.out.n, errcodes.ErrIllegalState
+	}
+	if len(w.chunk) > 0 {
+		if err := w.flushChunk(); err != nil {
+			return w.out.n, err
+		}
+	}
+	err := w.buf.Flush()
+	return w.out.n, err
+}
+
+func (w *Writer[E]) flushChunk() error {
+	err := w.enc.Encode(w.chunk)
+	clear(w.chunk)
+	w.chunk = w.chunk[:0]
+	return err
+}
+
+// Reader decodes the elements written by a Writer.
+type Reader[E any] struct {
+	in        *countingReader
+	dec       *gob.Decoder
+	count     int
+	remaining int
+	chunk     []E
+	pos       int
+}
+
+// NewReader reads and checks the header and returns a Reader for the elements.
+// It returns InvalidFormatError if the input is not an encoded collection of the given
+// kind in a supported version. Reads are buffered, so the Reader may consume bytes of r
+// past the end of the encoded collection.
+func NewReader[E any](r io.Reader, kind Kind) (*Reader[E], error) {
+	in := &countingReader{r: bufio.NewReader(r)}
+	var header [6]byte
+	if _, err := io.ReadFull(in, header[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, errcodes.ErrInvalidFormat
+		}
+		return nil, err
+	}
+	if !bytes.Equal(header[:4], magic[:]) || header[4] != Version || Kind(header[5]) != kind {
+		return nil, errcodes.ErrInvalidFormat
+	}
+	count, err := binary.ReadUvarint(in)
+	if err != nil || count > uint64(maxInt) {
+		return nil, errcodes.ErrInvalidFormat
+	}
+	return &Reader[E]{
+		in:        in,
+		dec:       gob.NewDecoder(in),
+		count:     int(count),
+		remaining: int(count),
+	}, nil
+}
+
+// Len returns the number of elements recorded in the header.
+func (r *Reader[E]) Len() int {
+	return r.count
+}
+
+// Read returns the next element, or io.EOF once all of them have been read.
+// A stream that ends early or holds more elements than the header promised
+// returns InvalidFormatError.
+func (r *Reader[E]) Read() (E, error) {
+	var zero E
+	if r.pos == len(r.chunk) {
+		if r.remaining == 0 {
+			return zero, io.EOF
+		}
+		// gob leaves zero-valued struct fields untouched, so elements of the previous
+		// chunk must not show through in the reused buffer.
+		clear(r.chunk[:cap(r.chunk)])
+		r.chunk = r.chunk[:0]
+		r.pos = 0
+		if err := r.dec.Decode(&r.chunk); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return zero, errcodes.ErrInvalidFormat
+			}
+			return zero, err
+		}
+		if len(r.chunk) == 0 || len(r.chunk) > r.remaining {
+			return zero, errcodes.ErrInvalidFormat
+		}
+		r.remaining -= len(r.chunk)
+	}
+	element := r.chunk[r.pos]
+	r.pos++
+	return element, nil
+}
+
+// ReadAll reads the remaining elements into a slice.
+func (r *Reader[E]) ReadAll() ([]E, error) {
+	// Do not trust the header with a large allocation before the data has arrived.
+	elements := make([]E, 0, min(r.remaining+len(r.chunk)-r.pos, ChunkSize))
+	for {
+		element, err := r.Read()
+		if err == io.EOF {
+			return elements, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+}
+
+// BytesRead returns the number of bytes of the encoding read so far.
+func (r *Reader[E]) BytesRead() int64 {
+	return r.in.n
+}
+
+// WriteSequence writes count elements taken from seq as a collection of the given kind
+// and returns the number of bytes written.
+func WriteSequence[E any](w io.Writer, kind Kind, count int, seq iter.Seq[E]) (int64, error) {
+	writer, err := NewWriter[E](w, kind, count)
+	if err != nil {
+		return 0, err
+	}
+	for element := range seq {
+		if err := writer.Write(element); err != nil {
+			return writer.out.n, err
+		}
+	}
+	return writer.Close()
+}
+
+// ReadSequence reads a collection of the given kind and returns its elements and the
+// number of bytes read.
+func ReadSequence[E any](r io.Reader, kind Kind) ([]E, int64, error) {
+	reader, err := NewReader[E](r, kind)
+	if err != nil {
+		return nil, 0, err
+	}
+	elements, err := reader.ReadAll()
+	return elements, reader.BytesRead(), err
+}
+
+// Marshal returns the bytes that src writes to an io.Writer.
+func Marshal(src io.WriterTo) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := src.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal has dst read data as if from an io.Reader.
+func Unmarshal(dst io.ReaderFrom, data []byte) error {
+	_, err := dst.ReadFrom(bytes.NewReader(data))
+	return err
+}
+
+const maxInt = int(^uint(0) >> 1)
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// countingReader counts the bytes read through it. It implements io.ByteReader so
+// that gob does not wrap it in another buffer.
+type countingReader struct {
+	r *bufio.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countingReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.n++
+	}
+	return b, err
+}
@@ -0,0 +1,104 @@
+package codec
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"testing"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriterAndReader(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter[string](&buf, Sequence, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Write("a"))
+	assert.NoError(t, w.Write("b"))
+	assert.EqualError(t, w.Write("c"), string(errcodes.IllegalStateError))
+	written, err := w.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), written)
+	assert.Equal(t, []byte{'G', 'C', 'O', 'L', Version, byte(Sequence), 2}, buf.Bytes()[:7])
+
+	r, err := NewReader[string](&buf, Sequence)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, r.Len())
+	first, err := r.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", first)
+	rest, err := r.ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, rest)
+	_, err = r.Read()
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, written, r.BytesRead())
+}
+
+func TestWriter_TooFewElements(t *testing.T) {
+	w, err := NewWriter[int](io.Discard, Sequence, 3)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Write(1))
+	_, err = w.Close()
+	assert.EqualError(t, err, string(errcodes.IllegalStateError))
+
+	_, err = WriteSequence(io.Discard, Sequence, 3, slices.Values([]int{1, 2, 3, 4}))
+	assert.EqualError(t, err, string(errcodes.IllegalStateError))
+}
+
+func TestReadSequence_Chunks(t *testing.T) {
+	// Zero-valued fields are left out by gob, so they must not pick up values
+	// from the previous chunk
+	values := make([]Entry[int, string], 2*ChunkSize+1)
+	for i := range values {
+		if i%ChunkSize != 0 {
+			values[i] = Entry[int, string]{Key: i, Value: "v"}
+		}
+	}
+	var buf bytes.Buffer
+	written, err := WriteSequence(&buf, Mapping, len(values), slices.Values(values))
+	assert.NoError(t, err)
+
+	decoded, read, err := ReadSequence[Entry[int, string]](&buf, Mapping)
+	assert.NoError(t, err)
+	assert.Equal(t, written, read)
+	assert.Equal(t, values, decoded)
+}
+
+func TestNewReader_Invalid(t *testing.T) {
+	valid, err := Marshal(writerFunc(func(w io.Writer) (int64, error) {
+		return WriteSequence(w, Sequence, 1, slices.Values([]int{7}))
+	}))
+	assert.NoError(t, err)
+	pair, err := Marshal(writerFunc(func(w io.Writer) (int64, error) {
+		return WriteSequence(w, Sequence, 2, slices.Values([]int{7, 8}))
+	}))
+	assert.NoError(t, err)
+
+	for name, data := range map[string][]byte{
+		"empty":       nil,
+		"short":       []byte("GCO"),
+		"magic":       []byte("GCOX\x01\x01\x00"),
+		"version":     []byte("GCOL\x02\x01\x00"),
+		"kind":        []byte("GCOL\x01\x02\x00"),
+		"count":       []byte("GCOL\x01\x01"),
+		"truncated":   valid[:len(valid)-1],
+		"extra":       append([]byte("GCOL\x01\x01\x01"), pair[7:]...),
+		"not-enough":  append([]byte("GCOL\x01\x01\x02"), valid[7:]...),
+		"no-elements": []byte("GCOL\x01\x01\x01"),
+	} {
+		_, _, err := ReadSequence[int](bytes.NewReader(data), Sequence)
+		assert.EqualError(t, err, string(errcodes.InvalidFormatError), name)
+	}
+
+	values, _, err := ReadSequence[int](bytes.NewReader(valid), Sequence)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{7}, values)
+}
+
+type writerFunc func(w io.Writer) (int64, error)
+
+func (f writerFunc) WriteTo(w io.Writer) (int64, error) {
+	return f(w)
+}
@@ -0,0 +1,15 @@
+// Package equality compares values whose type may not be comparable with ==.
+package equality
+
+import "reflect"
+
+// Equal reports whether a and b are equal. Values that == can compare, including interface
+// values holding comparable dynamic values, are compared with ==. The others, such as slices,
+// maps, functions and structs holding them, are compared with reflect.DeepEqual.
+func Equal[V any](a, b V) bool {
+	va, vb := reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem()
+	if va.Comparable() && vb.Comparable() {
+		return va.Equal(vb)
+	}
+	return reflect.DeepEqual(a, b)
+}
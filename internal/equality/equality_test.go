@@ -0,0 +1,34 @@
+package equality
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	assert.True(t, Equal(1, 1))
+	assert.False(t, Equal("a", "b"))
+
+	assert.True(t, Equal([]byte("ab"), []byte("ab")))
+	assert.False(t, Equal([]byte("ab"), []byte("ba")))
+	assert.True(t, Equal(map[string][]int{"a": {1}}, map[string][]int{"a": {1}}))
+
+	type record struct {
+		name string
+		tags []string
+	}
+	assert.True(t, Equal(record{"a", []string{"x"}}, record{"a", []string{"x"}}))
+	assert.False(t, Equal(record{"a", []string{"x"}}, record{"a", nil}))
+
+	var f func()
+	assert.True(t, Equal(f, nil))
+	assert.False(t, Equal(func() {}, func() {}))
+
+	// Pointers keep their identity semantics
+	x, y := 1, 1
+	assert.False(t, Equal(&x, &y))
+	assert.True(t, Equal[any](1, 1))
+	assert.True(t, Equal[any]([]int{1}, []int{1}))
+	assert.False(t, Equal[any](1, "1"))
+}
@@ -0,0 +1,185 @@
+// Package hashing provides the hash table behind the collections whose keys are hashed
+// and compared by a collections.Hasher rather than by Go's built-in map.
+package hashing
+
+import "github.com/chiranjeevipavurala/gocollections/collections"
+
+// Table maps keys to values using a Hasher. Entries are stored densely in slices and
+// entries with the same hash are chained through next, so adding an entry does not
+// allocate beyond growing the slices. Removal swaps the last entry into the vacated
+// slot, so positions are only stable until the next removal.
+//
+// Table does no locking; its users guard it.
+type Table[K any, V any] struct {
+	hasher collections.Hasher[K]
+	keys   []K
+	values []V
+	hashes []uint64
+	next   []int          // Position of the next entry with the same hash, or -1
+	heads  map[uint64]int // Position of the first entry with each hash
+}
+
+// New returns an empty table with room for capacity entries.
+func New[K any, V any](hasher collections.Hasher[K], capacity int) *Table[K, V] {
+	capacity = max(capacity, 0)
+	return &Table[K, V]{
+		hasher: hasher,
+		keys:   make([]K, 0, capacity),
+		values: make([]V, 0, capacity),
+		hashes: make([]uint64, 0, capacity),
+		next:   make([]int, 0, capacity),
+		heads:  make(map[uint64]int, capacity),
+	}
+}
+
+// Hasher returns the hasher the table was created with.
+func (t *Table[K, V]) Hasher() collections.Hasher[K] {
+	return t.hasher
+}
+
+// Len returns the number of entries.
+func (t *Table[K, V]) Len() int {
+	return len(t.keys)
+}
+
+// Index returns the position of key, or -1 if the table does not contain it.
+func (t *Table[K, V]) Index(key K) int {
+	i, ok := t.heads[t.hasher.Hash(key)]
+	if !ok {
+		return -1
+	}
+	for ; i >= 0; i = t.next[i] {
+		if t.hasher.Equal(t.keys[i], key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Key returns the key at position i.
+func (t *Table[K, V]) Key(i int) K {
+	return t.keys[i]
+}
+
+// Value returns the value at position i.
+func (t *Table[K, V]) Value(i int) V {
+	return t.values[i]
+}
+
+// SetValue replaces the value at position i and returns the old one.
+func (t *Table[K, V]) SetValue(i int, value V) V {
+	old := t.values[i]
+	t.values[i] = value
+	return old
+}
+
+// Put maps key to value. It returns the previous value and true if the key was present.
+func (t *Table[K, V]) Put(key K, value V) (V, bool) {
+	hash := t.hasher.Hash(key)
+	head, ok := t.heads[hash]
+	if ok {
+		for i := head; i >= 0; i = t.next[i] {
+			if t.hasher.Equal(t.keys[i], key) {
+				return t.SetValue(i, value), true
+			}
+		}
+	} else {
+		head = -1
+	}
+	t.heads[hash] = len(t.keys)
+	t.keys = append(t.keys, key)
+	t.values = append(t.values, value)
+	t.hashes = append(t.hashes, hash)
+	t.next = append(t.next, head)
+	var zero V
+	return zero, false
+}
+
+// Remove removes key. It returns the removed value and true if the key was present.
+func (t *Table[K, V]) Remove(key K) (V, bool) {
+	i := t.Index(key)
+	if i < 0 {
+		var zero V
+		return zero, false
+	}
+	value := t.values[i]
+	t.RemoveAt(i)
+	return value, true
+}
+
+// RemoveAt removes the entry at position i, moving the last entry into its place.
+func (t *Table[K, V]) RemoveAt(i int) {
+	t.relink(i, t.next[i])
+	last := len(t.keys) - 1
+	if i != last {
+		t.relink(last, i)
+		t.keys[i] = t.keys[last]
+		t.values[i] = t.values[last]
+		t.hashes[i] = t.hashes[last]
+		t.next[i] = t.next[last]
+	}
+	var zeroKey K
+	var zeroValue V
+	t.keys[last] = zeroKey
+	t.values[last] = zeroValue
+	t.keys = t.keys[:last]
+	t.values = t.values[:last]
+	t.hashes = t.hashes[:last]
+	t.next = t.next[:last]
+}
+
+// relink makes the link that points at position from in its hash chain point at to
+// instead. A negative to unlinks from, dropping the chain when it becomes empty.
+func (t *Table[K, V]) relink(from, to int) {
+	hash := t.hashes[from]
+	if t.heads[hash] == from {
+		if to < 0 {
+			delete(t.heads, hash)
+		} else {
+			t.heads[hash] = to
+		}
+		return
+	}
+	i := t.heads[hash]
+	for t.next[i] != from {
+		i = t.next[i]
+	}
+	t.next[i] = to
+}
+
+// Keys returns a copy of the keys, in position order.
+func (t *Table[K, V]) Keys() []K {
+	keys := make([]K, len(t.keys))
+	copy(keys, t.keys)
+	return keys
+}
+
+// Values returns a copy of the values, in position order.
+func (t *Table[K, V]) Values() []V {
+	values := make([]V, len(t.values))
+	copy(values, t.values)
+	return values
+}
+
+// Clear removes every entry.
+func (t *Table[K, V]) Clear() {
+	*t = *New[K, V](t.hasher, 0)
+}
+
+// Clone returns a copy of the table that shares nothing with it but the hasher.
+func (t *Table[K, V]) Clone() *Table[K, V] {
+	clone := &Table[K, V]{
+		hasher: t.hasher,
+		keys:   t.Keys(),
+		values: t.Values(),
+		hashes: make([]uint64, len(t.hashes)),
+		next:   make([]int, len(t.next)),
+		heads:  make(map[uint64]int, len(t.heads)),
+	}
+	copy(clone.hashes, t.hashes)
+	copy(clone.next, t.next)
+	for hash, i := range t.heads {
+		clone.heads[hash] = i
+	}
+	return clone
+}
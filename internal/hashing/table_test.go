@@ -0,0 +1,81 @@
+package hashing
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+)
+
+// collidingHasher puts every key into one of four chains, to exercise chain maintenance.
+var collidingHasher = collections.NewHasher(
+	func(key int) uint64 { return uint64(key % 4) },
+	func(a, b int) bool { return a == b },
+)
+
+func TestTable_PutGetRemove(t *testing.T) {
+	table := New[int, string](collidingHasher, 0)
+
+	old, replaced := table.Put(1, "one")
+	assert.False(t, replaced)
+	assert.Equal(t, "", old)
+	table.Put(5, "five")
+	table.Put(9, "nine")
+	old, replaced = table.Put(5, "FIVE")
+	assert.True(t, replaced)
+	assert.Equal(t, "five", old)
+	assert.Equal(t, 3, table.Len())
+
+	i := table.Index(5)
+	assert.Equal(t, 5, table.Key(i))
+	assert.Equal(t, "FIVE", table.Value(i))
+	assert.Equal(t, -1, table.Index(13))
+
+	value, removed := table.Remove(1)
+	assert.True(t, removed)
+	assert.Equal(t, "one", value)
+	_, removed = table.Remove(1)
+	assert.False(t, removed)
+	assert.ElementsMatch(t, []int{5, 9}, table.Keys())
+	assert.ElementsMatch(t, []string{"FIVE", "nine"}, table.Values())
+
+	clone := table.Clone()
+	table.Clear()
+	assert.Equal(t, 0, table.Len())
+	assert.Equal(t, -1, table.Index(5))
+	assert.Equal(t, 2, clone.Len())
+	assert.Equal(t, "nine", clone.Value(clone.Index(9)))
+}
+
+func TestTable_MatchesMap(t *testing.T) {
+	table := New[int, int](collidingHasher, 4)
+	model := make(map[int]int)
+	rng := rand.New(rand.NewSource(1))
+
+	for step := range 5000 {
+		key := rng.Intn(64)
+		if rng.Intn(3) == 0 {
+			want, wantOK := model[key]
+			got, ok := table.Remove(key)
+			assert.Equal(t, wantOK, ok)
+			assert.Equal(t, want, got)
+			delete(model, key)
+		} else {
+			want, wantOK := model[key]
+			got, ok := table.Put(key, step)
+			assert.Equal(t, wantOK, ok)
+			assert.Equal(t, want, got)
+			model[key] = step
+		}
+	}
+
+	assert.Equal(t, len(model), table.Len())
+	for key, value := range model {
+		i := table.Index(key)
+		if assert.GreaterOrEqual(t, i, 0) {
+			assert.Equal(t, value, table.Value(i))
+		}
+	}
+}
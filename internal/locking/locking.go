@@ -0,0 +1,51 @@
+// Package locking provides the read-write mutex of the collection types, which can be
+// turned off for collections that are only ever used by one goroutine.
+package locking
+
+import "sync"
+
+// RWMutex is a sync.RWMutex whose methods do nothing once Disable has been called. The
+// zero value is an unlocked mutex that is enabled.
+type RWMutex struct {
+	mu       sync.RWMutex
+	disabled bool
+}
+
+// Disable turns the mutex off. It must be called before the mutex is used, typically by
+// the constructor of an unsynchronized collection.
+func (m *RWMutex) Disable() {
+	m.disabled = true
+}
+
+// Disabled reports whether Disable has been called.
+func (m *RWMutex) Disabled() bool {
+	return m.disabled
+}
+
+// Lock locks m for writing.
+func (m *RWMutex) Lock() {
+	if !m.disabled {
+		m.mu.Lock()
+	}
+}
+
+// Unlock unlocks m for writing.
+func (m *RWMutex) Unlock() {
+	if !m.disabled {
+		m.mu.Unlock()
+	}
+}
+
+// RLock locks m for reading.
+func (m *RWMutex) RLock() {
+	if !m.disabled {
+		m.mu.RLock()
+	}
+}
+
+// RUnlock undoes a single RLock call.
+func (m *RWMutex) RUnlock() {
+	if !m.disabled {
+		m.mu.RUnlock()
+	}
+}
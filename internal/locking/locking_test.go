@@ -0,0 +1,48 @@
+package locking
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRWMutex_Enabled(t *testing.T) {
+	var mu RWMutex
+	assert.False(t, mu.Disabled())
+
+	count := 0
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				mu.Lock()
+				count++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 8000, count)
+
+	mu.RLock()
+	assert.False(t, mu.mu.TryLock())
+	mu.RUnlock()
+	assert.True(t, mu.mu.TryLock())
+	mu.mu.Unlock()
+}
+
+func TestRWMutex_Disabled(t *testing.T) {
+	var mu RWMutex
+	mu.Disable()
+	assert.True(t, mu.Disabled())
+
+	mu.Lock()
+	mu.RLock()
+	assert.True(t, mu.mu.TryLock())
+	mu.mu.Unlock()
+	mu.RUnlock()
+	mu.Unlock()
+}
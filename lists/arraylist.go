@@ -1,14 +1,15 @@
 package lists
 
 import (
-	"errors"
+	"iter"
 	"math/rand"
+	"slices"
 	"sort"
-	"sync"
 	"time"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
 	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/locking"
 )
 
 // DefaultCapacity is the default initial capacity for ArrayList
@@ -23,9 +24,22 @@ const MaxArraySize = 1<<31 - 1
 // MinCapacity is the minimum capacity for ArrayList
 const MinCapacity = 4
 
-type ArrayList[E comparable] struct {
-	values []E
-	mu     sync.RWMutex // For thread safety
+// ArrayList is a resizable-array List. Lists made by NewArrayListWithEquality compare
+// elements with the given Equaler, the others with ==; the zero ArrayList compares the
+// elements as interface values, which panics if they are not comparable.
+type ArrayList[E any] struct {
+	values   []E
+	modCount int                // Number of structural modifications, for fail-fast iteration
+	mu       locking.RWMutex    // For thread safety
+	eq       elementEquality[E] // How elements are compared; set once at construction
+}
+
+// equality returns how the list compares its elements.
+func (a *ArrayList[E]) equality() elementEquality[E] {
+	if a.eq.equal == nil {
+		return dynamicEquality[E]()
+	}
+	return a.eq
 }
 
 // calculateNewCapacity calculates the new capacity based on the current capacity and required size
@@ -60,11 +74,88 @@ func (a *ArrayList[E]) ensureCapacity(minCapacity int) {
 	}
 }
 
+// insertAt inserts element at index, shifting later elements right. The write lock must be held.
+func (a *ArrayList[E]) insertAt(index int, element E) {
+	// Ensure capacity for the new element
+	a.ensureCapacity(len(a.values) + 1)
+
+	// Shift elements to make space for the new element
+	a.values = append(a.values[:index+1], a.values[index:]...)
+	a.values[index] = element
+	a.modCount++
+}
+
+// removeAt removes and returns the element at index, shifting later elements left.
+// The write lock must be held.
+func (a *ArrayList[E]) removeAt(index int) E {
+	element := a.values[index]
+
+	// Shift elements to fill the gap
+	copy(a.values[index:], a.values[index+1:])
+	a.values = a.values[:len(a.values)-1]
+	a.modCount++
+	return element
+}
+
+// The methods below let a subList view operate on the list. They all assume
+// the appropriate lock is already held and that indices are in range.
+
+func (a *ArrayList[E]) mutex() *locking.RWMutex { return &a.mu }
+
+func (a *ArrayList[E]) mods() int { return a.modCount }
+
+func (a *ArrayList[E]) getAt(index int) E { return a.values[index] }
+
+func (a *ArrayList[E]) setAt(index int, element E) E {
+	old := a.values[index]
+	a.values[index] = element
+	return old
+}
+
+func (a *ArrayList[E]) insertAllAt(index int, elements []E) {
+	a.ensureCapacity(len(a.values) + len(elements))
+	a.values = a.values[:len(a.values)+len(elements)]
+	copy(a.values[index+len(elements):], a.values[index:])
+	copy(a.values[index:], elements)
+	a.modCount++
+}
+
+func (a *ArrayList[E]) removeRange(fromIndex, toIndex int) {
+	n := copy(a.values[fromIndex:], a.values[toIndex:])
+	clear(a.values[fromIndex+n:])
+	a.values = a.values[:fromIndex+n]
+	a.modCount++
+}
+
+func (a *ArrayList[E]) copyRange(fromIndex, toIndex int) []E {
+	result := make([]E, toIndex-fromIndex)
+	copy(result, a.values[fromIndex:toIndex])
+	return result
+}
+
+func (a *ArrayList[E]) setRange(fromIndex int, elements []E) {
+	copy(a.values[fromIndex:], elements)
+}
+
+func (a *ArrayList[E]) indexIn(element E, fromIndex, toIndex int) int {
+	if i := a.equality().index(a.values[fromIndex:toIndex], element); i >= 0 {
+		return fromIndex + i
+	}
+	return -1
+}
+
+func (a *ArrayList[E]) lastIndexIn(element E, fromIndex, toIndex int) int {
+	if i := a.equality().lastIndex(a.values[fromIndex:toIndex], element); i >= 0 {
+		return fromIndex + i
+	}
+	return -1
+}
+
 func NewArrayList[E comparable]() *ArrayList[E] {
 	values := make([]E, 0, DefaultCapacity)
 	return &ArrayList[E]{
 		values: values,
-		mu:     sync.RWMutex{},
+		eq:     comparableEquality[E](),
 	}
 }
 
@@ -78,7 +169,7 @@ func NewArrayListWithInitialCapacity[E comparable](capacity int) *ArrayList[E] {
 	values := make([]E, 0, capacity)
 	return &ArrayList[E]{
 		values: values,
-		mu:     sync.RWMutex{},
+		eq:     comparableEquality[E](),
 	}
 }
 
@@ -88,25 +179,41 @@ func NewArrayListWithInitialCollection[E comparable](values []E) *ArrayList[E] {
 	}
 	return &ArrayList[E]{
 		values: values,
-		mu:     sync.RWMutex{},
+		eq:     comparableEquality[E](),
 	}
 }
 
+// NewUnsynchronizedArrayList returns an empty ArrayList that takes no locks. It is faster
+// when the list is only used by one goroutine, and must not be used by several at once.
+func NewUnsynchronizedArrayList[E comparable]() *ArrayList[E] {
+	list := NewArrayList[E]()
+	list.mu.Disable()
+	return list
+}
+
+// NewArrayListWithEquality returns an empty ArrayList that compares elements with equaler
+// instead of ==, so Contains, IndexOf, Remove, Equals and the other lookups work for element
+// types that are not comparable. If equaler is also a collections.Hasher, bulk operations
+// such as RemoveAll and RetainAll hash the elements rather than compare every pair.
+func NewArrayListWithEquality[E any](equaler collections.Equaler[E]) *ArrayList[E] {
+	list := &ArrayList[E]{
+		values: make([]E, 0, DefaultCapacity),
+	}
+	if equaler != nil {
+		list.eq = equalerEquality(equaler)
+	}
+	return list
+}
+
 func (a *ArrayList[E]) AddAtIndex(index int, element E) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
 	if index > len(a.values) || index < 0 {
-		return errors.New(string(errcodes.IndexOutOfBoundsError))
+		return errcodes.NewIndexError("ArrayList", "AddAtIndex", index, len(a.values))
 	}
 
-	// Ensure capacity for the new element
-	a.ensureCapacity(len(a.values) + 1)
-
-	// Shift elements to make space for the new element
-	a.values = append(a.values[:index+1], a.values[index:]...)
-	a.values[index] = element
-
+	a.insertAt(index, element)
 	return nil
 }
 
@@ -116,21 +223,28 @@ func (a *ArrayList[E]) Add(element E) bool {
 
 	a.ensureCapacity(len(a.values) + 1)
 	a.values = append(a.values, element)
+	a.modCount++
 	return true
 }
 
 func (a *ArrayList[E]) AddAllAtIndex(index int, elements collections.Collection[E]) (bool, error) {
+	// Copy the elements before locking, since the collection may be a view of this list.
+	// The other bulk operations below do the same.
+	var elementsArray []E
+	if elements != nil {
+		elementsArray = elements.ToArray()
+	}
+
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
 	if index > len(a.values) || index < 0 {
-		return false, errors.New(string(errcodes.IndexOutOfBoundsError))
+		return false, errcodes.NewIndexError("ArrayList", "AddAllAtIndex", index, len(a.values))
 	}
 	if elements == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "ArrayList", "AddAllAtIndex")
 	}
 
-	elementsArray := elements.ToArray()
 	if len(elementsArray) == 0 {
 		return false, nil
 	}
@@ -140,14 +254,12 @@ func (a *ArrayList[E]) AddAllAtIndex(index int, elements collections.Collection[
 
 	// Shift elements to make space for new elements
 	a.values = append(a.values[:index], append(elementsArray, a.values[index:]...)...)
+	a.modCount++
 
 	return true, nil
 }
 
 func (a *ArrayList[E]) AddAll(collection collections.Collection[E]) bool {
-	a.mu.Lock()
-	defer a.mu.Unlock()
-
 	if collection == nil {
 		return false
 	}
@@ -157,9 +269,13 @@ func (a *ArrayList[E]) AddAll(collection collections.Collection[E]) bool {
 		return false
 	}
 
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	// Ensure capacity for all new elements
 	a.ensureCapacity(len(a.values) + len(elements))
 	a.values = append(a.values, elements...)
+	a.modCount++
 	return true
 }
 
@@ -175,6 +291,7 @@ func (a *ArrayList[E]) AddFirst(element E) {
 
 	// Shift elements to make space for the new element
 	a.values = append([]E{element}, a.values...)
+	a.modCount++
 }
 
 // AddLast adds the specified element at the end of the list.
@@ -187,6 +304,7 @@ func (a *ArrayList[E]) AddLast(element E) {
 	// Ensure capacity for the new element
 	a.ensureCapacity(len(a.values) + 1)
 	a.values = append(a.values, element)
+	a.modCount++
 }
 
 func (a *ArrayList[E]) Clear() {
@@ -194,23 +312,22 @@ func (a *ArrayList[E]) Clear() {
 	defer a.mu.Unlock()
 
 	a.values = make([]E, 0, DefaultCapacity)
+	a.modCount++
 }
 
 func (a *ArrayList[E]) Contains(element E) bool {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
-	for _, val := range a.values {
-		if val == element {
-			return true
-		}
+	if a.equality().index(a.values, element) >= 0 {
+		return true
 	}
 	return false
 }
 
 func (a *ArrayList[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
 	if collection == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "ArrayList", "ContainsAll")
 	}
 	elements := collection.ToArray()
 	if len(elements) == 0 {
@@ -236,12 +353,14 @@ func (a *ArrayList[E]) CopyOf(collection collections.Collection[E]) collections.
 	if len(elements) == 0 {
 		return &ArrayList[E]{
 			values: values,
+			eq:     a.eq,
 		}
 	}
 	values = make([]E, len(elements))
 	copy(values, elements)
 	return &ArrayList[E]{
 		values: values,
+		eq:     a.eq,
 	}
 }
 
@@ -256,8 +375,9 @@ func (a *ArrayList[E]) Equals(collection collections.Collection[E]) bool {
 	if len(a.values) != len(elements) {
 		return false
 	}
+	equal := a.equality().equal
 	for i, val := range elements {
-		if a.values[i] != val {
+		if !equal(a.values[i], val) {
 			return false
 		}
 	}
@@ -269,21 +389,21 @@ func (a *ArrayList[E]) Get(index int) (*E, error) {
 	defer a.mu.RUnlock()
 
 	if index >= len(a.values) || index < 0 {
-		return nil, errors.New(string(errcodes.IndexOutOfBoundsError))
+		return nil, errcodes.NewIndexError("ArrayList", "Get", index, len(a.values))
 	}
 	return &a.values[index], nil
 }
 
 func (a *ArrayList[E]) GetFirst() (*E, error) {
 	if len(a.values) == 0 {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayList", "GetFirst")
 	}
 	return &a.values[0], nil
 }
 
 func (a *ArrayList[E]) GetLast() (*E, error) {
 	if len(a.values) == 0 {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayList", "GetLast")
 	}
 	return &a.values[len(a.values)-1], nil
 }
@@ -296,12 +416,7 @@ func (a *ArrayList[E]) IndexOf(element E) int {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
-	for i, val := range a.values {
-		if val == element {
-			return i
-		}
-	}
-	return -1
+	return a.equality().index(a.values, element)
 }
 
 func (a *ArrayList[E]) IsEmpty() bool {
@@ -316,6 +431,36 @@ func (a *ArrayList[E]) Iterator() collections.Iterator[E] {
 	return iterator
 }
 
+// SnapshotIterator returns an iterator over a copy of the elements taken now.
+// Unlike Iterator, it keeps working if the list is modified during iteration.
+func (a *ArrayList[E]) SnapshotIterator() collections.Iterator[E] {
+	return collections.NewSnapshotIterator(a.ToArray())
+}
+
+// All returns a sequence over a snapshot of the elements in the list, from first to last.
+// The snapshot is taken when iteration starts, so the loop body may modify the list.
+func (a *ArrayList[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, value := range a.ToArray() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over a snapshot of the elements in the list, from last to first.
+// The snapshot is taken when iteration starts, so the loop body may modify the list.
+func (a *ArrayList[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, value := range slices.Backward(a.ToArray()) {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
 // LastIndexOf returns the index of the last occurrence of the specified element,
 // or -1 if the element is not found.
 // This method is thread-safe.
@@ -324,26 +469,14 @@ func (a *ArrayList[E]) LastIndexOf(element E) int {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
-	for i := len(a.values) - 1; i >= 0; i-- {
-		if a.values[i] == element {
-			return i
-		}
-	}
-	return -1
+	return a.equality().lastIndex(a.values, element)
 }
 
 func (a *ArrayList[E]) Remove(element E) bool {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	index := -1
-	for i, val := range a.values {
-		if val == element {
-			index = i
-			break
-		}
-	}
-
+	index := a.equality().index(a.values, element)
 	if index == -1 {
 		return false
 	}
@@ -351,6 +484,7 @@ func (a *ArrayList[E]) Remove(element E) bool {
 	// Shift elements to fill the gap
 	copy(a.values[index:], a.values[index+1:])
 	a.values = a.values[:len(a.values)-1]
+	a.modCount++
 
 	return true
 }
@@ -360,22 +494,14 @@ func (a *ArrayList[E]) RemoveAtIndex(index int) (*E, error) {
 	defer a.mu.Unlock()
 
 	if index >= len(a.values) || index < 0 {
-		return nil, errors.New(string(errcodes.IndexOutOfBoundsError))
+		return nil, errcodes.NewIndexError("ArrayList", "RemoveAtIndex", index, len(a.values))
 	}
 
-	element := a.values[index]
-
-	// Shift elements to fill the gap
-	copy(a.values[index:], a.values[index+1:])
-	a.values = a.values[:len(a.values)-1]
-
+	element := a.removeAt(index)
 	return &element, nil
 }
 
 func (a *ArrayList[E]) RemoveAll(collection collections.Collection[E]) bool {
-	a.mu.Lock()
-	defer a.mu.Unlock()
-
 	if collection == nil {
 		return false
 	}
@@ -385,17 +511,17 @@ func (a *ArrayList[E]) RemoveAll(collection collections.Collection[E]) bool {
 		return false
 	}
 
-	// Create a map for O(1) lookups
-	toRemove := make(map[E]struct{}, len(elements))
-	for _, elem := range elements {
-		toRemove[elem] = struct{}{}
-	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// Build a lookup, O(1) per element for comparable or hashed elements
+	toRemove := a.equality().lookup(elements)
 
 	// Keep track of write position and original length
 	writePos := 0
 	originalLength := len(a.values)
 	for _, val := range a.values {
-		if _, shouldRemove := toRemove[val]; !shouldRemove {
+		if !toRemove(val) {
 			a.values[writePos] = val
 			writePos++
 		}
@@ -403,19 +529,23 @@ func (a *ArrayList[E]) RemoveAll(collection collections.Collection[E]) bool {
 
 	// Trim the slice
 	a.values = a.values[:writePos]
-	return writePos < originalLength
+	if writePos == originalLength {
+		return false
+	}
+	a.modCount++
+	return true
 }
 
 func (a *ArrayList[E]) RemoveFirst() (*E, error) {
 	if len(a.values) == 0 {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayList", "RemoveFirst")
 	}
 	return a.RemoveAtIndex(0)
 }
 
 func (a *ArrayList[E]) RemoveLast() (*E, error) {
 	if len(a.values) == 0 {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayList", "RemoveLast")
 	}
 	return a.RemoveAtIndex(len(a.values) - 1)
 }
@@ -425,7 +555,7 @@ func (a *ArrayList[E]) Set(index int, element E) (*E, error) {
 	defer a.mu.Unlock()
 
 	if index >= len(a.values) || index < 0 {
-		return nil, errors.New(string(errcodes.IndexOutOfBoundsError))
+		return nil, errcodes.NewIndexError("ArrayList", "Set", index, len(a.values))
 	}
 	oldValue := a.values[index]
 	a.values[index] = element
@@ -439,30 +569,27 @@ func (a *ArrayList[E]) Size() int {
 	return len(a.values)
 }
 
+// SubList returns a live view of the elements between fromIndex (inclusive) and toIndex (exclusive).
+// Changes made through the view are written to this list, so list.SubList(a, b).Clear()
+// removes a range in place. Any other structural change to this list invalidates the view,
+// after which its operations fail with ConcurrentModificationError.
 func (a *ArrayList[E]) SubList(fromIndex int, toIndex int) (collections.List[E], error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
 	if fromIndex < 0 || toIndex > len(a.values) {
-		return nil, errors.New(string(errcodes.IndexOutOfBoundsError))
+		return nil, errcodes.NewRangeError("ArrayList", "SubList", fromIndex, toIndex, len(a.values))
 	}
 	if fromIndex > toIndex {
-		return nil, errors.New(string(errcodes.IllegalArgumentError))
-	}
-	values := make([]E, toIndex-fromIndex)
-	for i := fromIndex; i < toIndex; i++ {
-		values[i-fromIndex] = a.values[i]
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "ArrayList", "SubList")
 	}
-	return &ArrayList[E]{
-		values: values,
-	}, nil
+	return newSubList[E](a, fromIndex, toIndex), nil
 }
 
+// Reversed returns a reverse-order view of this list. The view implements collections.List;
+// reads and writes through it are mapped onto this list, which is neither copied nor reordered.
 func (a *ArrayList[E]) Reversed() collections.Collection[E] {
-	a.mu.Lock()
-	defer a.mu.Unlock()
-
-	for i, j := 0, len(a.values)-1; i < j; i, j = i+1, j-1 {
-		a.values[i], a.values[j] = a.values[j], a.values[i]
-	}
-	return a
+	return newReversedList[E](a)
 }
 
 func (a *ArrayList[E]) Sort(comparator collections.Comparator[E]) {
@@ -472,6 +599,7 @@ func (a *ArrayList[E]) Sort(comparator collections.Comparator[E]) {
 	sort.Slice(a.values, func(i, j int) bool {
 		return comparator.Compare(a.values[i], a.values[j]) < 0
 	})
+	a.modCount++
 }
 
 // ToArray returns a slice containing all elements in the list.
@@ -485,46 +613,178 @@ func (a *ArrayList[E]) ToArray() []E {
 	return result
 }
 
-type ArrayListIterator[E comparable] struct {
-	values   []E
-	index    int
-	mu       sync.RWMutex
-	modCount int // For fail-fast iteration
+// ArrayListIterator iterates over the live backing array of an ArrayList.
+// Once the list is structurally modified after the iterator is created, HasNext
+// reports false and Next fails with ConcurrentModificationError.
+type ArrayListIterator[E any] struct {
+	list             *ArrayList[E]
+	cursor           int
+	expectedModCount int
 }
 
-func NewArrayListIterator[E comparable](a *ArrayList[E]) collections.Iterator[E] {
+func NewArrayListIterator[E any](a *ArrayList[E]) collections.Iterator[E] {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
-	values := make([]E, len(a.values))
-	copy(values, a.values)
 	return &ArrayListIterator[E]{
-		values:   values,
-		index:    0,
-		mu:       sync.RWMutex{},
-		modCount: 0,
+		list:             a,
+		expectedModCount: a.modCount,
 	}
 }
 
 func (a *ArrayListIterator[E]) HasNext() bool {
+	a.list.mu.RLock()
+	defer a.list.mu.RUnlock()
+
+	return a.list.modCount == a.expectedModCount && a.cursor < len(a.list.values)
+}
+
+func (a *ArrayListIterator[E]) Next() (*E, error) {
+	a.list.mu.RLock()
+	defer a.list.mu.RUnlock()
+
+	if a.list.modCount != a.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "ArrayList", "Iterator.Next")
+	}
+	if a.cursor >= len(a.list.values) {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayList", "Iterator.Next")
+	}
+	val := a.list.values[a.cursor]
+	a.cursor++
+	return &val, nil
+}
+
+// ListIterator returns a list iterator over the elements in this list, starting at index.
+// An index equal to the size of the list positions the cursor after the last element.
+func (a *ArrayList[E]) ListIterator(index int) (collections.ListIterator[E], error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
-	return a.index < len(a.values)
+	if index < 0 || index > len(a.values) {
+		return nil, errcodes.NewIndexError("ArrayList", "ListIterator", index, len(a.values))
+	}
+	return &arrayListListIterator[E]{
+		list:             a,
+		cursor:           index,
+		lastReturned:     -1,
+		expectedModCount: a.modCount,
+	}, nil
 }
 
-func (a *ArrayListIterator[E]) Next() (*E, error) {
-	a.mu.Lock()
-	defer a.mu.Unlock()
+// arrayListListIterator is a bidirectional, fail-fast iterator over an ArrayList.
+// Changes made through the iterator itself keep it valid.
+type arrayListListIterator[E any] struct {
+	list             *ArrayList[E]
+	cursor           int // Index of the element returned by the next call to Next
+	lastReturned     int // Index of the element last returned by Next or Previous, or -1
+	expectedModCount int
+}
+
+func (it *arrayListListIterator[E]) HasNext() bool {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	return it.list.modCount == it.expectedModCount && it.cursor < len(it.list.values)
+}
+
+func (it *arrayListListIterator[E]) Next() (*E, error) {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
 
-	if a.index >= len(a.values) {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+	if it.list.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "ArrayList", "ListIterator.Next")
 	}
-	val := a.values[a.index]
-	a.index++
+	if it.cursor >= len(it.list.values) {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayList", "ListIterator.Next")
+	}
+	val := it.list.values[it.cursor]
+	it.lastReturned = it.cursor
+	it.cursor++
+	return &val, nil
+}
+
+func (it *arrayListListIterator[E]) HasPrevious() bool {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	return it.list.modCount == it.expectedModCount && it.cursor > 0
+}
+
+func (it *arrayListListIterator[E]) Previous() (*E, error) {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if it.list.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "ArrayList", "ListIterator.Previous")
+	}
+	if it.cursor <= 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayList", "ListIterator.Previous")
+	}
+	it.cursor--
+	it.lastReturned = it.cursor
+	val := it.list.values[it.cursor]
 	return &val, nil
 }
 
+// expectedMods returns the modification count the iterator expects, so a subList view
+// can stay in step with changes made through the iterator.
+func (it *arrayListListIterator[E]) expectedMods() int {
+	return it.expectedModCount
+}
+
+func (it *arrayListListIterator[E]) NextIndex() int {
+	return it.cursor
+}
+
+func (it *arrayListListIterator[E]) PreviousIndex() int {
+	return it.cursor - 1
+}
+
+func (it *arrayListListIterator[E]) Remove() error {
+	it.list.mu.Lock()
+	defer it.list.mu.Unlock()
+
+	if it.list.modCount != it.expectedModCount {
+		return errcodes.New(errcodes.ConcurrentModificationError, "ArrayList", "ListIterator.Remove")
+	}
+	if it.lastReturned < 0 {
+		return errcodes.New(errcodes.IllegalStateError, "ArrayList", "ListIterator.Remove")
+	}
+	it.list.removeAt(it.lastReturned)
+	it.cursor = it.lastReturned
+	it.lastReturned = -1
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+func (it *arrayListListIterator[E]) Set(element E) error {
+	it.list.mu.Lock()
+	defer it.list.mu.Unlock()
+
+	if it.list.modCount != it.expectedModCount {
+		return errcodes.New(errcodes.ConcurrentModificationError, "ArrayList", "ListIterator.Set")
+	}
+	if it.lastReturned < 0 {
+		return errcodes.New(errcodes.IllegalStateError, "ArrayList", "ListIterator.Set")
+	}
+	it.list.values[it.lastReturned] = element
+	return nil
+}
+
+func (it *arrayListListIterator[E]) Add(element E) error {
+	it.list.mu.Lock()
+	defer it.list.mu.Unlock()
+
+	if it.list.modCount != it.expectedModCount {
+		return errcodes.New(errcodes.ConcurrentModificationError, "ArrayList", "ListIterator.Add")
+	}
+	it.list.insertAt(it.cursor, element)
+	it.cursor++
+	it.lastReturned = -1
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
 // TrimToSize reduces the capacity to the current size
 func (a *ArrayList[E]) TrimToSize() {
 	a.mu.Lock()
@@ -559,29 +819,28 @@ func (a *ArrayList[E]) Capacity() int {
 
 // RetainAll keeps only elements that are in the specified collection
 func (a *ArrayList[E]) RetainAll(collection collections.Collection[E]) (bool, error) {
-	a.mu.Lock()
-	defer a.mu.Unlock()
-
 	if collection == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "ArrayList", "RetainAll")
 	}
 
 	elements := collection.ToArray()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	if len(elements) == 0 {
 		a.values = a.values[:0]
+		a.modCount++
 		return true, nil
 	}
 
-	// Create a map for O(1) lookups
-	toRetain := make(map[E]struct{}, len(elements))
-	for _, elem := range elements {
-		toRetain[elem] = struct{}{}
-	}
+	// Build a lookup, O(1) per element for comparable or hashed elements
+	toRetain := a.equality().lookup(elements)
 
 	// Keep track of write position
 	writePos := 0
 	for _, val := range a.values {
-		if _, shouldRetain := toRetain[val]; shouldRetain {
+		if toRetain(val) {
 			a.values[writePos] = val
 			writePos++
 		}
@@ -589,19 +848,25 @@ func (a *ArrayList[E]) RetainAll(collection collections.Collection[E]) (bool, er
 
 	// Trim the slice
 	a.values = a.values[:writePos]
+	a.modCount++
 	return writePos > 0, nil
 }
 
-// Clone creates a deep copy of the list
+// Clone creates a deep copy of the list. The copy is unsynchronized if the list is.
 func (a *ArrayList[E]) Clone() collections.List[E] {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
 	newValues := make([]E, len(a.values))
 	copy(newValues, a.values)
-	return &ArrayList[E]{
+	clone := &ArrayList[E]{
 		values: newValues,
+		eq:     a.eq,
+	}
+	if a.mu.Disabled() {
+		clone.mu.Disable()
 	}
+	return clone
 }
 
 // Shuffle randomly permutes the list
@@ -614,6 +879,7 @@ func (a *ArrayList[E]) Shuffle() {
 		j := rand.Intn(i + 1)
 		a.values[i], a.values[j] = a.values[j], a.values[i]
 	}
+	a.modCount++
 }
 
 // FindFirst finds the first element matching the predicate
@@ -626,7 +892,7 @@ func (a *ArrayList[E]) FindFirst(predicate func(E) bool) (*E, error) {
 			return &val, nil
 		}
 	}
-	return nil, errors.New(string(errcodes.NoSuchElementError))
+	return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayList", "FindFirst")
 }
 
 // FindAll finds all elements matching the predicate
@@ -648,7 +914,7 @@ func (a *ArrayList[E]) Filter(predicate func(E) bool) collections.List[E] {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
-	result := NewArrayList[E]()
+	result := &ArrayList[E]{values: make([]E, 0, DefaultCapacity), eq: a.eq}
 	for _, val := range a.values {
 		if predicate(val) {
 			result.Add(val)
@@ -670,17 +936,17 @@ func (a *ArrayList[E]) ForEach(action func(E)) {
 // AddAllFirst adds all elements from the collection at the beginning
 func (a *ArrayList[E]) AddAllFirst(collection collections.Collection[E]) (bool, error) {
 	if collection == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "ArrayList", "AddAllFirst")
 	}
 
-	a.mu.Lock()
-	defer a.mu.Unlock()
-
 	elements := collection.ToArray()
 	if len(elements) == 0 {
 		return false, nil
 	}
 
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	// Ensure capacity for all new elements
 	a.ensureCapacity(len(a.values) + len(elements))
 
@@ -689,26 +955,28 @@ func (a *ArrayList[E]) AddAllFirst(collection collections.Collection[E]) (bool,
 	copy(newValues, elements)
 	copy(newValues[len(elements):], a.values)
 	a.values = newValues
+	a.modCount++
 	return true, nil
 }
 
 // AddAllLast adds all elements from the collection at the end
 func (a *ArrayList[E]) AddAllLast(collection collections.Collection[E]) (bool, error) {
 	if collection == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "ArrayList", "AddAllLast")
 	}
 
-	a.mu.Lock()
-	defer a.mu.Unlock()
-
 	elements := collection.ToArray()
 	if len(elements) == 0 {
 		return false, nil
 	}
 
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	// Ensure capacity for all new elements
 	a.ensureCapacity(len(a.values) + len(elements))
 	a.values = append(a.values, elements...)
+	a.modCount++
 	return true, nil
 }
 
@@ -729,6 +997,9 @@ func (a *ArrayList[E]) RemoveIf(predicate func(E) bool) bool {
 	}
 
 	a.values = newValues
+	if modified {
+		a.modCount++
+	}
 	return modified
 }
 
@@ -745,7 +1016,7 @@ func (a *ArrayList[E]) ReplaceAll(operator func(E) E) {
 // RemoveRange removes elements in the specified range
 func (a *ArrayList[E]) RemoveRange(fromIndex, toIndex int) error {
 	if fromIndex < 0 || toIndex > len(a.values) || fromIndex > toIndex {
-		return errors.New(string(errcodes.IndexOutOfBoundsError))
+		return errcodes.NewRangeError("ArrayList", "RemoveRange", fromIndex, toIndex, len(a.values))
 	}
 
 	a.mu.Lock()
@@ -755,6 +1026,7 @@ func (a *ArrayList[E]) RemoveRange(fromIndex, toIndex int) error {
 	copy(newValues, a.values[:fromIndex])
 	copy(newValues[fromIndex:], a.values[toIndex:])
 	a.values = newValues
+	a.modCount++
 	return nil
 }
 
@@ -768,21 +1040,11 @@ func (a *ArrayList[E]) FastContains(element E) bool {
 
 	const mapThreshold = 1000 // Configurable threshold for map-based lookup
 	if len(a.values) > mapThreshold {
-		valueMap := make(map[E]struct{}, len(a.values))
-		for _, val := range a.values {
-			valueMap[val] = struct{}{}
-		}
-		_, exists := valueMap[element]
-		return exists
+		return a.equality().lookup(a.values)(element)
 	}
 
 	// Use linear search for small collections
-	for _, val := range a.values {
-		if val == element {
-			return true
-		}
-	}
-	return false
+	return a.equality().index(a.values, element) >= 0
 }
 
 // FastRemoveAll uses a map for O(n) removal of multiple elements
@@ -794,17 +1056,14 @@ func (a *ArrayList[E]) FastRemoveAll(elements []E) bool {
 		return false
 	}
 
-	// Create a map for O(1) lookups
-	toRemove := make(map[E]struct{}, len(elements))
-	for _, elem := range elements {
-		toRemove[elem] = struct{}{}
-	}
+	// Build a lookup, O(1) per element for comparable or hashed elements
+	toRemove := a.equality().lookup(elements)
 
 	// Keep track of write position and original length
 	writePos := 0
 	originalLength := len(a.values)
 	for _, val := range a.values {
-		if _, shouldRemove := toRemove[val]; !shouldRemove {
+		if !toRemove(val) {
 			a.values[writePos] = val
 			writePos++
 		}
@@ -812,7 +1071,11 @@ func (a *ArrayList[E]) FastRemoveAll(elements []E) bool {
 
 	// Trim the slice
 	a.values = a.values[:writePos]
-	return writePos < originalLength
+	if writePos == originalLength {
+		return false
+	}
+	a.modCount++
+	return true
 }
 
 // FastRetainAll uses a map for O(n) retention of multiple elements
@@ -821,7 +1084,7 @@ func (a *ArrayList[E]) FastRetainAll(elements []E) (bool, error) {
 	defer a.mu.Unlock()
 
 	if elements == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "ArrayList", "FastRetainAll")
 	}
 	if len(a.values) == 0 {
 		return false, nil
@@ -829,20 +1092,18 @@ func (a *ArrayList[E]) FastRetainAll(elements []E) (bool, error) {
 
 	if len(elements) == 0 {
 		a.values = a.values[:0]
+		a.modCount++
 		return true, nil
 	}
 
-	// Create a map for O(1) lookups
-	toRetain := make(map[E]struct{}, len(elements))
-	for _, elem := range elements {
-		toRetain[elem] = struct{}{}
-	}
+	// Build a lookup, O(1) per element for comparable or hashed elements
+	toRetain := a.equality().lookup(elements)
 
 	// Keep track of write position and original length
 	writePos := 0
 	originalLength := len(a.values)
 	for _, val := range a.values {
-		if _, shouldRetain := toRetain[val]; shouldRetain {
+		if toRetain(val) {
 			a.values[writePos] = val
 			writePos++
 		}
@@ -850,7 +1111,11 @@ func (a *ArrayList[E]) FastRetainAll(elements []E) (bool, error) {
 
 	// Trim the slice
 	a.values = a.values[:writePos]
-	return writePos < originalLength, nil
+	if writePos == originalLength {
+		return false, nil
+	}
+	a.modCount++
+	return true, nil
 }
 
 // FastIndexOf uses binary search for sorted lists
@@ -860,11 +1125,12 @@ func (a *ArrayList[E]) FastIndexOf(element E, isSorted bool, comparator collecti
 
 	if isSorted && comparator != nil {
 		// Binary search for sorted lists
+		equal := a.equality().equal
 		left, right := 0, len(a.values)-1
 		firstOccurrence := -1
 		for left <= right {
 			mid := (left + right) / 2
-			if a.values[mid] == element {
+			if equal(a.values[mid], element) {
 				firstOccurrence = mid
 				right = mid - 1 // Continue searching left for earlier occurrence
 			} else if comparator.Compare(a.values[mid], element) < 0 {
@@ -877,12 +1143,7 @@ func (a *ArrayList[E]) FastIndexOf(element E, isSorted bool, comparator collecti
 	}
 
 	// Linear search for unsorted lists
-	for i, val := range a.values {
-		if val == element {
-			return i
-		}
-	}
-	return -1
+	return a.equality().index(a.values, element)
 }
 
 // FastLastIndexOf uses reverse linear search for better performance
@@ -890,32 +1151,24 @@ func (a *ArrayList[E]) FastLastIndexOf(element E) int {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
-	for i := len(a.values) - 1; i >= 0; i-- {
-		if a.values[i] == element {
-			return i
-		}
-	}
-	return -1
+	return a.equality().lastIndex(a.values, element)
 }
 
-// FastSubList uses slice operations for better performance
+// FastSubList returns the same live view as SubList. It checks the order of the
+// indices before their bounds, so a reversed range reports IllegalArgumentError first.
 func (a *ArrayList[E]) FastSubList(fromIndex, toIndex int) (collections.List[E], error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
 	if fromIndex > toIndex {
-		return nil, errors.New(string(errcodes.IllegalArgumentError))
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "ArrayList", "FastSubList")
 	}
 
 	if fromIndex < 0 || toIndex > len(a.values) {
-		return nil, errors.New(string(errcodes.IndexOutOfBoundsError))
+		return nil, errcodes.NewRangeError("ArrayList", "FastSubList", fromIndex, toIndex, len(a.values))
 	}
 
-	subValues := make([]E, toIndex-fromIndex)
-	copy(subValues, a.values[fromIndex:toIndex])
-	return &ArrayList[E]{
-		values: subValues,
-	}, nil
+	return newSubList[E](a, fromIndex, toIndex), nil
 }
 
 func (a *ArrayList[E]) RemoveAllBatch(elements []E) bool {
@@ -930,17 +1183,14 @@ func (a *ArrayList[E]) RemoveAllBatch(elements []E) bool {
 		return false
 	}
 
-	// Create a map for O(1) lookups
-	toRemove := make(map[E]struct{}, len(elements))
-	for _, elem := range elements {
-		toRemove[elem] = struct{}{}
-	}
+	// Build a lookup, O(1) per element for comparable or hashed elements
+	toRemove := a.equality().lookup(elements)
 
 	// Keep track of write position and original length
 	writePos := 0
 	originalLength := len(a.values)
 	for _, val := range a.values {
-		if _, shouldRemove := toRemove[val]; !shouldRemove {
+		if !toRemove(val) {
 			a.values[writePos] = val
 			writePos++
 		}
@@ -948,23 +1198,28 @@ func (a *ArrayList[E]) RemoveAllBatch(elements []E) bool {
 
 	// Trim the slice
 	a.values = a.values[:writePos]
-	return writePos < originalLength
+	if writePos == originalLength {
+		return false
+	}
+	a.modCount++
+	return true
 }
 
 func (a *ArrayList[E]) BinarySearch(element E, comparator collections.Comparator[E]) (int, error) {
 	if comparator == nil {
-		return -1, errors.New(string(errcodes.NullPointerError))
+		return -1, errcodes.New(errcodes.NullPointerError, "ArrayList", "BinarySearch")
 	}
 
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
 	// Binary search for sorted lists
+	equal := a.equality().equal
 	left, right := 0, len(a.values)-1
 	firstOccurrence := -1
 	for left <= right {
 		mid := (left + right) / 2
-		if a.values[mid] == element {
+		if equal(a.values[mid], element) {
 			firstOccurrence = mid
 			right = mid - 1 // Continue searching left for earlier occurrence
 		} else if comparator.Compare(a.values[mid], element) < 0 {
@@ -978,18 +1233,19 @@ func (a *ArrayList[E]) BinarySearch(element E, comparator collections.Comparator
 
 func (a *ArrayList[E]) BinarySearchFromIndex(element E, fromIndex, toIndex int, comparator collections.Comparator[E]) (int, error) {
 	if fromIndex < 0 || toIndex > len(a.values) || fromIndex > toIndex {
-		return -1, errors.New(string(errcodes.IndexOutOfBoundsError))
+		return -1, errcodes.NewRangeError("ArrayList", "BinarySearchFromIndex", fromIndex, toIndex, len(a.values))
 	}
 	if comparator == nil {
-		return -1, errors.New(string(errcodes.NullPointerError))
+		return -1, errcodes.New(errcodes.NullPointerError, "ArrayList", "BinarySearchFromIndex")
 	}
 
 	// Binary search for sorted lists
+	equal := a.equality().equal
 	left, right := fromIndex, toIndex-1
 	firstOccurrence := -1
 	for left <= right {
 		mid := (left + right) / 2
-		if a.values[mid] == element {
+		if equal(a.values[mid], element) {
 			firstOccurrence = mid
 			right = mid - 1 // Continue searching left for earlier occurrence
 		} else if comparator.Compare(a.values[mid], element) < 0 {
@@ -1015,5 +1271,6 @@ func (a *ArrayList[E]) AddAllBatch(elements []E) bool {
 	// Ensure capacity for all new elements
 	a.ensureCapacity(len(a.values) + len(elements))
 	a.values = append(a.values, elements...)
+	a.modCount++
 	return true
 }
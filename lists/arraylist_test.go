@@ -1,11 +1,17 @@
 package lists
 
 import (
+	"bytes"
+	"errors"
+	"hash/maphash"
 	"reflect"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/chiranjeevipavurala/gocollections/collections"
 	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -523,6 +529,29 @@ func TestArrayList_Clone(t *testing.T) {
 	assert.Equal(t, 6, clone.Size())
 }
 
+func TestNewUnsynchronizedArrayList(t *testing.T) {
+	list := NewUnsynchronizedArrayList[int]()
+	assert.True(t, list.mu.Disabled())
+	assert.False(t, NewArrayList[int]().mu.Disabled())
+
+	for i := 1; i <= 5; i++ {
+		list.Add(i)
+	}
+	assert.NoError(t, list.AddAtIndex(0, 0))
+	val, err := list.Get(5)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, *val)
+
+	subList, err := list.SubList(1, 3)
+	assert.NoError(t, err)
+	subList.Clear()
+	assert.Equal(t, []int{0, 3, 4, 5}, list.ToArray())
+
+	clone := list.Clone().(*ArrayList[int])
+	assert.True(t, clone.mu.Disabled())
+	assert.Equal(t, list.ToArray(), clone.ToArray())
+}
+
 // TestArrayList_CopyOf tests CopyOf operation
 func TestArrayList_CopyOf(t *testing.T) {
 	list := NewArrayList[int]()
@@ -614,8 +643,45 @@ func TestArrayList_IteratorConcurrentModification(t *testing.T) {
 	// Modify list while iterating
 	list.Add(6)
 	val, err = iterator.Next()
+	assert.Nil(t, val)
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+
+	// Set is not a structural modification
+	iterator = list.Iterator()
+	_, _ = list.Set(0, 10)
+	val, err = iterator.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, *val)
+
+	// A failed removal leaves the iterator valid
+	list.Remove(99)
+	val, err = iterator.Next()
 	assert.NoError(t, err)
 	assert.Equal(t, 2, *val)
+
+	list.RemoveAtIndex(0)
+	_, err = iterator.Next()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+}
+
+// TestArrayList_SnapshotIterator tests that the snapshot iterator ignores later modifications
+func TestArrayList_SnapshotIterator(t *testing.T) {
+	list := NewArrayList[int]()
+	list.AddAll(NewArrayListWithInitialCollection([]int{1, 2, 3}))
+
+	iterator := list.SnapshotIterator()
+	list.Clear()
+	list.Add(4)
+
+	var result []int
+	for iterator.HasNext() {
+		val, err := iterator.Next()
+		assert.NoError(t, err)
+		result = append(result, *val)
+	}
+	assert.Equal(t, []int{1, 2, 3}, result)
+	_, err := iterator.Next()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
 }
 
 // TestArrayList_Shuffle tests shuffle operation with different sizes
@@ -1085,48 +1151,126 @@ func TestArrayList_IndexOf(t *testing.T) {
 	assert.Equal(t, -1, index)
 }
 
-// TestArrayList_Reversed tests Reversed operation
+// TestArrayList_Reversed tests that Reversed returns a view and leaves the list unchanged
 func TestArrayList_Reversed(t *testing.T) {
 	// Test with empty list
 	emptyList := NewArrayList[int]()
-	emptyList.Reversed()
-	assert.Equal(t, 0, emptyList.Size())
-
-	// Test with single element
-	list := NewArrayList[int]()
-	list.Add(1)
-	list.Reversed()
-	val, _ := list.Get(0)
-	assert.Equal(t, 1, *val)
+	assert.Equal(t, 0, emptyList.Reversed().Size())
+	_, err := emptyList.Reversed().(collections.List[int]).GetFirst()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
 
 	// Test with multiple elements
-	list = NewArrayList[int]()
-	list.Add(1)
-	list.Add(2)
-	list.Add(3)
-	list.Reversed()
-	val, _ = list.Get(0)
-	assert.Equal(t, 3, *val)
-	val, _ = list.Get(1)
-	assert.Equal(t, 2, *val)
-	val, _ = list.Get(2)
-	assert.Equal(t, 1, *val)
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3, 4})
+	reversed := list.Reversed().(collections.List[int])
+	assert.Equal(t, []int{4, 3, 2, 1}, reversed.ToArray())
+	assert.Equal(t, []int{1, 2, 3, 4}, list.ToArray())
+	for i, want := range []int{4, 3, 2, 1} {
+		val, err := reversed.Get(i)
+		assert.NoError(t, err)
+		assert.Equal(t, want, *val)
+	}
+	_, err = reversed.Get(4)
+	assert.EqualError(t, err, string(errcodes.IndexOutOfBoundsError))
 
-	// Test with even number of elements
-	list = NewArrayList[int]()
-	list.Add(1)
-	list.Add(2)
-	list.Add(3)
-	list.Add(4)
-	list.Reversed()
-	val, _ = list.Get(0)
-	assert.Equal(t, 4, *val)
-	val, _ = list.Get(1)
-	assert.Equal(t, 3, *val)
-	val, _ = list.Get(2)
-	assert.Equal(t, 2, *val)
-	val, _ = list.Get(3)
-	assert.Equal(t, 1, *val)
+	// Reversing the view gives back the list
+	assert.Same(t, list, reversed.Reversed())
+}
+
+// TestArrayList_ReversedView tests that writes through a reversed view map onto the list
+func TestArrayList_ReversedView(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3})
+	reversed := list.Reversed().(collections.List[int])
+
+	reversed.AddFirst(4)
+	reversed.AddLast(0)
+	assert.True(t, reversed.Add(-1))
+	assert.Equal(t, []int{-1, 0, 1, 2, 3, 4}, list.ToArray())
+	assert.Equal(t, []int{4, 3, 2, 1, 0, -1}, reversed.ToArray())
+
+	assert.NoError(t, reversed.AddAtIndex(1, 35))
+	_, err := reversed.Set(0, 40)
+	assert.NoError(t, err)
+	removed, err := reversed.RemoveAtIndex(5)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, *removed)
+	assert.Equal(t, []int{-1, 1, 2, 3, 35, 40}, list.ToArray())
+
+	ok, err := reversed.AddAllAtIndex(0, NewArrayListWithInitialCollection([]int{50, 45}))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, reversed.AddAll(NewArrayListWithInitialCollection([]int{-2, -3})))
+	assert.Equal(t, []int{50, 45, 40, 35, 3, 2, 1, -1, -2, -3}, reversed.ToArray())
+
+	first, _ := reversed.RemoveFirst()
+	last, _ := reversed.RemoveLast()
+	assert.Equal(t, 50, *first)
+	assert.Equal(t, -3, *last)
+	assert.Equal(t, []int{-2, -1, 1, 2, 3, 35, 40, 45}, list.ToArray())
+
+	// Sorting the view sorts the list the other way
+	reversed.Sort(&IntComparator{})
+	assert.Equal(t, []int{-2, -1, 1, 2, 3, 35, 40, 45}, reversed.ToArray())
+	assert.Equal(t, []int{45, 40, 35, 3, 2, 1, -1, -2}, list.ToArray())
+
+	// Index lookups and removal use the view's order
+	list = NewArrayListWithInitialCollection([]int{1, 2, 1, 3})
+	reversed = list.Reversed().(collections.List[int])
+	assert.Equal(t, 1, reversed.IndexOf(1))
+	assert.Equal(t, 3, reversed.LastIndexOf(1))
+	assert.Equal(t, -1, reversed.IndexOf(9))
+	assert.True(t, reversed.Remove(1))
+	assert.Equal(t, []int{1, 2, 3}, list.ToArray())
+	assert.True(t, reversed.Equals(NewArrayListWithInitialCollection([]int{3, 2, 1})))
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(reversed.All()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(reversed.Backward()))
+
+	// SubList of the view is a reversed view of the matching range
+	list = NewArrayListWithInitialCollection([]int{1, 2, 3, 4, 5})
+	reversed = list.Reversed().(collections.List[int])
+	sub, err := reversed.SubList(1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 3}, sub.ToArray())
+	sub.Clear()
+	assert.Equal(t, []int{1, 2, 5}, list.ToArray())
+}
+
+// TestArrayList_ReversedIterator tests iterating and editing through a reversed view
+func TestArrayList_ReversedIterator(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3, 4})
+	reversed := list.Reversed().(collections.List[int])
+
+	var seen []int
+	for it := reversed.Iterator(); it.HasNext(); {
+		val, err := it.Next()
+		assert.NoError(t, err)
+		seen = append(seen, *val)
+	}
+	assert.Equal(t, []int{4, 3, 2, 1}, seen)
+
+	it, err := reversed.ListIterator(0)
+	assert.NoError(t, err)
+	assert.EqualError(t, it.Remove(), string(errcodes.IllegalStateError))
+	for it.HasNext() {
+		val, _ := it.Next()
+		if *val%2 == 0 {
+			assert.NoError(t, it.Remove())
+		} else {
+			assert.NoError(t, it.Add(*val*10))
+			assert.EqualError(t, it.Set(0), string(errcodes.IllegalStateError))
+		}
+	}
+	assert.Equal(t, []int{3, 30, 1, 10}, reversed.ToArray())
+	assert.Equal(t, 4, it.NextIndex())
+
+	val, err := it.Previous()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, *val)
+	assert.NoError(t, it.Set(11))
+	assert.Equal(t, []int{11, 1, 30, 3}, list.ToArray())
+
+	list.Add(5)
+	_, err = it.Previous()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
 }
 
 // TestArrayList_RetainAll tests RetainAll operation
@@ -2250,3 +2394,387 @@ func TestStringComparator_Compare(t *testing.T) {
 		})
 	}
 }
+
+// TestArrayList_All tests the range-over-func sequences
+func TestArrayList_All(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3})
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(list.All()))
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(list.Backward()))
+	assert.Empty(t, slices.Collect(NewArrayList[int]().All()))
+	assert.Empty(t, slices.Collect(NewArrayList[int]().Backward()))
+
+	// Breaking out of the loop stops the sequence
+	var seen []int
+	for v := range list.All() {
+		seen = append(seen, v)
+		if v == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, seen)
+
+	// The sequence iterates a snapshot, so the body may modify the list
+	seen = nil
+	for v := range list.All() {
+		if v == 3 {
+			list.Add(4)
+		}
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, seen)
+	assert.Equal(t, []int{1, 2, 3, 4}, list.ToArray())
+}
+
+func TestArrayList_AllModifiedInLoop(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{0, 1, 2, 3, 4, 5})
+	var seen []int
+	for v := range list.All() {
+		if v%2 == 0 {
+			list.Remove(v)
+		}
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, seen)
+	assert.Equal(t, []int{1, 3, 5}, list.ToArray())
+
+	seen = nil
+	for v := range list.All() {
+		_ = list.AddAtIndex(0, v*10)
+		list.AddFirst(v * 100)
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []int{1, 3, 5}, seen)
+	assert.Equal(t, 9, list.Size())
+
+	seen = nil
+	for v := range list.Backward() {
+		list.RemoveLast()
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []int{5, 3, 1, 10, 100, 30, 300, 50, 500}, seen)
+	assert.True(t, list.IsEmpty())
+}
+
+// TestArrayList_ListIterator tests bidirectional traversal and edits through a list iterator
+func TestArrayList_ListIterator(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3, 4})
+
+	_, err := list.ListIterator(-1)
+	assert.EqualError(t, err, string(errcodes.IndexOutOfBoundsError))
+	_, err = list.ListIterator(5)
+	assert.EqualError(t, err, string(errcodes.IndexOutOfBoundsError))
+
+	it, err := list.ListIterator(0)
+	assert.NoError(t, err)
+	assert.False(t, it.HasPrevious())
+	assert.Equal(t, 0, it.NextIndex())
+	assert.Equal(t, -1, it.PreviousIndex())
+	_, err = it.Previous()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+
+	// Remove and Set need a preceding Next or Previous
+	assert.EqualError(t, it.Remove(), string(errcodes.IllegalStateError))
+	assert.EqualError(t, it.Set(0), string(errcodes.IllegalStateError))
+
+	// Remove the even elements and double the odd ones
+	for it.HasNext() {
+		val, err := it.Next()
+		assert.NoError(t, err)
+		if *val%2 == 0 {
+			assert.NoError(t, it.Remove())
+			assert.EqualError(t, it.Remove(), string(errcodes.IllegalStateError))
+		} else {
+			assert.NoError(t, it.Set(*val*2))
+		}
+	}
+	assert.Equal(t, []int{2, 6}, list.ToArray())
+	assert.Equal(t, 2, it.NextIndex())
+
+	// Walk backwards, inserting before each element
+	for it.HasPrevious() {
+		val, err := it.Previous()
+		assert.NoError(t, err)
+		assert.NoError(t, it.Add(*val-1))
+		_, _ = it.Previous()
+	}
+	assert.Equal(t, []int{1, 2, 5, 6}, list.ToArray())
+	assert.Equal(t, 0, it.NextIndex())
+
+	// Remove after Previous removes the element at the cursor
+	it, _ = list.ListIterator(list.Size())
+	val, err := it.Previous()
+	assert.NoError(t, err)
+	assert.Equal(t, 6, *val)
+	assert.NoError(t, it.Remove())
+	assert.Equal(t, 3, it.NextIndex())
+	assert.False(t, it.HasNext())
+	assert.Equal(t, []int{1, 2, 5}, list.ToArray())
+
+	// Add at the end appends
+	assert.NoError(t, it.Add(7))
+	assert.EqualError(t, it.Set(8), string(errcodes.IllegalStateError))
+	assert.Equal(t, []int{1, 2, 5, 7}, list.ToArray())
+}
+
+// TestArrayList_ListIteratorConcurrentModification tests that outside changes invalidate a list iterator
+func TestArrayList_ListIteratorConcurrentModification(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3})
+	it, _ := list.ListIterator(1)
+	_, err := it.Next()
+	assert.NoError(t, err)
+
+	list.Add(4)
+	assert.False(t, it.HasNext())
+	assert.False(t, it.HasPrevious())
+	_, err = it.Next()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+	_, err = it.Previous()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+	assert.EqualError(t, it.Remove(), string(errcodes.ConcurrentModificationError))
+	assert.EqualError(t, it.Set(0), string(errcodes.ConcurrentModificationError))
+	assert.EqualError(t, it.Add(0), string(errcodes.ConcurrentModificationError))
+	assert.Equal(t, []int{1, 2, 3, 4}, list.ToArray())
+}
+
+// TestArrayList_SubListView tests that a sublist writes through to its parent
+func TestArrayList_SubListView(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	sub, err := list.SubList(2, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4, 5}, sub.ToArray())
+
+	// Writes go through to the parent
+	old, err := sub.Set(0, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *old)
+	val, _ := list.Get(2)
+	assert.Equal(t, 20, *val)
+
+	// Structural changes through the view keep it valid and shift the parent
+	assert.True(t, sub.Add(55))
+	assert.NoError(t, sub.AddAtIndex(0, 11))
+	assert.Equal(t, []int{11, 20, 3, 4, 5, 55}, sub.ToArray())
+	assert.Equal(t, []int{0, 1, 11, 20, 3, 4, 5, 55, 6, 7, 8, 9}, list.ToArray())
+
+	removed, err := sub.RemoveAtIndex(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, *removed)
+	assert.True(t, sub.Remove(4))
+	assert.False(t, sub.Remove(9), "elements outside the view are not removed")
+	assert.Equal(t, 4, sub.Size())
+	assert.Equal(t, 2, sub.IndexOf(5))
+	assert.Equal(t, -1, sub.IndexOf(0))
+	assert.True(t, sub.Contains(55))
+
+	_, err = sub.Get(4)
+	assert.EqualError(t, err, string(errcodes.IndexOutOfBoundsError))
+
+	// Sorting a view sorts only that range
+	sub.Sort(&IntComparator{})
+	assert.Equal(t, []int{0, 1, 3, 5, 11, 55, 6, 7, 8, 9}, list.ToArray())
+
+	// Clearing a view removes the range in place
+	sub.Clear()
+	assert.True(t, sub.IsEmpty())
+	assert.Equal(t, []int{0, 1, 6, 7, 8, 9}, list.ToArray())
+	assert.True(t, sub.Add(100))
+	assert.Equal(t, []int{0, 1, 100, 6, 7, 8, 9}, list.ToArray())
+
+	// RemoveAll only removes within the view
+	sub, _ = list.SubList(3, 6)
+	assert.True(t, sub.RemoveAll(NewArrayListWithInitialCollection([]int{0, 7})))
+	assert.Equal(t, []int{6, 8}, sub.ToArray())
+	assert.Equal(t, []int{0, 1, 100, 6, 8, 9}, list.ToArray())
+}
+
+// TestArrayList_SubListConcurrentModification tests that parent changes invalidate a sublist
+func TestArrayList_SubListConcurrentModification(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3, 4, 5})
+	sub, _ := list.SubList(1, 3)
+	it := sub.Iterator()
+
+	// Set on the parent is not structural
+	_, _ = list.Set(1, 20)
+	val, err := sub.Get(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, *val)
+
+	list.Add(6)
+	_, err = sub.Get(0)
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+	_, err = sub.Set(0, 1)
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+	_, err = sub.SubList(0, 1)
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+	_, err = sub.ListIterator(0)
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+	_, err = it.Next()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+	assert.False(t, sub.Iterator().HasNext())
+
+	// Operations without an error result leave the parent untouched
+	assert.Equal(t, 0, sub.Size())
+	assert.False(t, sub.Add(7))
+	sub.Clear()
+	assert.Equal(t, []int{1, 20, 3, 4, 5, 6}, list.ToArray())
+}
+
+// TestArrayList_NestedSubList tests that changes through a nested view update every enclosing view
+func TestArrayList_NestedSubList(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{0, 1, 2, 3, 4, 5, 6, 7})
+	outer, _ := list.SubList(1, 7)
+	inner, err := outer.SubList(1, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4}, inner.ToArray())
+
+	inner.Clear()
+	assert.Equal(t, []int{1, 5, 6}, outer.ToArray())
+	assert.Equal(t, []int{0, 1, 5, 6, 7}, list.ToArray())
+
+	inner.Add(9)
+	assert.Equal(t, []int{1, 9, 5, 6}, outer.ToArray())
+
+	// A change through the outer view invalidates the inner one
+	outer.RemoveFirst()
+	assert.Equal(t, []int{9, 5, 6}, outer.ToArray())
+	_, err = inner.Get(0)
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+}
+
+// TestArrayList_SubListIterator tests iteration and editing through a sublist's list iterator
+func TestArrayList_SubListIterator(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3, 4, 5, 6})
+	sub, _ := list.SubList(1, 5)
+
+	var seen []int
+	for it := sub.Iterator(); it.HasNext(); {
+		val, err := it.Next()
+		assert.NoError(t, err)
+		seen = append(seen, *val)
+	}
+	assert.Equal(t, []int{2, 3, 4, 5}, seen)
+	assert.Equal(t, []int{5, 4, 3, 2}, slices.Collect(sub.Backward()))
+
+	it, err := sub.ListIterator(0)
+	assert.NoError(t, err)
+	for it.HasNext() {
+		val, _ := it.Next()
+		if *val%2 == 0 {
+			assert.NoError(t, it.Remove())
+		} else {
+			assert.NoError(t, it.Add(*val*10))
+		}
+	}
+	assert.Equal(t, 4, it.NextIndex())
+	_, err = it.Next()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+	assert.Equal(t, []int{3, 30, 5, 50}, sub.ToArray())
+	assert.Equal(t, []int{1, 3, 30, 5, 50, 6}, list.ToArray())
+
+	for it.HasPrevious() {
+		_, err := it.Previous()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 0, it.NextIndex())
+	_, err = it.Previous()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+}
+
+// TestArrayList_AddAllOwnSubList tests that a list can add a view of itself without deadlocking
+func TestArrayList_AddAllOwnSubList(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3})
+	sub, _ := list.SubList(0, 2)
+	assert.True(t, list.AddAll(sub))
+	assert.Equal(t, []int{1, 2, 3, 1, 2}, list.ToArray())
+
+	sub, _ = list.SubList(3, 5)
+	ok, err := list.AddAllAtIndex(0, sub)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 2, 1, 2, 3, 1, 2}, list.ToArray())
+
+	sub, _ = list.SubList(0, 2)
+	assert.True(t, sub.AddAll(sub))
+	assert.Equal(t, []int{1, 2, 1, 2, 1, 2, 3, 1, 2}, list.ToArray())
+}
+
+func TestArrayList_ErrorDetails(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3})
+
+	_, err := list.Get(5)
+	assert.ErrorIs(t, err, errcodes.ErrIndexOutOfBounds)
+	var indexErr *errcodes.IndexError
+	assert.True(t, errors.As(err, &indexErr))
+	assert.Equal(t, errcodes.IndexError{Type: "ArrayList", Op: "Get", Index: 5, Size: 3}, *indexErr)
+
+	view, err := list.SubList(0, 2)
+	assert.NoError(t, err)
+	_, err = view.SubList(1, 4)
+	assert.True(t, errors.As(err, &indexErr))
+	assert.Equal(t, errcodes.IndexError{Type: "SubList", Op: "SubList", Index: 4, Size: 2}, *indexErr)
+
+	list.Add(4)
+	_, err = view.Get(0)
+	assert.ErrorIs(t, err, errcodes.ErrConcurrentModification)
+	var e *errcodes.Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, "SubList", e.Type)
+	assert.Equal(t, "Get", e.Op)
+
+	_, err = NewArrayList[int]().GetFirst()
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, errcodes.Error{Code: errcodes.NoSuchElementError, Type: "ArrayList", Op: "GetFirst"}, *e)
+}
+
+func TestNewArrayListWithEquality(t *testing.T) {
+	caseInsensitive := collections.EqualerFunc[string](strings.EqualFold)
+	list := NewArrayListWithEquality[string](caseInsensitive)
+	list.Add("Go")
+	list.Add("Rust")
+	list.Add("GO")
+
+	assert.True(t, list.Contains("go"))
+	assert.Equal(t, 0, list.IndexOf("go"))
+	assert.Equal(t, 2, list.LastIndexOf("go"))
+	assert.True(t, list.Equals(NewArrayListWithInitialCollection([]string{"go", "RUST", "go"})))
+	assert.True(t, list.Remove("rust"))
+	assert.Equal(t, []string{"Go", "GO"}, list.ToArray())
+
+	view, err := list.SubList(0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, view.LastIndexOf("go"))
+	assert.True(t, view.Reversed().Equals(NewArrayListWithInitialCollection([]string{"go", "go"})))
+
+	clone := list.Clone()
+	assert.True(t, clone.Contains("gO"))
+	assert.True(t, list.RemoveAll(NewArrayListWithInitialCollection([]string{"go"})))
+	assert.True(t, list.IsEmpty())
+}
+
+func TestNewArrayListWithEquality_NonComparableElements(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hasher := collections.NewHasher(
+		func(b []byte) uint64 { return maphash.Bytes(seed, b) },
+		bytes.Equal,
+	)
+	list := NewArrayListWithEquality[[]byte](hasher)
+	for _, s := range []string{"a", "b", "c", "b"} {
+		list.Add([]byte(s))
+	}
+
+	assert.True(t, list.Contains([]byte("c")))
+	assert.Equal(t, 1, list.IndexOf([]byte("b")))
+	assert.Equal(t, 3, list.FastLastIndexOf([]byte("b")))
+	assert.Equal(t, -1, list.IndexOf([]byte("d")))
+
+	changed, err := list.RetainAll(NewArrayListWithEquality[[]byte](hasher))
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	list.AddAllBatch([][]byte{[]byte("a"), []byte("b"), []byte("b")})
+	assert.True(t, list.FastRemoveAll([][]byte{[]byte("b")}))
+	assert.Equal(t, [][]byte{[]byte("a")}, list.ToArray())
+	assert.Equal(t, [][]byte{[]byte("a")}, list.Filter(func([]byte) bool { return true }).ToArray())
+}
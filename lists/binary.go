@@ -0,0 +1,140 @@
+package lists
+
+import (
+	"io"
+	"slices"
+
+	"github.com/chiranjeevipavurala/gocollections/internal/codec"
+)
+
+// Lists and stacks use the binary format of the internal codec package, in which the
+// elements are gob-encoded in iteration order. WriteTo streams the elements while holding
+// the read lock, so writers wait until it returns. ReadFrom, UnmarshalBinary and
+// GobDecode replace the current contents.
+
+// WriteTo writes the list to w in the binary format and returns the number of bytes written.
+func (a *ArrayList[E]) WriteTo(w io.Writer) (int64, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return codec.WriteSequence(w, codec.Sequence, len(a.values), slices.Values(a.values))
+}
+
+// ReadFrom replaces the contents of the list with a collection read from r in the binary
+// format and returns the number of bytes read.
+func (a *ArrayList[E]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSequence[E](r, codec.Sequence)
+	if err != nil {
+		return n, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.values = values
+	a.modCount++
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (a *ArrayList[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(a)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (a *ArrayList[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(a, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (a *ArrayList[E]) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (a *ArrayList[E]) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
+
+// WriteTo writes the list to w in the binary format and returns the number of bytes written.
+func (l *LinkedList[E]) WriteTo(w io.Writer) (int64, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return codec.WriteSequence(w, codec.Sequence, l.size, func(yield func(E) bool) {
+		for current := l.head; current != nil; current = current.GetNext() {
+			if !yield(*current.GetData()) {
+				return
+			}
+		}
+	})
+}
+
+// ReadFrom replaces the contents of the list with a collection read from r in the binary
+// format and returns the number of bytes read.
+func (l *LinkedList[E]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSequence[E](r, codec.Sequence)
+	if err != nil {
+		return n, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.reset()
+	for _, value := range values {
+		l.linkBefore(value, nil)
+	}
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (l *LinkedList[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(l)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (l *LinkedList[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(l, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (l *LinkedList[E]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (l *LinkedList[E]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+// WriteTo writes the stack to w in the binary format, from bottom to top, and returns
+// the number of bytes written.
+func (s *Stack[E]) WriteTo(w io.Writer) (int64, error) {
+	return s.list.WriteTo(w)
+}
+
+// ReadFrom replaces the contents of the stack with a collection read from r in the binary
+// format and returns the number of bytes read. The last element becomes the top of the stack.
+func (s *Stack[E]) ReadFrom(r io.Reader) (int64, error) {
+	if s.list == nil {
+		s.list = NewArrayList[E]()
+	}
+	return s.list.ReadFrom(r)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s *Stack[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(s)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Stack[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(s, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (s *Stack[E]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (s *Stack[E]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
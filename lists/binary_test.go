@@ -0,0 +1,122 @@
+package lists
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*ArrayList[int])(nil)
+	_ encoding.BinaryUnmarshaler = (*LinkedList[int])(nil)
+	_ gob.GobEncoder             = (*Stack[int])(nil)
+	_ gob.GobDecoder             = (*Stack[int])(nil)
+)
+
+func TestArrayList_Binary(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]string{"b", "a", "c"})
+	data, err := list.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := NewArrayList[string]()
+	decoded.Add("old")
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, []string{"b", "a", "c"}, decoded.ToArray())
+
+	// A zero list can be decoded into and an empty list round-trips
+	var zero ArrayList[string]
+	assert.NoError(t, zero.UnmarshalBinary(data))
+	assert.Equal(t, []string{"b", "a", "c"}, zero.ToArray())
+	data, err = NewArrayList[string]().MarshalBinary()
+	assert.NoError(t, err)
+	assert.NoError(t, zero.UnmarshalBinary(data))
+	assert.True(t, zero.IsEmpty())
+}
+
+func TestArrayList_BinaryLarge(t *testing.T) {
+	// More elements than fit in one chunk of the stream
+	values := make([]int, 10000)
+	for i := range values {
+		values[i] = i * 3
+	}
+	list := NewArrayListWithInitialCollection(values)
+
+	var buf bytes.Buffer
+	written, err := list.WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), written)
+
+	decoded := NewArrayList[int]()
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, written, read)
+	assert.Equal(t, values, decoded.ToArray())
+}
+
+func TestArrayList_BinaryInvalid(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2, 3})
+	data, err := list.MarshalBinary()
+	assert.NoError(t, err)
+
+	// Bad input is rejected and leaves the list unchanged
+	assert.EqualError(t, list.UnmarshalBinary(nil), string(errcodes.InvalidFormatError))
+	assert.EqualError(t, list.UnmarshalBinary([]byte("not a collection")), string(errcodes.InvalidFormatError))
+	assert.EqualError(t, list.UnmarshalBinary(data[:len(data)-1]), string(errcodes.InvalidFormatError))
+	assert.Error(t, NewArrayList[string]().UnmarshalBinary(data))
+	assert.Equal(t, []int{1, 2, 3}, list.ToArray())
+
+	// Decoding invalidates iterators
+	it := list.Iterator()
+	assert.NoError(t, list.UnmarshalBinary(data))
+	_, err = it.Next()
+	assert.Error(t, err)
+}
+
+func TestLinkedList_Binary(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.Add(3)
+	list.Add(1)
+	data, err := list.MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded LinkedList[int]
+	decoded.Add(9)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, []int{3, 1}, decoded.ToArray())
+	assert.Equal(t, 2, decoded.Size())
+	last, err := decoded.GetLast()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *last)
+}
+
+func TestStack_Binary(t *testing.T) {
+	stack := NewStack[string]()
+	stack.Push("bottom")
+	stack.Push("top")
+
+	// Collections nested in other values round-trip through gob
+	type snapshot struct {
+		Name  string
+		Stack *Stack[string]
+		List  *ArrayList[int]
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(snapshot{
+		Name:  "s",
+		Stack: stack,
+		List:  NewArrayListWithInitialCollection([]int{1, 2}),
+	}))
+
+	var decoded snapshot
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, "s", decoded.Name)
+	assert.Equal(t, []int{1, 2}, decoded.List.ToArray())
+	top, err := decoded.Stack.Pop()
+	assert.NoError(t, err)
+	assert.Equal(t, "top", *top)
+	assert.Equal(t, 1, decoded.Stack.Size())
+}
@@ -0,0 +1,95 @@
+package lists
+
+import (
+	"slices"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+)
+
+// elementEquality is how a list compares its elements. Lists of comparable elements use ==
+// and Go maps; lists created with an Equaler call it instead, and hash the elements for bulk
+// lookups when the Equaler is also a Hasher.
+type elementEquality[E any] struct {
+	equal func(a, b E) bool
+	// index and lastIndex return the position of the first or last element of values
+	// equal to element, or -1.
+	index     func(values []E, element E) int
+	lastIndex func(values []E, element E) int
+	// lookup returns a function reporting whether an element equals one of elements.
+	lookup func(elements []E) func(E) bool
+}
+
+// comparableEquality compares elements with ==.
+func comparableEquality[E comparable]() elementEquality[E] {
+	return elementEquality[E]{
+		equal:     equalComparable[E],
+		index:     slices.Index[[]E],
+		lastIndex: lastIndexComparable[E],
+		lookup:    lookupComparable[E],
+	}
+}
+
+func equalComparable[E comparable](a, b E) bool { return a == b }
+
+func lastIndexComparable[E comparable](values []E, element E) int {
+	for i := len(values) - 1; i >= 0; i-- {
+		if values[i] == element {
+			return i
+		}
+	}
+	return -1
+}
+
+func lookupComparable[E comparable](elements []E) func(E) bool {
+	set := make(map[E]struct{}, len(elements))
+	for _, element := range elements {
+		set[element] = struct{}{}
+	}
+	return func(element E) bool {
+		_, ok := set[element]
+		return ok
+	}
+}
+
+// dynamicEquality compares elements with == on their interface values. It is used by the
+// zero ArrayList, whose element type is not known to be comparable, and panics like == does
+// when the elements are not.
+func dynamicEquality[E any]() elementEquality[E] {
+	return equalerEquality[E](collections.EqualerFunc[E](func(a, b E) bool { return any(a) == any(b) }))
+}
+
+// equalerEquality compares elements with equaler.
+func equalerEquality[E any](equaler collections.Equaler[E]) elementEquality[E] {
+	eq := elementEquality[E]{
+		equal: equaler.Equal,
+		index: func(values []E, element E) int {
+			return slices.IndexFunc(values, func(v E) bool { return equaler.Equal(v, element) })
+		},
+		lastIndex: func(values []E, element E) int {
+			for i := len(values) - 1; i >= 0; i-- {
+				if equaler.Equal(values[i], element) {
+					return i
+				}
+			}
+			return -1
+		},
+		lookup: func(elements []E) func(E) bool {
+			return func(element E) bool {
+				return slices.ContainsFunc(elements, func(v E) bool { return equaler.Equal(v, element) })
+			}
+		},
+	}
+	if hasher, ok := equaler.(collections.Hasher[E]); ok {
+		eq.lookup = func(elements []E) func(E) bool {
+			buckets := make(map[uint64][]E, len(elements))
+			for _, element := range elements {
+				hash := hasher.Hash(element)
+				buckets[hash] = append(buckets[hash], element)
+			}
+			return func(element E) bool {
+				return slices.ContainsFunc(buckets[hasher.Hash(element)], func(v E) bool { return hasher.Equal(v, element) })
+			}
+		}
+	}
+	return eq
+}
@@ -0,0 +1,83 @@
+package lists
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Lists and stacks are encoded as JSON arrays in iteration order. Decoding replaces the
+// current contents, and, as encoding/json recommends, decoding a JSON null is a no-op.
+
+// MarshalJSON encodes the list as a JSON array.
+func (a *ArrayList[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(a.ToArray())
+}
+
+// UnmarshalJSON replaces the contents of the list with the elements of a JSON array.
+func (a *ArrayList[E]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[E](data)
+	if err != nil || values == nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.values = values
+	a.modCount++
+	return nil
+}
+
+// MarshalJSON encodes the list as a JSON array.
+func (l *LinkedList[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(l.ToArray())
+}
+
+// UnmarshalJSON replaces the contents of the list with the elements of a JSON array.
+func (l *LinkedList[E]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[E](data)
+	if err != nil || values == nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.reset()
+	for _, value := range values {
+		l.linkBefore(value, nil)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the stack as a JSON array from bottom to top.
+func (s *Stack[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(s.ToArray())
+}
+
+// UnmarshalJSON replaces the contents of the stack with the elements of a JSON array.
+// The last element of the array becomes the top of the stack.
+func (s *Stack[E]) UnmarshalJSON(data []byte) error {
+	if s.list == nil {
+		s.list = NewArrayList[E]()
+	}
+	return s.list.UnmarshalJSON(data)
+}
+
+// marshalJSONArray encodes values as a JSON array, writing an empty array rather than null for no values.
+func marshalJSONArray[E any](values []E) ([]byte, error) {
+	if values == nil {
+		values = []E{}
+	}
+	return json.Marshal(values)
+}
+
+// unmarshalJSONArray decodes a JSON array. It returns nil without an error for a JSON null.
+func unmarshalJSONArray[E any](data []byte) ([]E, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, nil
+	}
+	values := []E{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
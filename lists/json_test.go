@@ -0,0 +1,89 @@
+package lists
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayList_JSON(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]string{"b", "a", "c"})
+	data, err := json.Marshal(list)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["b","a","c"]`, string(data))
+
+	decoded := NewArrayList[string]()
+	decoded.Add("old")
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, []string{"b", "a", "c"}, decoded.ToArray())
+
+	// A zero list can be decoded into and an empty list encodes as an empty array
+	var zero ArrayList[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[1,2]`), &zero))
+	assert.Equal(t, []int{1, 2}, zero.ToArray())
+	data, err = json.Marshal(NewArrayList[int]())
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	// Decoding null leaves the list unchanged, and bad input is rejected
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &zero))
+	assert.Equal(t, []int{1, 2}, zero.ToArray())
+	assert.Error(t, json.Unmarshal([]byte(`{"a":1}`), &zero))
+	assert.Error(t, json.Unmarshal([]byte(`["x"]`), &zero))
+	assert.Equal(t, []int{1, 2}, zero.ToArray())
+}
+
+func TestArrayList_JSONInvalidatesIterators(t *testing.T) {
+	list := NewArrayListWithInitialCollection([]int{1, 2})
+	it := list.Iterator()
+	assert.NoError(t, json.Unmarshal([]byte(`[3]`), list))
+	_, err := it.Next()
+	assert.Error(t, err)
+}
+
+func TestLinkedList_JSON(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.Add(3)
+	list.Add(1)
+	data, err := json.Marshal(list)
+	assert.NoError(t, err)
+	assert.Equal(t, `[3,1]`, string(data))
+
+	var decoded LinkedList[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[5,6,7]`), &decoded))
+	assert.Equal(t, []int{5, 6, 7}, decoded.ToArray())
+	assert.Equal(t, 3, decoded.Size())
+	last, err := decoded.GetLast()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, *last)
+	assert.NoError(t, json.Unmarshal([]byte(`[]`), &decoded))
+	assert.True(t, decoded.IsEmpty())
+}
+
+func TestStack_JSON(t *testing.T) {
+	stack := NewStack[string]()
+	stack.Push("bottom")
+	stack.Push("top")
+	data, err := json.Marshal(stack)
+	assert.NoError(t, err)
+	assert.Equal(t, `["bottom","top"]`, string(data))
+
+	var decoded Stack[string]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	top, err := decoded.Pop()
+	assert.NoError(t, err)
+	assert.Equal(t, "top", *top)
+
+	// Collections nested in other values round-trip too
+	type document struct {
+		Tags *ArrayList[string] `json:"tags"`
+	}
+	in := document{Tags: NewArrayListWithInitialCollection([]string{"x", "y"})}
+	data, err = json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"tags":["x","y"]}`, string(data))
+	var out document
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, []string{"x", "y"}, out.Tags.ToArray())
+}
@@ -1,19 +1,24 @@
 package lists
 
 import (
-	"errors"
+	"iter"
+	"slices"
 	"sort"
-	"sync"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
 	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/locking"
 )
 
 type LinkedList[E comparable] struct {
 	head ListNode[E]
 	tail ListNode[E]
 	size int
-	mu   sync.RWMutex
+	// modCount counts structural modifications so that iterators can fail fast
+	modCount int
+	// resets counts the times the whole chain was dropped, which leaves its nodes linked to each other
+	resets int
+	mu     locking.RWMutex
 }
 
 func NewLinkedList[E comparable]() *LinkedList[E] {
@@ -21,7 +26,6 @@ func NewLinkedList[E comparable]() *LinkedList[E] {
 		head: nil,
 		tail: nil,
 		size: 0,
-		mu:   sync.RWMutex{},
 	}
 }
 
@@ -33,6 +37,14 @@ func NewLinkedListWithInitialCollection[E comparable](values []E) *LinkedList[E]
 	return list
 }
 
+// NewUnsynchronizedLinkedList returns an empty LinkedList that takes no locks. It is faster
+// when the list is only used by one goroutine, and must not be used by several at once.
+func NewUnsynchronizedLinkedList[E comparable]() *LinkedList[E] {
+	list := NewLinkedList[E]()
+	list.mu.Disable()
+	return list
+}
+
 func (l *LinkedList[E]) Add(element E) bool {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -47,6 +59,7 @@ func (l *LinkedList[E]) Add(element E) bool {
 		l.tail = newNode
 	}
 	l.size++
+	l.modCount++
 	return true
 }
 
@@ -55,7 +68,7 @@ func (l *LinkedList[E]) AddAtIndex(index int, element E) error {
 	defer l.mu.Unlock()
 
 	if index > l.size || index < 0 {
-		return errors.New(string(errcodes.IndexOutOfBoundsError))
+		return errcodes.NewIndexError("LinkedList", "AddAtIndex", index, l.size)
 	}
 
 	newNode := NewListNodeImpl(element)
@@ -83,6 +96,7 @@ func (l *LinkedList[E]) AddAtIndex(index int, element E) error {
 		current.SetNext(newNode)
 	}
 	l.size++
+	l.modCount++
 	return nil
 }
 
@@ -105,6 +119,7 @@ func (l *LinkedList[E]) AddLast(element E) {
 		l.tail = newNode
 	}
 	l.size++
+	l.modCount++
 }
 
 func (l *LinkedList[E]) AddAll(collection collections.Collection[E]) bool {
@@ -134,12 +149,13 @@ func (l *LinkedList[E]) AddAllBatch(elements []E) bool {
 		}
 		l.size++
 	}
+	l.modCount++
 	return true
 }
 
 func (l *LinkedList[E]) AddAllAtIndex(index int, elements collections.Collection[E]) (bool, error) {
 	if elements == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "LinkedList", "AddAllAtIndex")
 	}
 	return l.AddAllAtIndexBatch(index, elements.ToArray())
 }
@@ -153,7 +169,7 @@ func (l *LinkedList[E]) AddAllAtIndexBatch(index int, elements []E) (bool, error
 	defer l.mu.Unlock()
 
 	if index > l.size || index < 0 {
-		return false, errors.New(string(errcodes.IndexOutOfBoundsError))
+		return false, errcodes.NewIndexError("LinkedList", "AddAllAtIndexBatch", index, l.size)
 	}
 
 	// Create a sublist of new nodes
@@ -195,6 +211,7 @@ func (l *LinkedList[E]) AddAllAtIndexBatch(index int, elements []E) (bool, error
 	}
 
 	l.size += len(elements)
+	l.modCount++
 	return true, nil
 }
 
@@ -202,9 +219,7 @@ func (l *LinkedList[E]) Clear() {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	l.head = nil
-	l.tail = nil
-	l.size = 0
+	l.reset()
 }
 
 func (l *LinkedList[E]) Contains(element E) bool {
@@ -223,7 +238,7 @@ func (l *LinkedList[E]) Contains(element E) bool {
 
 func (l *LinkedList[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
 	if collection == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "LinkedList", "ContainsAll")
 	}
 	if collection.Size() == 0 {
 		return true, nil
@@ -279,7 +294,7 @@ func (l *LinkedList[E]) Equals(collection collections.Collection[E]) bool {
 }
 
 func (l *LinkedList[E]) Get(index int) (*E, error) {
-	if err := l.checkIndex(index); err != nil {
+	if err := l.checkIndex("Get", index); err != nil {
 		return nil, err
 	}
 
@@ -310,7 +325,7 @@ func (l *LinkedList[E]) GetFirst() (*E, error) {
 	defer l.mu.RUnlock()
 
 	if l.size == 0 {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedList", "GetFirst")
 	}
 	val := *l.head.GetData()
 	return &val, nil
@@ -321,7 +336,7 @@ func (l *LinkedList[E]) GetLast() (*E, error) {
 	defer l.mu.RUnlock()
 
 	if l.size == 0 {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedList", "GetLast")
 	}
 	val := *l.tail.GetData()
 	return &val, nil
@@ -347,62 +362,81 @@ func (l *LinkedList[E]) IsEmpty() bool {
 	return l.size == 0
 }
 
-// ListIteratorImpl is a thread-safe iterator implementation
+// ListIteratorImpl is a thread-safe, fail-fast iterator over a LinkedList.
+// Once the list is structurally modified after the iterator is created, HasNext
+// reports false and Next fails with ConcurrentModificationError.
 type ListIteratorImpl[E comparable] struct {
-	current ListNode[E]
-	mu      *sync.RWMutex
+	list             *LinkedList[E]
+	current          ListNode[E]
+	expectedModCount int
 }
 
-func NewListIteratorImpl[E comparable](head ListNode[E], mu *sync.RWMutex) collections.Iterator[E] {
+// NewListIteratorImpl returns an iterator starting at the head of the list.
+func NewListIteratorImpl[E comparable](list *LinkedList[E]) collections.Iterator[E] {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
 	return &ListIteratorImpl[E]{
-		current: head,
-		mu:      mu,
+		list:             list,
+		current:          list.head,
+		expectedModCount: list.modCount,
 	}
 }
 
 func (iter *ListIteratorImpl[E]) HasNext() bool {
-	iter.mu.RLock()
-	defer iter.mu.RUnlock()
-	return iter.current != nil
+	iter.list.mu.RLock()
+	defer iter.list.mu.RUnlock()
+	return iter.list.modCount == iter.expectedModCount && iter.current != nil
 }
 
 func (iter *ListIteratorImpl[E]) Next() (*E, error) {
-	iter.mu.RLock()
-	defer iter.mu.RUnlock()
+	iter.list.mu.RLock()
+	defer iter.list.mu.RUnlock()
 
+	if iter.list.modCount != iter.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "LinkedList", "Iterator.Next")
+	}
 	if iter.current == nil {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedList", "Iterator.Next")
 	}
 	val := *iter.current.GetData()
 	iter.current = iter.current.GetNext()
 	return &val, nil
 }
 
-// DescendingIteratorImpl is a thread-safe iterator implementation
+// DescendingIteratorImpl is a thread-safe, fail-fast iterator over a LinkedList
+// from tail to head. It follows the same rules as ListIteratorImpl.
 type DescendingIteratorImpl[E comparable] struct {
-	current ListNode[E]
-	mu      *sync.RWMutex
+	list             *LinkedList[E]
+	current          ListNode[E]
+	expectedModCount int
 }
 
-func NewDescendingIteratorImpl[E comparable](tail ListNode[E], mu *sync.RWMutex) collections.Iterator[E] {
+// NewDescendingIteratorImpl returns an iterator starting at the tail of the list.
+func NewDescendingIteratorImpl[E comparable](list *LinkedList[E]) collections.Iterator[E] {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
 	return &DescendingIteratorImpl[E]{
-		current: tail,
-		mu:      mu,
+		list:             list,
+		current:          list.tail,
+		expectedModCount: list.modCount,
 	}
 }
 
 func (iter *DescendingIteratorImpl[E]) HasNext() bool {
-	iter.mu.RLock()
-	defer iter.mu.RUnlock()
-	return iter.current != nil
+	iter.list.mu.RLock()
+	defer iter.list.mu.RUnlock()
+	return iter.list.modCount == iter.expectedModCount && iter.current != nil
 }
 
 func (iter *DescendingIteratorImpl[E]) Next() (*E, error) {
-	iter.mu.RLock()
-	defer iter.mu.RUnlock()
+	iter.list.mu.RLock()
+	defer iter.list.mu.RUnlock()
 
+	if iter.list.modCount != iter.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "LinkedList", "DescendingIterator.Next")
+	}
 	if iter.current == nil {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedList", "DescendingIterator.Next")
 	}
 	val := *iter.current.GetData()
 	iter.current = iter.current.GetPrev()
@@ -410,15 +444,211 @@ func (iter *DescendingIteratorImpl[E]) Next() (*E, error) {
 }
 
 func (l *LinkedList[E]) Iterator() collections.Iterator[E] {
+	return NewListIteratorImpl(l)
+}
+
+func (l *LinkedList[E]) DescendingIterator() collections.Iterator[E] {
+	return NewDescendingIteratorImpl(l)
+}
+
+// SnapshotIterator returns an iterator over a copy of the elements taken now.
+// Unlike Iterator, it keeps working if the list is modified during iteration.
+func (l *LinkedList[E]) SnapshotIterator() collections.Iterator[E] {
+	return collections.NewSnapshotIterator(l.ToArray())
+}
+
+// ListIterator returns a list iterator over the elements in this list, starting at index.
+// Positioning the iterator is O(n); Remove, Set and Add at the cursor are O(1).
+func (l *LinkedList[E]) ListIterator(index int) (collections.ListIterator[E], error) {
 	l.mu.RLock()
 	defer l.mu.RUnlock()
-	return NewListIteratorImpl(l.head, &l.mu)
+
+	if index < 0 || index > l.size {
+		return nil, errcodes.NewIndexError("LinkedList", "ListIterator", index, l.size)
+	}
+	var next ListNode[E]
+	if index < l.size {
+		next = l.node(index)
+	}
+	return &linkedListListIterator[E]{
+		list:             l,
+		next:             next,
+		nextIndex:        index,
+		expectedModCount: l.modCount,
+	}, nil
 }
 
-func (l *LinkedList[E]) DescendingIterator() collections.Iterator[E] {
+// linkedListListIterator is a bidirectional, fail-fast iterator over a LinkedList.
+// Changes made through the iterator itself keep it valid.
+type linkedListListIterator[E comparable] struct {
+	list             *LinkedList[E]
+	next             ListNode[E] // Node returned by the next call to Next, nil at the end
+	lastReturned     ListNode[E] // Node last returned by Next or Previous, nil after Remove or Add
+	nextIndex        int
+	expectedModCount int
+}
+
+func (it *linkedListListIterator[E]) HasNext() bool {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+	return it.list.modCount == it.expectedModCount && it.nextIndex < it.list.size
+}
+
+func (it *linkedListListIterator[E]) Next() (*E, error) {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if it.list.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "LinkedList", "ListIterator.Next")
+	}
+	if it.next == nil {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedList", "ListIterator.Next")
+	}
+	it.lastReturned = it.next
+	it.next = it.next.GetNext()
+	it.nextIndex++
+	val := *it.lastReturned.GetData()
+	return &val, nil
+}
+
+func (it *linkedListListIterator[E]) HasPrevious() bool {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+	return it.list.modCount == it.expectedModCount && it.nextIndex > 0
+}
+
+func (it *linkedListListIterator[E]) Previous() (*E, error) {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if it.list.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "LinkedList", "ListIterator.Previous")
+	}
+	if it.nextIndex <= 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedList", "ListIterator.Previous")
+	}
+	if it.next == nil {
+		it.next = it.list.tail
+	} else {
+		it.next = it.next.GetPrev()
+	}
+	it.lastReturned = it.next
+	it.nextIndex--
+	val := *it.lastReturned.GetData()
+	return &val, nil
+}
+
+func (it *linkedListListIterator[E]) expectedMods() int {
+	return it.expectedModCount
+}
+
+func (it *linkedListListIterator[E]) NextIndex() int {
+	return it.nextIndex
+}
+
+func (it *linkedListListIterator[E]) PreviousIndex() int {
+	return it.nextIndex - 1
+}
+
+func (it *linkedListListIterator[E]) Remove() error {
+	it.list.mu.Lock()
+	defer it.list.mu.Unlock()
+
+	if it.list.modCount != it.expectedModCount {
+		return errcodes.New(errcodes.ConcurrentModificationError, "LinkedList", "ListIterator.Remove")
+	}
+	if it.lastReturned == nil {
+		return errcodes.New(errcodes.IllegalStateError, "LinkedList", "ListIterator.Remove")
+	}
+	if it.next == it.lastReturned {
+		// The last call was Previous, so the cursor stays put and Next moves on
+		it.next = it.lastReturned.GetNext()
+	} else {
+		it.nextIndex--
+	}
+	it.list.unlink(it.lastReturned)
+	it.lastReturned = nil
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+func (it *linkedListListIterator[E]) Set(element E) error {
+	it.list.mu.Lock()
+	defer it.list.mu.Unlock()
+
+	if it.list.modCount != it.expectedModCount {
+		return errcodes.New(errcodes.ConcurrentModificationError, "LinkedList", "ListIterator.Set")
+	}
+	if it.lastReturned == nil {
+		return errcodes.New(errcodes.IllegalStateError, "LinkedList", "ListIterator.Set")
+	}
+	it.lastReturned.SetData(element)
+	return nil
+}
+
+func (it *linkedListListIterator[E]) Add(element E) error {
+	it.list.mu.Lock()
+	defer it.list.mu.Unlock()
+
+	if it.list.modCount != it.expectedModCount {
+		return errcodes.New(errcodes.ConcurrentModificationError, "LinkedList", "ListIterator.Add")
+	}
+	it.list.linkBefore(element, it.next)
+	it.nextIndex++
+	it.lastReturned = nil
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+// All returns a sequence over the elements in the list from first to last.
+// The nodes are fixed when iteration starts and the read lock is released while the loop
+// body runs, so the body may modify the list. An element removed before it is reached is
+// skipped, and an element added during the loop is not yielded.
+func (l *LinkedList[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		nodes, resets := l.nodes()
+		l.walk(nodes, resets, yield)
+	}
+}
+
+// Backward returns a sequence over the elements in the list from last to first.
+// Like All, it may be used while the loop body modifies the list.
+func (l *LinkedList[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		nodes, resets := l.nodes()
+		slices.Reverse(nodes)
+		l.walk(nodes, resets, yield)
+	}
+}
+
+// nodes returns the nodes of the list from first to last and the reset count, under the read lock.
+func (l *LinkedList[E]) nodes() ([]ListNode[E], int) {
 	l.mu.RLock()
 	defer l.mu.RUnlock()
-	return NewDescendingIteratorImpl(l.tail, &l.mu)
+
+	nodes := make([]ListNode[E], 0, l.size)
+	for current := l.head; current != nil; current = current.GetNext() {
+		nodes = append(nodes, current)
+	}
+	return nodes, l.resets
+}
+
+// walk yields the values of nodes that are still in the list when they are reached,
+// reading each one under the read lock. It stops once the list has been reset.
+func (l *LinkedList[E]) walk(nodes []ListNode[E], resets int, yield func(E) bool) {
+	for _, n := range nodes {
+		l.mu.RLock()
+		if l.resets != resets {
+			l.mu.RUnlock()
+			return
+		}
+		linked := l.linked(n)
+		value := *n.GetData()
+		l.mu.RUnlock()
+		if linked && !yield(value) {
+			return
+		}
+	}
 }
 
 func (l *LinkedList[E]) LastIndexOf(element E) int {
@@ -442,7 +672,7 @@ func (l *LinkedList[E]) RemoveAtIndex(index int) (*E, error) {
 	defer l.mu.Unlock()
 
 	if index >= l.size || index < 0 {
-		return nil, errors.New(string(errcodes.IndexOutOfBoundsError))
+		return nil, errcodes.NewIndexError("LinkedList", "RemoveAtIndex", index, l.size)
 	}
 
 	var val E
@@ -468,6 +698,7 @@ func (l *LinkedList[E]) RemoveAtIndex(index int) (*E, error) {
 		current.GetNext().SetPrev(current.GetPrev())
 	}
 	l.size--
+	l.modCount++
 	return &val, nil
 }
 
@@ -505,6 +736,7 @@ func (l *LinkedList[E]) Remove(element E) bool {
 				current.GetNext().SetPrev(current.GetPrev())
 			}
 			l.size--
+			l.modCount++
 			return true
 		}
 		current = current.GetNext()
@@ -553,6 +785,7 @@ func (l *LinkedList[E]) RemoveAllBatch(elements []E) bool {
 				next.SetPrev(current.GetPrev())
 			}
 			l.size--
+			l.modCount++
 			modified = true
 		}
 		current = next
@@ -561,7 +794,7 @@ func (l *LinkedList[E]) RemoveAllBatch(elements []E) bool {
 }
 
 func (l *LinkedList[E]) Set(index int, element E) (*E, error) {
-	if err := l.checkIndex(index); err != nil {
+	if err := l.checkIndex("Set", index); err != nil {
 		return nil, err
 	}
 
@@ -604,21 +837,17 @@ func (l *LinkedList[E]) ToArray() []E {
 	return values
 }
 
+// SubList returns a live view of the elements between fromIndex (inclusive) and toIndex (exclusive).
+// Changes made through the view are written to this list, and any other structural change
+// to this list invalidates the view with ConcurrentModificationError.
 func (l *LinkedList[E]) SubList(fromIndex int, toIndex int) (collections.List[E], error) {
-	if fromIndex < 0 || toIndex > l.size || fromIndex > toIndex {
-		return nil, errors.New(string(errcodes.IndexOutOfBoundsError))
-	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
 
-	values := make([]E, toIndex-fromIndex)
-	current := l.head
-	for i := 0; i < fromIndex; i++ {
-		current = current.GetNext()
-	}
-	for i := 0; i < toIndex-fromIndex; i++ {
-		values[i] = *current.GetData()
-		current = current.GetNext()
+	if fromIndex < 0 || toIndex > l.size || fromIndex > toIndex {
+		return nil, errcodes.NewRangeError("LinkedList", "SubList", fromIndex, toIndex, l.size)
 	}
-	return NewLinkedListWithInitialCollection(values), nil
+	return newSubList[E](l, fromIndex, toIndex), nil
 }
 
 func (l *LinkedList[E]) CopyOf(collection collections.Collection[E]) collections.List[E] {
@@ -717,6 +946,7 @@ func (l *LinkedList[E]) RemoveLastOccurrence(val E) bool {
 				current.GetNext().SetPrev(current.GetPrev())
 			}
 			l.size--
+			l.modCount++
 			return true
 		}
 		current = current.GetPrev()
@@ -724,21 +954,10 @@ func (l *LinkedList[E]) RemoveLastOccurrence(val E) bool {
 	return false
 }
 
+// Reversed returns a reverse-order view of this list. The view implements collections.List;
+// reads and writes through it are mapped onto this list, which is neither copied nor reordered.
 func (l *LinkedList[E]) Reversed() collections.Collection[E] {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	current := l.head
-	var prev ListNode[E] = nil
-	for current != nil {
-		next := current.GetNext()
-		current.SetNext(prev)
-		current.SetPrev(next)
-		prev = current
-		current = next
-	}
-	l.head, l.tail = l.tail, l.head
-	return l
+	return newReversedList[E](l)
 }
 
 func (l *LinkedList[E]) Sort(comparator collections.Comparator[E]) {
@@ -759,9 +978,7 @@ func (l *LinkedList[E]) Sort(comparator collections.Comparator[E]) {
 	})
 
 	// Rebuild the list - directly reset instead of calling Clear()
-	l.head = nil
-	l.tail = nil
-	l.size = 0
+	l.reset()
 
 	// Add sorted values
 	for _, val := range values {
@@ -778,9 +995,186 @@ func (l *LinkedList[E]) Sort(comparator collections.Comparator[E]) {
 	}
 }
 
-func (l *LinkedList[E]) checkIndex(index int) error {
+// node returns the node at index, walking from whichever end is closer.
+// The caller must hold the lock and ensure that index is in range.
+func (l *LinkedList[E]) node(index int) ListNode[E] {
+	if index < l.size/2 {
+		current := l.head
+		for i := 0; i < index; i++ {
+			current = current.GetNext()
+		}
+		return current
+	}
+	current := l.tail
+	for i := l.size - 1; i > index; i-- {
+		current = current.GetPrev()
+	}
+	return current
+}
+
+// linked reports whether n is still in the chain that starts at head. A removed node keeps
+// its links, but neither is head nor is pointed to by its predecessor; nodes dropped as a
+// whole chain by reset are not detected. The caller must hold the lock.
+func (l *LinkedList[E]) linked(n ListNode[E]) bool {
+	prev := n.GetPrev()
+	return n == l.head || prev != nil && prev.GetNext() == n
+}
+
+// reset drops every node. The caller must hold the write lock.
+func (l *LinkedList[E]) reset() {
+	l.head = nil
+	l.tail = nil
+	l.size = 0
+	l.modCount++
+	l.resets++
+}
+
+// unlink removes n from the list. The node keeps its own links so that a
+// walk positioned on it can still move on. The caller must hold the write lock.
+func (l *LinkedList[E]) unlink(n ListNode[E]) {
+	prev, next := n.GetPrev(), n.GetNext()
+	if prev == nil {
+		l.head = next
+	} else {
+		prev.SetNext(next)
+	}
+	if next == nil {
+		l.tail = prev
+	} else {
+		next.SetPrev(prev)
+	}
+	l.size--
+	l.modCount++
+}
+
+// linkBefore inserts element before succ, or at the end if succ is nil.
+// The caller must hold the write lock.
+func (l *LinkedList[E]) linkBefore(element E, succ ListNode[E]) {
+	newNode := NewListNodeImpl(element)
+	var prev ListNode[E]
+	if succ == nil {
+		prev = l.tail
+		l.tail = newNode
+	} else {
+		prev = succ.GetPrev()
+		succ.SetPrev(newNode)
+		newNode.SetNext(succ)
+	}
+	newNode.SetPrev(prev)
+	if prev == nil {
+		l.head = newNode
+	} else {
+		prev.SetNext(newNode)
+	}
+	l.size++
+	l.modCount++
+}
+
+// The methods below let a subList view operate on the list. Like node, they
+// assume the appropriate lock is already held and that indices are in range.
+
+func (l *LinkedList[E]) mutex() *locking.RWMutex { return &l.mu }
+
+func (l *LinkedList[E]) mods() int { return l.modCount }
+
+func (l *LinkedList[E]) getAt(index int) E { return *l.node(index).GetData() }
+
+func (l *LinkedList[E]) setAt(index int, element E) E {
+	n := l.node(index)
+	old := *n.GetData()
+	n.SetData(element)
+	return old
+}
+
+func (l *LinkedList[E]) insertAt(index int, element E) {
+	l.insertAllAt(index, []E{element})
+}
+
+func (l *LinkedList[E]) insertAllAt(index int, elements []E) {
+	var succ ListNode[E]
+	if index < l.size {
+		succ = l.node(index)
+	}
+	for _, element := range elements {
+		l.linkBefore(element, succ)
+	}
+}
+
+func (l *LinkedList[E]) removeAt(index int) E {
+	n := l.node(index)
+	l.unlink(n)
+	return *n.GetData()
+}
+
+func (l *LinkedList[E]) removeRange(fromIndex, toIndex int) {
+	if fromIndex == toIndex {
+		return
+	}
+	current := l.node(fromIndex)
+	for i := fromIndex; i < toIndex; i++ {
+		next := current.GetNext()
+		l.unlink(current)
+		current = next
+	}
+}
+
+func (l *LinkedList[E]) copyRange(fromIndex, toIndex int) []E {
+	result := make([]E, toIndex-fromIndex)
+	if len(result) == 0 {
+		return result
+	}
+	current := l.node(fromIndex)
+	for i := range result {
+		result[i] = *current.GetData()
+		current = current.GetNext()
+	}
+	return result
+}
+
+func (l *LinkedList[E]) setRange(fromIndex int, elements []E) {
+	if len(elements) == 0 {
+		return
+	}
+	current := l.node(fromIndex)
+	for _, element := range elements {
+		current.SetData(element)
+		current = current.GetNext()
+	}
+}
+
+func (l *LinkedList[E]) indexIn(element E, fromIndex, toIndex int) int {
+	if fromIndex == toIndex {
+		return -1
+	}
+	current := l.node(fromIndex)
+	for i := fromIndex; i < toIndex; i++ {
+		if *current.GetData() == element {
+			return i
+		}
+		current = current.GetNext()
+	}
+	return -1
+}
+
+func (l *LinkedList[E]) lastIndexIn(element E, fromIndex, toIndex int) int {
+	if fromIndex == toIndex {
+		return -1
+	}
+	current := l.node(toIndex - 1)
+	for i := toIndex - 1; i >= fromIndex; i-- {
+		if *current.GetData() == element {
+			return i
+		}
+		current = current.GetPrev()
+	}
+	return -1
+}
+
+func (l *LinkedList[E]) equality() elementEquality[E] { return comparableEquality[E]() }
+
+func (l *LinkedList[E]) checkIndex(op string, index int) error {
 	if index < 0 || index >= l.size {
-		return errors.New(string(errcodes.IndexOutOfBoundsError))
+		return errcodes.NewIndexError("LinkedList", op, index, l.size)
 	}
 	return nil
 }
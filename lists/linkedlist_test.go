@@ -2,8 +2,12 @@ package lists
 
 import (
 	"reflect"
+	"slices"
 	"sync"
 	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 )
 
 func TestLinkedList_Add(t *testing.T) {
@@ -41,6 +45,29 @@ func TestLinkedList_Add(t *testing.T) {
 	}
 }
 
+func TestNewUnsynchronizedLinkedList(t *testing.T) {
+	list := NewUnsynchronizedLinkedList[int]()
+	if !list.mu.Disabled() {
+		t.Error("NewUnsynchronizedLinkedList should disable locking")
+	}
+	if NewLinkedList[int]().mu.Disabled() {
+		t.Error("NewLinkedList should lock")
+	}
+
+	list.Add(1)
+	list.AddFirst(0)
+	list.AddLast(2)
+	if got := list.ToArray(); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("Expected [0 1 2], got %v", got)
+	}
+	if _, err := list.RemoveAtIndex(1); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{0, 2}) {
+		t.Errorf("Expected [0 2], got %v", got)
+	}
+}
+
 func TestLinkedList_Clear(t *testing.T) {
 	list := LinkedList[int]{}
 	list.Add(1)
@@ -1620,30 +1647,42 @@ func TestLinkedList_Reversed(t *testing.T) {
 	list.Add(2)
 	list.Add(3)
 
-	list.Reversed()
+	reversed := list.Reversed().(collections.List[int])
 
-	val, err := list.Get(0)
-	if err != nil {
-		t.Errorf("Expected no error, got %v", err)
+	if !reflect.DeepEqual(list.ToArray(), []int{1, 2, 3}) {
+		t.Errorf("Expected list to be unchanged, got %v", list.ToArray())
 	}
-	if *val != 3 {
-		t.Errorf("Expected element at index 0 to be 3, got %d", *val)
+	for i, want := range []int{3, 2, 1} {
+		val, err := reversed.Get(i)
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if *val != want {
+			t.Errorf("Expected element at index %d to be %d, got %d", i, want, *val)
+		}
 	}
 
-	val, err = list.Get(1)
-	if err != nil {
-		t.Errorf("Expected no error, got %v", err)
+	reversed.AddFirst(4)
+	reversed.AddLast(0)
+	if !reflect.DeepEqual(list.ToArray(), []int{0, 1, 2, 3, 4}) {
+		t.Errorf("Expected writes through the view to reach the list, got %v", list.ToArray())
 	}
-	if *val != 2 {
-		t.Errorf("Expected element at index 1 to be 2, got %d", *val)
+	if got := slices.Collect(reversed.All()); !reflect.DeepEqual(got, []int{4, 3, 2, 1, 0}) {
+		t.Errorf("Expected view to iterate in reverse, got %v", got)
 	}
 
-	val, err = list.Get(2)
-	if err != nil {
-		t.Errorf("Expected no error, got %v", err)
+	it, _ := reversed.ListIterator(0)
+	for it.HasNext() {
+		val, _ := it.Next()
+		if *val%2 == 1 {
+			it.Remove()
+		}
 	}
-	if *val != 1 {
-		t.Errorf("Expected element at index 2 to be 1, got %d", *val)
+	if !reflect.DeepEqual(list.ToArray(), []int{0, 2, 4}) {
+		t.Errorf("Expected odd elements removed through the view, got %v", list.ToArray())
+	}
+	if reversed.Reversed() != collections.Collection[int](&list) {
+		t.Errorf("Expected Reversed of the view to return the list")
 	}
 }
 func TestLinkedList_GetLast(t *testing.T) {
@@ -2069,3 +2108,312 @@ func TestLinkedList_RemoveAllBatch(t *testing.T) {
 		t.Errorf("Expected last element to be 4")
 	}
 }
+
+func TestLinkedList_All(t *testing.T) {
+	list := NewLinkedListWithInitialCollection([]int{1, 2, 3})
+	if got := slices.Collect(list.All()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+	if got := slices.Collect(list.Backward()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", got)
+	}
+	if got := slices.Collect(NewLinkedList[int]().All()); len(got) != 0 {
+		t.Errorf("Expected no elements from an empty list, got %v", got)
+	}
+
+	// Removing the current element from the loop body does not end the iteration
+	var seen []int
+	for v := range list.All() {
+		if v == 2 {
+			list.Remove(2)
+		}
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+		t.Errorf("Expected to see [1 2 3], got %v", seen)
+	}
+	if got := list.ToArray(); !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("Expected [1 3] after removal, got %v", got)
+	}
+
+	seen = nil
+	for v := range list.Backward() {
+		seen = append(seen, v)
+		break
+	}
+	if !reflect.DeepEqual(seen, []int{3}) {
+		t.Errorf("Expected break to stop after [3], got %v", seen)
+	}
+}
+
+func TestLinkedList_AllModifiedInLoop(t *testing.T) {
+	list := NewLinkedListWithInitialCollection([]int{1, 2, 3, 4})
+
+	// Elements removed before they are reached are skipped, new ones are not yielded
+	var seen []int
+	for v := range list.All() {
+		if v == 1 {
+			list.Remove(2)
+			list.RemoveLast()
+			list.Add(5)
+		}
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 3}) {
+		t.Errorf("Expected to see [1 3], got %v", seen)
+	}
+
+	seen = nil
+	for v := range list.Backward() {
+		if v == 5 {
+			list.RemoveAtIndex(1)
+		}
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []int{5, 1}) {
+		t.Errorf("Expected to see [5 1], got %v", seen)
+	}
+
+	// Nothing is yielded after the list is cleared or sorted
+	for name, reset := range map[string]func(){
+		"Clear": list.Clear,
+		"Sort":  func() { list.Sort(&IntComparator{}) },
+	} {
+		list.Clear()
+		list.AddAllBatch([]int{3, 2, 1})
+		seen = nil
+		for v := range list.All() {
+			reset()
+			list.Add(4)
+			seen = append(seen, v)
+		}
+		if !reflect.DeepEqual(seen, []int{3}) {
+			t.Errorf("%s: expected to see [3], got %v", name, seen)
+		}
+	}
+}
+
+func TestLinkedList_IteratorConcurrentModification(t *testing.T) {
+	list := NewLinkedListWithInitialCollection([]int{1, 2, 3})
+
+	iterators := map[string]collections.Iterator[int]{
+		"ascending":  list.Iterator(),
+		"descending": list.DescendingIterator(),
+	}
+	for name, iterator := range iterators {
+		if _, err := iterator.Next(); err != nil {
+			t.Errorf("%s: expected no error, got %v", name, err)
+		}
+	}
+
+	// Set is not a structural modification
+	if _, err := list.Set(1, 20); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	val, err := iterators["ascending"].Next()
+	if err != nil || *val != 20 {
+		t.Errorf("Expected 20 after Set, got %v, %v", val, err)
+	}
+
+	list.AddFirst(0)
+	for name, iterator := range iterators {
+		if iterator.HasNext() {
+			t.Errorf("%s: expected HasNext() to return false after modification", name)
+		}
+		val, err := iterator.Next()
+		if val != nil || err == nil || err.Error() != string(errcodes.ConcurrentModificationError) {
+			t.Errorf("%s: expected ConcurrentModificationError, got %v, %v", name, val, err)
+		}
+	}
+
+	// Failed removals do not invalidate a fresh iterator
+	iterator := list.Iterator()
+	list.Remove(99)
+	list.RemoveAllBatch([]int{99})
+	if val, err := iterator.Next(); err != nil || *val != 0 {
+		t.Errorf("Expected 0, got %v, %v", val, err)
+	}
+}
+
+func TestLinkedList_SnapshotIterator(t *testing.T) {
+	list := NewLinkedListWithInitialCollection([]int{1, 2, 3})
+
+	iterator := list.SnapshotIterator()
+	list.Clear()
+	list.Add(4)
+
+	var result []int
+	for iterator.HasNext() {
+		val, err := iterator.Next()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		result = append(result, *val)
+	}
+	if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", result)
+	}
+}
+
+func TestLinkedList_ListIterator(t *testing.T) {
+	list := NewLinkedListWithInitialCollection([]int{1, 2, 3, 4})
+
+	if _, err := list.ListIterator(5); err == nil || err.Error() != string(errcodes.IndexOutOfBoundsError) {
+		t.Errorf("Expected IndexOutOfBoundsError, got %v", err)
+	}
+
+	it, err := list.ListIterator(0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := it.Remove(); err == nil || err.Error() != string(errcodes.IllegalStateError) {
+		t.Errorf("Expected IllegalStateError before Next, got %v", err)
+	}
+
+	// Remove the even elements and double the odd ones
+	for it.HasNext() {
+		val, err := it.Next()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if *val%2 == 0 {
+			if err := it.Remove(); err != nil {
+				t.Errorf("Expected no error from Remove, got %v", err)
+			}
+		} else if err := it.Set(*val * 2); err != nil {
+			t.Errorf("Expected no error from Set, got %v", err)
+		}
+	}
+	if got := list.ToArray(); !reflect.DeepEqual(got, []int{2, 6}) {
+		t.Errorf("Expected [2 6], got %v", got)
+	}
+	if it.NextIndex() != 2 || it.PreviousIndex() != 1 {
+		t.Errorf("Expected indices 2 and 1, got %d and %d", it.NextIndex(), it.PreviousIndex())
+	}
+
+	// Walk backwards, inserting before each element
+	for it.HasPrevious() {
+		val, err := it.Previous()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if err := it.Add(*val - 1); err != nil {
+			t.Errorf("Expected no error from Add, got %v", err)
+		}
+		_, _ = it.Previous()
+	}
+	if got := list.ToArray(); !reflect.DeepEqual(got, []int{1, 2, 5, 6}) {
+		t.Errorf("Expected [1 2 5 6], got %v", got)
+	}
+	if got := slices.Collect(list.Backward()); !reflect.DeepEqual(got, []int{6, 5, 2, 1}) {
+		t.Errorf("Expected backward links [6 5 2 1], got %v", got)
+	}
+
+	// Remove after Previous removes the element at the cursor
+	it, _ = list.ListIterator(2)
+	val, _ := it.Previous()
+	if *val != 2 {
+		t.Errorf("Expected 2, got %d", *val)
+	}
+	if err := it.Remove(); err != nil {
+		t.Errorf("Expected no error from Remove, got %v", err)
+	}
+	val, _ = it.Next()
+	if *val != 5 || it.NextIndex() != 2 {
+		t.Errorf("Expected 5 at index 1, got %d with next index %d", *val, it.NextIndex())
+	}
+
+	// Add at the end appends and keeps the tail consistent
+	for it.HasNext() {
+		_, _ = it.Next()
+	}
+	_ = it.Add(7)
+	if got, _ := list.GetLast(); *got != 7 {
+		t.Errorf("Expected last element 7, got %d", *got)
+	}
+	if got := slices.Collect(list.Backward()); !reflect.DeepEqual(got, []int{7, 6, 5, 1}) {
+		t.Errorf("Expected [7 6 5 1], got %v", got)
+	}
+}
+
+func TestLinkedList_ListIteratorConcurrentModification(t *testing.T) {
+	list := NewLinkedListWithInitialCollection([]int{1, 2, 3})
+	it, _ := list.ListIterator(1)
+	if _, err := it.Next(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	list.RemoveFirst()
+	if it.HasNext() || it.HasPrevious() {
+		t.Errorf("Expected an invalidated iterator to report no more elements")
+	}
+	if _, err := it.Next(); err == nil || err.Error() != string(errcodes.ConcurrentModificationError) {
+		t.Errorf("Expected ConcurrentModificationError from Next, got %v", err)
+	}
+	if err := it.Remove(); err == nil || err.Error() != string(errcodes.ConcurrentModificationError) {
+		t.Errorf("Expected ConcurrentModificationError from Remove, got %v", err)
+	}
+	if err := it.Add(0); err == nil || err.Error() != string(errcodes.ConcurrentModificationError) {
+		t.Errorf("Expected ConcurrentModificationError from Add, got %v", err)
+	}
+}
+
+func TestLinkedList_SubListView(t *testing.T) {
+	list := NewLinkedListWithInitialCollection([]int{0, 1, 2, 3, 4, 5, 6, 7})
+
+	sub, err := list.SubList(2, 6)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := sub.Set(0, 20); err != nil {
+		t.Errorf("Expected no error from Set, got %v", err)
+	}
+	sub.AddLast(55)
+	sub.AddFirst(11)
+	if got := list.ToArray(); !reflect.DeepEqual(got, []int{0, 1, 11, 20, 3, 4, 5, 55, 6, 7}) {
+		t.Errorf("Expected writes to reach the parent, got %v", got)
+	}
+
+	// Clearing a view removes the range in place and keeps both link directions consistent
+	sub.Clear()
+	if got := list.ToArray(); !reflect.DeepEqual(got, []int{0, 1, 6, 7}) {
+		t.Errorf("Expected [0 1 6 7], got %v", got)
+	}
+	if got := slices.Collect(list.Backward()); !reflect.DeepEqual(got, []int{7, 6, 1, 0}) {
+		t.Errorf("Expected [7 6 1 0], got %v", got)
+	}
+
+	// Edits through the view's list iterator reach the parent
+	sub, _ = list.SubList(1, 3)
+	it, err := sub.ListIterator(0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for it.HasNext() {
+		val, _ := it.Next()
+		if err := it.Set(*val * 10); err != nil {
+			t.Errorf("Expected no error from Set, got %v", err)
+		}
+		if err := it.Add(-1); err != nil {
+			t.Errorf("Expected no error from Add, got %v", err)
+		}
+	}
+	if got := sub.ToArray(); !reflect.DeepEqual(got, []int{10, -1, 60, -1}) {
+		t.Errorf("Expected [10 -1 60 -1], got %v", got)
+	}
+	if got := list.ToArray(); !reflect.DeepEqual(got, []int{0, 10, -1, 60, -1, 7}) {
+		t.Errorf("Expected [0 10 -1 60 -1 7], got %v", got)
+	}
+	if sub.LastIndexOf(-1) != 3 || sub.IndexOf(-1) != 1 {
+		t.Errorf("Expected IndexOf 1 and LastIndexOf 3, got %d and %d", sub.IndexOf(-1), sub.LastIndexOf(-1))
+	}
+
+	// A change to the parent invalidates the view
+	list.Add(8)
+	if _, err := sub.Get(0); err == nil || err.Error() != string(errcodes.ConcurrentModificationError) {
+		t.Errorf("Expected ConcurrentModificationError, got %v", err)
+	}
+	if sub.Size() != 0 {
+		t.Errorf("Expected an invalidated view to report size 0, got %d", sub.Size())
+	}
+}
@@ -0,0 +1,310 @@
+package lists
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// reversedList is a reverse-order view of a list. Each operation is mapped onto the
+// underlying list, so nothing is copied and the underlying order is never changed.
+// Index-based operations read the underlying size first, so under concurrent structural
+// modification they may address a different element than intended.
+type reversedList[E any] struct {
+	list collections.List[E]
+}
+
+// newReversedList returns a reverse-order view of list.
+func newReversedList[E any](list collections.List[E]) *reversedList[E] {
+	return &reversedList[E]{list: list}
+}
+
+// reversedCopy returns the elements of the collection in reverse order.
+func reversedCopy[E any](collection collections.Collection[E]) []E {
+	values := collection.ToArray()
+	slices.Reverse(values)
+	return values
+}
+
+func (r *reversedList[E]) Add(element E) bool {
+	r.list.AddFirst(element)
+	return true
+}
+
+func (r *reversedList[E]) AddAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	values := reversedCopy(collection)
+	if len(values) == 0 {
+		return false
+	}
+	added, _ := r.list.AddAllAtIndex(0, &ArrayList[E]{values: values})
+	return added
+}
+
+func (r *reversedList[E]) AddAtIndex(index int, element E) error {
+	size := r.list.Size()
+	if index < 0 || index > size {
+		return errcodes.NewIndexError("ReversedList", "AddAtIndex", index, size)
+	}
+	return r.list.AddAtIndex(size-index, element)
+}
+
+func (r *reversedList[E]) AddAllAtIndex(index int, elements collections.Collection[E]) (bool, error) {
+	if elements == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "ReversedList", "AddAllAtIndex")
+	}
+	size := r.list.Size()
+	if index < 0 || index > size {
+		return false, errcodes.NewIndexError("ReversedList", "AddAllAtIndex", index, size)
+	}
+	values := reversedCopy(elements)
+	if len(values) == 0 {
+		return false, nil
+	}
+	return r.list.AddAllAtIndex(size-index, &ArrayList[E]{values: values})
+}
+
+func (r *reversedList[E]) AddFirst(element E) {
+	r.list.AddLast(element)
+}
+
+func (r *reversedList[E]) AddLast(element E) {
+	r.list.AddFirst(element)
+}
+
+func (r *reversedList[E]) Clear() {
+	r.list.Clear()
+}
+
+func (r *reversedList[E]) Contains(element E) bool {
+	return r.list.Contains(element)
+}
+
+func (r *reversedList[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
+	return r.list.ContainsAll(collection)
+}
+
+func (r *reversedList[E]) CopyOf(collection collections.Collection[E]) collections.List[E] {
+	return r.list.CopyOf(collection)
+}
+
+func (r *reversedList[E]) Equals(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	// Compare in the underlying order, so the underlying list's notion of equality applies
+	return r.list.Equals(&ArrayList[E]{values: reversedCopy(collection)})
+}
+
+func (r *reversedList[E]) Get(index int) (*E, error) {
+	size := r.list.Size()
+	if index < 0 || index >= size {
+		return nil, errcodes.NewIndexError("ReversedList", "Get", index, size)
+	}
+	return r.list.Get(size - 1 - index)
+}
+
+func (r *reversedList[E]) GetFirst() (*E, error) {
+	return r.list.GetLast()
+}
+
+func (r *reversedList[E]) GetLast() (*E, error) {
+	return r.list.GetFirst()
+}
+
+func (r *reversedList[E]) IndexOf(element E) int {
+	index := r.list.LastIndexOf(element)
+	if index < 0 {
+		return -1
+	}
+	return r.list.Size() - 1 - index
+}
+
+func (r *reversedList[E]) LastIndexOf(element E) int {
+	index := r.list.IndexOf(element)
+	if index < 0 {
+		return -1
+	}
+	return r.list.Size() - 1 - index
+}
+
+func (r *reversedList[E]) IsEmpty() bool {
+	return r.list.IsEmpty()
+}
+
+// Iterator returns an iterator from the last element of the underlying list to the first.
+// It fails fast in the same way as the underlying list's iterators.
+func (r *reversedList[E]) Iterator() collections.Iterator[E] {
+	it, err := r.ListIterator(0)
+	if err != nil {
+		return collections.NewSnapshotIterator[E](nil)
+	}
+	return it
+}
+
+// ListIterator returns a list iterator over this view starting at index, backed by a
+// list iterator of the underlying list that moves in the opposite direction.
+func (r *reversedList[E]) ListIterator(index int) (collections.ListIterator[E], error) {
+	size := r.list.Size()
+	if index < 0 || index > size {
+		return nil, errcodes.NewIndexError("ReversedList", "ListIterator", index, size)
+	}
+	inner, err := r.list.ListIterator(size - index)
+	if err != nil {
+		return nil, err
+	}
+	return &reversedListIterator[E]{list: r.list, inner: inner}, nil
+}
+
+func (r *reversedList[E]) All() iter.Seq[E] {
+	return r.list.Backward()
+}
+
+func (r *reversedList[E]) Backward() iter.Seq[E] {
+	return r.list.All()
+}
+
+// Remove removes the first occurrence of element in this view, which is the last one
+// in the underlying list.
+func (r *reversedList[E]) Remove(element E) bool {
+	index := r.list.LastIndexOf(element)
+	if index < 0 {
+		return false
+	}
+	_, err := r.list.RemoveAtIndex(index)
+	return err == nil
+}
+
+func (r *reversedList[E]) RemoveAll(collection collections.Collection[E]) bool {
+	return r.list.RemoveAll(collection)
+}
+
+func (r *reversedList[E]) RemoveAtIndex(index int) (*E, error) {
+	size := r.list.Size()
+	if index < 0 || index >= size {
+		return nil, errcodes.NewIndexError("ReversedList", "RemoveAtIndex", index, size)
+	}
+	return r.list.RemoveAtIndex(size - 1 - index)
+}
+
+func (r *reversedList[E]) RemoveFirst() (*E, error) {
+	return r.list.RemoveLast()
+}
+
+func (r *reversedList[E]) RemoveLast() (*E, error) {
+	return r.list.RemoveFirst()
+}
+
+// Reversed returns the underlying list.
+func (r *reversedList[E]) Reversed() collections.Collection[E] {
+	return r.list
+}
+
+func (r *reversedList[E]) Set(index int, element E) (*E, error) {
+	size := r.list.Size()
+	if index < 0 || index >= size {
+		return nil, errcodes.NewIndexError("ReversedList", "Set", index, size)
+	}
+	return r.list.Set(size-1-index, element)
+}
+
+func (r *reversedList[E]) Size() int {
+	return r.list.Size()
+}
+
+// Sort sorts the underlying list in the opposite order, so that this view is sorted by comparator.
+func (r *reversedList[E]) Sort(comparator collections.Comparator[E]) {
+	r.list.Sort(comparators.Reverse(comparator))
+}
+
+// SubList returns a reverse-order view of the matching range of the underlying list.
+func (r *reversedList[E]) SubList(fromIndex int, toIndex int) (collections.List[E], error) {
+	size := r.list.Size()
+	if fromIndex < 0 || toIndex > size {
+		return nil, errcodes.NewRangeError("ReversedList", "SubList", fromIndex, toIndex, size)
+	}
+	if fromIndex > toIndex {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "ReversedList", "SubList")
+	}
+	sub, err := r.list.SubList(size-toIndex, size-fromIndex)
+	if err != nil {
+		return nil, err
+	}
+	return newReversedList(sub), nil
+}
+
+func (r *reversedList[E]) ToArray() []E {
+	return reversedCopy[E](r.list)
+}
+
+// reversedListIterator adapts a list iterator of the underlying list to the reversed view.
+type reversedListIterator[E any] struct {
+	list  collections.List[E]
+	inner collections.ListIterator[E]
+	// canEdit is true while the element last returned by Next or Previous may be
+	// removed or replaced. Add moves the inner cursor, so it cannot rely on the
+	// inner iterator's own tracking.
+	canEdit bool
+}
+
+func (it *reversedListIterator[E]) HasNext() bool {
+	return it.inner.HasPrevious()
+}
+
+func (it *reversedListIterator[E]) Next() (*E, error) {
+	val, err := it.inner.Previous()
+	it.canEdit = err == nil
+	return val, err
+}
+
+func (it *reversedListIterator[E]) HasPrevious() bool {
+	return it.inner.HasNext()
+}
+
+func (it *reversedListIterator[E]) Previous() (*E, error) {
+	val, err := it.inner.Next()
+	it.canEdit = err == nil
+	return val, err
+}
+
+func (it *reversedListIterator[E]) NextIndex() int {
+	return it.list.Size() - it.inner.NextIndex()
+}
+
+func (it *reversedListIterator[E]) PreviousIndex() int {
+	return it.NextIndex() - 1
+}
+
+func (it *reversedListIterator[E]) Remove() error {
+	if !it.canEdit {
+		return errcodes.New(errcodes.IllegalStateError, "ReversedList", "ListIterator.Remove")
+	}
+	if err := it.inner.Remove(); err != nil {
+		return err
+	}
+	it.canEdit = false
+	return nil
+}
+
+func (it *reversedListIterator[E]) Set(element E) error {
+	if !it.canEdit {
+		return errcodes.New(errcodes.IllegalStateError, "ReversedList", "ListIterator.Set")
+	}
+	return it.inner.Set(element)
+}
+
+// Add inserts element so that it precedes the cursor in this view, which means
+// after the inner cursor. The inner iterator then steps back over it.
+func (it *reversedListIterator[E]) Add(element E) error {
+	if err := it.inner.Add(element); err != nil {
+		return err
+	}
+	it.canEdit = false
+	_, err := it.inner.Previous()
+	return err
+}
@@ -1,6 +1,7 @@
 package lists
 
 import (
+	"slices"
 	"testing"
 
 	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
@@ -830,3 +831,85 @@ func TestAddAllWithCollection(t *testing.T) {
 		}
 	}
 }
+
+func TestStack_All(t *testing.T) {
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	assert.Equal(t, stack.ToArray(), slices.Collect(stack.All()))
+}
+
+func TestStack_SnapshotIterator(t *testing.T) {
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+
+	iterator := stack.SnapshotIterator()
+	_, _ = stack.Pop()
+	stack.Push(3)
+
+	var result []int
+	for iterator.HasNext() {
+		val, err := iterator.Next()
+		assert.NoError(t, err)
+		result = append(result, *val)
+	}
+	assert.Equal(t, []int{1, 2}, result)
+}
+
+func TestStack_ConcurrentPushPop(t *testing.T) {
+	stack := NewStack[int]()
+	done := make(chan struct{})
+	for g := 0; g < 4; g++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for i := 0; i < 500; i++ {
+				stack.Push(i)
+				_, _ = stack.Peek()
+				_ = stack.Search(i)
+			}
+		}()
+	}
+	for g := 0; g < 4; g++ {
+		<-done
+	}
+	assert.Equal(t, 2000, stack.Size())
+
+	popped := 0
+	for g := 0; g < 4; g++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for i := 0; i < 500; i++ {
+				_, err := stack.Pop()
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	for g := 0; g < 4; g++ {
+		<-done
+		popped += 500
+	}
+	assert.Equal(t, 2000, popped)
+	assert.True(t, stack.IsEmpty())
+}
+
+func TestNewUnsynchronizedStack(t *testing.T) {
+	stack := NewUnsynchronizedStack[int]()
+	assert.True(t, stack.list.mu.Disabled())
+
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(1)
+	assert.Equal(t, 1, stack.Search(1))
+	assert.Equal(t, 2, stack.Search(2))
+	assert.True(t, stack.Remove(1))
+	assert.Equal(t, []int{1, 2}, stack.ToArray())
+
+	clone := stack.Clone()
+	assert.True(t, clone.list.mu.Disabled())
+	top, err := clone.Pop()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *top)
+	assert.Equal(t, 2, stack.Size())
+}
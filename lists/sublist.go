@@ -0,0 +1,652 @@
+package lists
+
+import (
+	"iter"
+	"sort"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/locking"
+)
+
+// listBackend is implemented by the lists that can back a subList view.
+// Apart from the List methods, all of its methods assume the backend's lock is held.
+type listBackend[E any] interface {
+	collections.List[E]
+	mutex() *locking.RWMutex
+	mods() int
+	getAt(index int) E
+	setAt(index int, element E) E
+	insertAt(index int, element E)
+	insertAllAt(index int, elements []E)
+	removeAt(index int) E
+	removeRange(fromIndex, toIndex int)
+	copyRange(fromIndex, toIndex int) []E
+	setRange(fromIndex int, elements []E)
+	indexIn(element E, fromIndex, toIndex int) int
+	lastIndexIn(element E, fromIndex, toIndex int) int
+	equality() elementEquality[E]
+}
+
+// listCursor is a list iterator of a backend that reports the modification
+// count it expects, so a view can follow changes made through it.
+type listCursor[E any] interface {
+	collections.ListIterator[E]
+	expectedMods() int
+}
+
+// subList is a live view of the range [offset, offset+size) of a backing list.
+// Reads and writes go through to the backing list under its lock. Structural
+// changes made through the view, or through a view derived from it, keep the view
+// valid. Any other structural change to the backing list invalidates it: methods
+// that return an error then report ConcurrentModificationError, and the others
+// treat the view as empty and leave the backing list untouched.
+type subList[E any] struct {
+	root             listBackend[E]
+	parent           *subList[E] // View this one was taken from, or nil
+	offset           int         // Position of the first element in root
+	size             int
+	expectedModCount int
+}
+
+// newSubList returns a view of root covering [fromIndex, toIndex).
+// The caller must hold root's lock and have validated the range.
+func newSubList[E any](root listBackend[E], fromIndex, toIndex int) *subList[E] {
+	return &subList[E]{
+		root:             root,
+		offset:           fromIndex,
+		size:             toIndex - fromIndex,
+		expectedModCount: root.mods(),
+	}
+}
+
+// checkForComodification reports whether the backing list was changed behind the view's back.
+// The caller must hold the lock.
+func (s *subList[E]) checkForComodification(op string) error {
+	if s.root.mods() != s.expectedModCount {
+		return errcodes.New(errcodes.ConcurrentModificationError, "SubList", op)
+	}
+	return nil
+}
+
+// updateSize records a structural change of delta elements made through this view,
+// propagating it to the views this one was derived from. The caller must hold the write lock.
+func (s *subList[E]) updateSize(delta, modCount int) {
+	for v := s; v != nil; v = v.parent {
+		v.size += delta
+		v.expectedModCount = modCount
+	}
+}
+
+func (s *subList[E]) end() int {
+	return s.offset + s.size
+}
+
+func (s *subList[E]) Add(element E) bool {
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if s.checkForComodification("Add") != nil {
+		return false
+	}
+	s.root.insertAt(s.end(), element)
+	s.updateSize(1, s.root.mods())
+	return true
+}
+
+func (s *subList[E]) AddAtIndex(index int, element E) error {
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := s.checkForComodification("AddAtIndex"); err != nil {
+		return err
+	}
+	if index < 0 || index > s.size {
+		return errcodes.NewIndexError("SubList", "AddAtIndex", index, s.size)
+	}
+	s.root.insertAt(s.offset+index, element)
+	s.updateSize(1, s.root.mods())
+	return nil
+}
+
+func (s *subList[E]) AddAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	values := collection.ToArray()
+	if len(values) == 0 {
+		return false
+	}
+
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if s.checkForComodification("AddAll") != nil {
+		return false
+	}
+	s.root.insertAllAt(s.end(), values)
+	s.updateSize(len(values), s.root.mods())
+	return true
+}
+
+func (s *subList[E]) AddAllAtIndex(index int, elements collections.Collection[E]) (bool, error) {
+	if elements == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "SubList", "AddAllAtIndex")
+	}
+	// Copy the elements before locking, since the collection may be this view or its backing list
+	values := elements.ToArray()
+
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := s.checkForComodification("AddAllAtIndex"); err != nil {
+		return false, err
+	}
+	if index < 0 || index > s.size {
+		return false, errcodes.NewIndexError("SubList", "AddAllAtIndex", index, s.size)
+	}
+	if len(values) == 0 {
+		return false, nil
+	}
+	s.root.insertAllAt(s.offset+index, values)
+	s.updateSize(len(values), s.root.mods())
+	return true, nil
+}
+
+func (s *subList[E]) AddFirst(element E) {
+	_ = s.AddAtIndex(0, element)
+}
+
+func (s *subList[E]) AddLast(element E) {
+	s.Add(element)
+}
+
+// Clear removes the elements in this view from the backing list.
+func (s *subList[E]) Clear() {
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if s.checkForComodification("Clear") != nil || s.size == 0 {
+		return
+	}
+	s.root.removeRange(s.offset, s.end())
+	s.updateSize(-s.size, s.root.mods())
+}
+
+func (s *subList[E]) Contains(element E) bool {
+	return s.IndexOf(element) >= 0
+}
+
+func (s *subList[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "SubList", "ContainsAll")
+	}
+	elements := collection.ToArray()
+
+	mu := s.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if err := s.checkForComodification("ContainsAll"); err != nil {
+		return false, err
+	}
+	for _, element := range elements {
+		if s.root.indexIn(element, s.offset, s.end()) < 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *subList[E]) CopyOf(collection collections.Collection[E]) collections.List[E] {
+	return s.root.CopyOf(collection)
+}
+
+func (s *subList[E]) Equals(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	values := s.ToArray()
+	if len(values) != len(elements) {
+		return false
+	}
+	equal := s.root.equality().equal
+	for i, val := range elements {
+		if !equal(values[i], val) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *subList[E]) Get(index int) (*E, error) {
+	mu := s.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if err := s.checkForComodification("Get"); err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= s.size {
+		return nil, errcodes.NewIndexError("SubList", "Get", index, s.size)
+	}
+	val := s.root.getAt(s.offset + index)
+	return &val, nil
+}
+
+func (s *subList[E]) GetFirst() (*E, error) {
+	mu := s.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if err := s.checkForComodification("GetFirst"); err != nil {
+		return nil, err
+	}
+	if s.size == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "SubList", "GetFirst")
+	}
+	val := s.root.getAt(s.offset)
+	return &val, nil
+}
+
+func (s *subList[E]) GetLast() (*E, error) {
+	mu := s.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if err := s.checkForComodification("GetLast"); err != nil {
+		return nil, err
+	}
+	if s.size == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "SubList", "GetLast")
+	}
+	val := s.root.getAt(s.end() - 1)
+	return &val, nil
+}
+
+func (s *subList[E]) IndexOf(element E) int {
+	mu := s.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if s.checkForComodification("IndexOf") != nil {
+		return -1
+	}
+	if index := s.root.indexIn(element, s.offset, s.end()); index >= 0 {
+		return index - s.offset
+	}
+	return -1
+}
+
+func (s *subList[E]) LastIndexOf(element E) int {
+	mu := s.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if s.checkForComodification("LastIndexOf") != nil {
+		return -1
+	}
+	if index := s.root.lastIndexIn(element, s.offset, s.end()); index >= 0 {
+		return index - s.offset
+	}
+	return -1
+}
+
+func (s *subList[E]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Iterator returns a fail-fast iterator over the elements in this view.
+func (s *subList[E]) Iterator() collections.Iterator[E] {
+	it, err := s.ListIterator(0)
+	if err != nil {
+		return &subListIterator[E]{view: s}
+	}
+	return it
+}
+
+// ListIterator returns a list iterator over the elements in this view, starting at index.
+// Edits made through the iterator go to the backing list and keep the view valid.
+func (s *subList[E]) ListIterator(index int) (collections.ListIterator[E], error) {
+	mu := s.root.mutex()
+	mu.RLock()
+	if err := s.checkForComodification("ListIterator"); err != nil {
+		mu.RUnlock()
+		return nil, err
+	}
+	if index < 0 || index > s.size {
+		mu.RUnlock()
+		return nil, errcodes.NewIndexError("SubList", "ListIterator", index, s.size)
+	}
+	mu.RUnlock()
+
+	// The backing iterator takes the lock itself. If the list changes in between,
+	// the mismatch in modification counts makes the first call fail.
+	inner, err := s.root.ListIterator(s.offset + index)
+	if err != nil {
+		return nil, err
+	}
+	return &subListIterator[E]{view: s, inner: inner.(listCursor[E])}, nil
+}
+
+// All returns a sequence over a snapshot of the elements in this view.
+// The snapshot is taken when iteration starts, so the loop body may modify the list.
+func (s *subList[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, val := range s.ToArray() {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over a snapshot of the elements in this view from last to first.
+func (s *subList[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		values := s.ToArray()
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(values[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *subList[E]) Remove(element E) bool {
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if s.checkForComodification("Remove") != nil {
+		return false
+	}
+	index := s.root.indexIn(element, s.offset, s.end())
+	if index < 0 {
+		return false
+	}
+	s.root.removeAt(index)
+	s.updateSize(-1, s.root.mods())
+	return true
+}
+
+func (s *subList[E]) RemoveAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	if len(elements) == 0 {
+		return false
+	}
+	toRemove := s.root.equality().lookup(elements)
+
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if s.checkForComodification("RemoveAll") != nil {
+		return false
+	}
+	values := s.root.copyRange(s.offset, s.end())
+	kept := values[:0]
+	for _, val := range values {
+		if !toRemove(val) {
+			kept = append(kept, val)
+		}
+	}
+	if len(kept) == len(values) {
+		return false
+	}
+	// Rewrite the range in one pass rather than removing elements one at a time
+	s.root.removeRange(s.offset+len(kept), s.end())
+	s.root.setRange(s.offset, kept)
+	s.updateSize(len(kept)-len(values), s.root.mods())
+	return true
+}
+
+func (s *subList[E]) RemoveAtIndex(index int) (*E, error) {
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := s.checkForComodification("RemoveAtIndex"); err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= s.size {
+		return nil, errcodes.NewIndexError("SubList", "RemoveAtIndex", index, s.size)
+	}
+	val := s.root.removeAt(s.offset + index)
+	s.updateSize(-1, s.root.mods())
+	return &val, nil
+}
+
+func (s *subList[E]) RemoveFirst() (*E, error) {
+	return s.removeEnd("RemoveFirst", false)
+}
+
+func (s *subList[E]) RemoveLast() (*E, error) {
+	return s.removeEnd("RemoveLast", true)
+}
+
+// removeEnd removes the first or last element of the view.
+func (s *subList[E]) removeEnd(op string, last bool) (*E, error) {
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := s.checkForComodification(op); err != nil {
+		return nil, err
+	}
+	if s.size == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "SubList", op)
+	}
+	index := s.offset
+	if last {
+		index = s.end() - 1
+	}
+	val := s.root.removeAt(index)
+	s.updateSize(-1, s.root.mods())
+	return &val, nil
+}
+
+// Reversed returns a reverse-order view of this view.
+func (s *subList[E]) Reversed() collections.Collection[E] {
+	return newReversedList[E](s)
+}
+
+func (s *subList[E]) Set(index int, element E) (*E, error) {
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := s.checkForComodification("Set"); err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= s.size {
+		return nil, errcodes.NewIndexError("SubList", "Set", index, s.size)
+	}
+	old := s.root.setAt(s.offset+index, element)
+	return &old, nil
+}
+
+func (s *subList[E]) Size() int {
+	mu := s.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if s.checkForComodification("Size") != nil {
+		return 0
+	}
+	return s.size
+}
+
+// Sort sorts the elements in this view in place within the backing list.
+func (s *subList[E]) Sort(comparator collections.Comparator[E]) {
+	mu := s.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if s.checkForComodification("Sort") != nil {
+		return
+	}
+	values := s.root.copyRange(s.offset, s.end())
+	sort.Slice(values, func(i, j int) bool {
+		return comparator.Compare(values[i], values[j]) < 0
+	})
+	s.root.setRange(s.offset, values)
+}
+
+// SubList returns a view of the portion of this view between fromIndex and toIndex.
+func (s *subList[E]) SubList(fromIndex int, toIndex int) (collections.List[E], error) {
+	mu := s.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if err := s.checkForComodification("SubList"); err != nil {
+		return nil, err
+	}
+	if fromIndex < 0 || toIndex > s.size {
+		return nil, errcodes.NewRangeError("SubList", "SubList", fromIndex, toIndex, s.size)
+	}
+	if fromIndex > toIndex {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "SubList", "SubList")
+	}
+	return &subList[E]{
+		root:             s.root,
+		parent:           s,
+		offset:           s.offset + fromIndex,
+		size:             toIndex - fromIndex,
+		expectedModCount: s.expectedModCount,
+	}, nil
+}
+
+func (s *subList[E]) ToArray() []E {
+	mu := s.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if s.checkForComodification("ToArray") != nil {
+		return []E{}
+	}
+	return s.root.copyRange(s.offset, s.end())
+}
+
+// subListIterator bounds a backing list iterator to the range of a subList view.
+// A nil inner iterator stands for a view that was already invalid when iteration began.
+type subListIterator[E any] struct {
+	view  *subList[E]
+	inner listCursor[E]
+}
+
+// check returns ConcurrentModificationError if the view no longer matches the backing list.
+func (it *subListIterator[E]) check(op string) error {
+	if it.inner == nil {
+		return errcodes.New(errcodes.ConcurrentModificationError, "SubList", op)
+	}
+	mu := it.view.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if it.inner.expectedMods() != it.view.expectedModCount {
+		return errcodes.New(errcodes.ConcurrentModificationError, "SubList", op)
+	}
+	return it.view.checkForComodification(op)
+}
+
+// bounds returns the positions of the view's first element and one past its last element in the backing list.
+func (it *subListIterator[E]) bounds() (int, int) {
+	mu := it.view.root.mutex()
+	mu.RLock()
+	defer mu.RUnlock()
+	return it.view.offset, it.view.end()
+}
+
+// track records a structural change of delta elements made through the backing iterator.
+func (it *subListIterator[E]) track(delta int) {
+	mu := it.view.root.mutex()
+	mu.Lock()
+	defer mu.Unlock()
+	it.view.updateSize(delta, it.inner.expectedMods())
+}
+
+func (it *subListIterator[E]) HasNext() bool {
+	if it.check("ListIterator.HasNext") != nil {
+		return false
+	}
+	_, end := it.bounds()
+	return it.inner.NextIndex() < end
+}
+
+func (it *subListIterator[E]) Next() (*E, error) {
+	if err := it.check("ListIterator.Next"); err != nil {
+		return nil, err
+	}
+	if _, end := it.bounds(); it.inner.NextIndex() >= end {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "SubList", "ListIterator.Next")
+	}
+	return it.inner.Next()
+}
+
+func (it *subListIterator[E]) HasPrevious() bool {
+	if it.check("ListIterator.HasPrevious") != nil {
+		return false
+	}
+	start, _ := it.bounds()
+	return it.inner.NextIndex() > start
+}
+
+func (it *subListIterator[E]) Previous() (*E, error) {
+	if err := it.check("ListIterator.Previous"); err != nil {
+		return nil, err
+	}
+	if start, _ := it.bounds(); it.inner.NextIndex() <= start {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "SubList", "ListIterator.Previous")
+	}
+	return it.inner.Previous()
+}
+
+func (it *subListIterator[E]) NextIndex() int {
+	if it.inner == nil {
+		return 0
+	}
+	return it.inner.NextIndex() - it.view.offset
+}
+
+func (it *subListIterator[E]) PreviousIndex() int {
+	return it.NextIndex() - 1
+}
+
+func (it *subListIterator[E]) Remove() error {
+	if err := it.check("ListIterator.Remove"); err != nil {
+		return err
+	}
+	if err := it.inner.Remove(); err != nil {
+		return err
+	}
+	it.track(-1)
+	return nil
+}
+
+func (it *subListIterator[E]) Set(element E) error {
+	if err := it.check("ListIterator.Set"); err != nil {
+		return err
+	}
+	return it.inner.Set(element)
+}
+
+func (it *subListIterator[E]) Add(element E) error {
+	if err := it.check("ListIterator.Add"); err != nil {
+		return err
+	}
+	if err := it.inner.Add(element); err != nil {
+		return err
+	}
+	it.track(1)
+	return nil
+}
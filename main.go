@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 
+	"github.com/chiranjeevipavurala/gocollections/comparators"
 	"github.com/chiranjeevipavurala/gocollections/lists"
 	"github.com/chiranjeevipavurala/gocollections/sets"
 )
@@ -53,7 +54,7 @@ func main() {
 	for _, val := range arrayList.ToArray() {
 		fmt.Println("From Array List", val)
 	}
-	arrayList.Sort(&StringComparator{})
+	arrayList.Sort(comparators.Natural[string]())
 
 	for _, val := range arrayList.ToArray() {
 		fmt.Println("From Array List", val)
@@ -82,15 +83,3 @@ func main() {
 	fmt.Println("Queue at top of queue", *val)
 
 }
-
-type StringComparator struct {
-}
-
-func (c *StringComparator) Compare(a, b string) int {
-	if a < b {
-		return -1
-	} else if a > b {
-		return 1
-	}
-	return 0
-}
@@ -0,0 +1,315 @@
+package maps
+
+import (
+	"io"
+	"iter"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/codec"
+)
+
+// Maps use the binary format of the internal codec package, in which each mapping is
+// gob-encoded as a key and value pair in iteration order. WriteTo streams the mappings
+// while holding the read lock, so writers wait until it returns. ReadFrom,
+// UnmarshalBinary and GobDecode replace the current contents; when a key appears more
+// than once, the last value wins.
+
+// WriteTo writes the map to w in the binary format and returns the number of bytes written.
+func (m *HashMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return writeEntries(w, len(m.entries), func(yield func(K, V) bool) {
+		for k, v := range m.entries {
+			if !yield(k, v) {
+				return
+			}
+		}
+	})
+}
+
+// ReadFrom replaces the contents of the map with a map read from r in the binary format
+// and returns the number of bytes read.
+func (m *HashMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	entries, n, err := codec.ReadSequence[codec.Entry[K, V]](r, codec.Mapping)
+	if err != nil {
+		return n, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = make(map[K]V, max(len(entries), DefaultCapacity))
+	for _, e := range entries {
+		m.entries[e.Key] = e.Value
+	}
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m *HashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(m)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *HashMap[K, V]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(m, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (m *HashMap[K, V]) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (m *HashMap[K, V]) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
+
+// WriteTo writes the map to w in the binary format and returns the number of bytes written.
+func (ht *HashTable[K, V]) WriteTo(w io.Writer) (int64, error) {
+	ht.mu.RLock()
+	defer ht.mu.RUnlock()
+	return writeEntries(w, len(ht.items), func(yield func(K, V) bool) {
+		for k, v := range ht.items {
+			if !yield(k, v) {
+				return
+			}
+		}
+	})
+}
+
+// ReadFrom replaces the contents of the map with a map read from r in the binary format
+// and returns the number of bytes read.
+func (ht *HashTable[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	entries, n, err := codec.ReadSequence[codec.Entry[K, V]](r, codec.Mapping)
+	if err != nil {
+		return n, err
+	}
+
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	ht.items = make(map[K]V, len(entries))
+	for _, e := range entries {
+		ht.items[e.Key] = e.Value
+	}
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (ht *HashTable[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(ht)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (ht *HashTable[K, V]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(ht, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (ht *HashTable[K, V]) GobEncode() ([]byte, error) {
+	return ht.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (ht *HashTable[K, V]) GobDecode(data []byte) error {
+	return ht.UnmarshalBinary(data)
+}
+
+// WriteTo writes the map to w in the binary format and returns the number of bytes written.
+// Unlike the other whole-map operations, it read-locks every segment for the duration of
+// the call, so the snapshot is consistent and writers to any segment wait until it returns.
+func (c *ConcurrentHashMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	count := 0
+	for _, seg := range c.segments {
+		seg.mu.RLock()
+		defer seg.mu.RUnlock()
+		count += len(seg.entries)
+	}
+	return writeEntries(w, count, func(yield func(K, V) bool) {
+		for _, seg := range c.segments {
+			for k, v := range seg.entries {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// ReadFrom replaces the contents of the map with a map read from r in the binary format
+// and returns the number of bytes read. The map must have been created with
+// NewConcurrentHashMap; reading into a zero ConcurrentHashMap returns NullPointerError.
+// The replacement clears and fills one segment at a time, so concurrent readers may see
+// a mix of old and new entries.
+func (c *ConcurrentHashMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	if c.segments == nil {
+		return 0, errcodes.New(errcodes.NullPointerError, "ConcurrentHashMap", "ReadFrom")
+	}
+	entries, n, err := codec.ReadSequence[codec.Entry[K, V]](r, codec.Mapping)
+	if err != nil {
+		return n, err
+	}
+
+	c.Clear()
+	for _, e := range entries {
+		c.Put(e.Key, e.Value)
+	}
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (c *ConcurrentHashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(c)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *ConcurrentHashMap[K, V]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(c, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (c *ConcurrentHashMap[K, V]) GobEncode() ([]byte, error) {
+	return c.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (c *ConcurrentHashMap[K, V]) GobDecode(data []byte) error {
+	return c.UnmarshalBinary(data)
+}
+
+// WriteTo writes the map to w in the binary format, in insertion order, and returns the
+// number of bytes written.
+func (lhm *LinkedHashMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	lhm.mu.RLock()
+	defer lhm.mu.RUnlock()
+	return writeEntries(w, len(lhm.items), func(yield func(K, V) bool) {
+		for current := lhm.head; current != nil; current = current.next {
+			if !yield(current.key, current.value) {
+				return
+			}
+		}
+	})
+}
+
+// ReadFrom replaces the contents of the map with a map read from r in the binary format,
+// keeping the order in which the mappings were written, and returns the number of bytes read.
+func (lhm *LinkedHashMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	entries, n, err := codec.ReadSequence[codec.Entry[K, V]](r, codec.Mapping)
+	if err != nil {
+		return n, err
+	}
+
+	lhm.mu.Lock()
+	defer lhm.mu.Unlock()
+	lhm.head = nil
+	lhm.tail = nil
+	lhm.items = make(map[K]*node[K, V], len(entries))
+	for _, e := range entries {
+		lhm.put(e.Key, e.Value)
+	}
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (lhm *LinkedHashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(lhm)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (lhm *LinkedHashMap[K, V]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(lhm, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (lhm *LinkedHashMap[K, V]) GobEncode() ([]byte, error) {
+	return lhm.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (lhm *LinkedHashMap[K, V]) GobDecode(data []byte) error {
+	return lhm.UnmarshalBinary(data)
+}
+
+// WriteTo writes the map to w in the binary format, in ascending key order, and returns
+// the number of bytes written.
+func (t *TreeMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return writeEntries(w, t.size, func(yield func(K, V) bool) {
+		for node := t.getFirstNode(nil); node != nil; node = successor(node) {
+			if !yield(node.key, node.value) {
+				return
+			}
+		}
+	})
+}
+
+// ReadFrom replaces the contents of the map with a map read from r in the binary format
+// and returns the number of bytes read. The map keeps its comparator, so it must have been
+// created with NewTreeMap; reading into a zero TreeMap returns NullPointerError. When the
+// keys are already in ascending order, such as those written by a TreeMap with the same
+// comparator, the tree is rebuilt in linear time instead of by one insertion per key.
+func (t *TreeMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	if t.comparator == nil {
+		return 0, errcodes.New(errcodes.NullPointerError, "TreeMap", "ReadFrom")
+	}
+	entries, n, err := codec.ReadSequence[codec.Entry[K, V]](r, codec.Mapping)
+	if err != nil {
+		return n, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sorted := true
+	for i := 1; i < len(entries) && sorted; i++ {
+		sorted = t.comparator.Compare(entries[i-1].Key, entries[i].Key) < 0
+	}
+	if sorted {
+		keys := make([]K, len(entries))
+		values := make([]V, len(entries))
+		for i, e := range entries {
+			keys[i] = e.Key
+			values[i] = e.Value
+		}
+		t.buildFromSorted(keys, values)
+		return n, nil
+	}
+
+	t.root = nil
+	t.size = 0
+	t.modCount++
+	for _, e := range entries {
+		t.put(e.Key, e.Value)
+	}
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t *TreeMap[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(t)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *TreeMap[K, V]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(t, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (t *TreeMap[K, V]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (t *TreeMap[K, V]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// writeEntries writes count mappings taken from seq as a map in the binary format.
+func writeEntries[K comparable, V any](w io.Writer, count int, seq iter.Seq2[K, V]) (int64, error) {
+	return codec.WriteSequence(w, codec.Mapping, count, func(yield func(codec.Entry[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(codec.Entry[K, V]{Key: k, Value: v}) {
+				return
+			}
+		}
+	})
+}
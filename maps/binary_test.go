@@ -0,0 +1,136 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/gob"
+	"slices"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashMap_Binary(t *testing.T) {
+	m := NewHashMap[string, int]().(*HashMap[string, int])
+	m.Put("a", 1)
+	m.Put("b", 2)
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded HashMap[string, int]
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.True(t, m.Equals(&decoded))
+
+	// A map cannot be decoded into a set or list and vice versa
+	assert.EqualError(t, decoded.UnmarshalBinary(entries(t, codec.Sequence)), string(errcodes.InvalidFormatError))
+	assert.EqualError(t, decoded.UnmarshalBinary(data[:len(data)-2]), string(errcodes.InvalidFormatError))
+	assert.Equal(t, 2, decoded.Size())
+}
+
+func TestLinkedHashMap_Binary(t *testing.T) {
+	m := NewLinkedHashMap[string, int]().(*LinkedHashMap[string, int])
+	m.Put("z", 26)
+	m.Put("a", 1)
+	m.Put("m", 13)
+
+	type snapshot struct {
+		Index *LinkedHashMap[string, int]
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(snapshot{Index: m}))
+	var decoded snapshot
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, []string{"z", "a", "m"}, slices.Collect(decoded.Index.Keys()))
+	assert.Equal(t, 13, *decoded.Index.Get("m"))
+}
+
+func TestTreeMap_Binary(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 4, 7, 8, 100, 5000} {
+		m := NewTreeMap[int, string](comparators.Natural[int]()).(*TreeMap[int, string])
+		keys := make([]int, n)
+		for i := range keys {
+			keys[i] = i * 2
+		}
+		for _, k := range slices.Backward(keys) {
+			m.Put(k, "v")
+		}
+		var buf bytes.Buffer
+		written, err := m.WriteTo(&buf)
+		assert.NoError(t, err)
+
+		decoded := NewTreeMap[int, string](comparators.Natural[int]()).(*TreeMap[int, string])
+		decoded.Put(-1, "old")
+		read, err := decoded.ReadFrom(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, written, read)
+		assert.Equal(t, n, decoded.Size())
+		assert.Equal(t, keys, slices.AppendSeq([]int{}, decoded.Keys()))
+		assert.True(t, decoded.verifyRedBlackProperties())
+
+		// The rebuilt tree keeps working as a red-black tree
+		decoded.Put(1, "odd")
+		decoded.Remove(0)
+		assert.True(t, decoded.verifyRedBlackProperties())
+		if n > 0 {
+			last, err := decoded.LastKey()
+			assert.NoError(t, err)
+			assert.Equal(t, max(keys[n-1], 1), last)
+		}
+	}
+}
+
+func TestTreeMap_BinaryUnsorted(t *testing.T) {
+	// Keys out of the comparator's order are inserted one at a time
+	m := NewTreeMap[string, int](comparators.Reverse(comparators.Natural[string]())).(*TreeMap[string, int])
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := NewTreeMap[string, int](comparators.Natural[string]()).(*TreeMap[string, int])
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(decoded.Keys()))
+	assert.True(t, decoded.verifyRedBlackProperties())
+
+	var zero TreeMap[string, int]
+	assert.EqualError(t, zero.UnmarshalBinary(data), string(errcodes.NullPointerError))
+}
+
+func TestHashTableAndConcurrentHashMap_Binary(t *testing.T) {
+	concurrent := NewConcurrentHashMap[int, int]()
+	for i := range 10000 {
+		concurrent.Put(i, i*i)
+	}
+	data, err := concurrent.MarshalBinary()
+	assert.NoError(t, err)
+
+	table := NewHashTable[int, int]()
+	table.Put(-1, 0)
+	assert.NoError(t, table.UnmarshalBinary(data))
+	assert.Equal(t, 10000, table.Size())
+	assert.Equal(t, 81, *table.Get(9))
+	assert.False(t, table.HasKey(-1))
+
+	data, err = table.GobEncode()
+	assert.NoError(t, err)
+	decoded := NewConcurrentHashMap[int, int]()
+	decoded.Put(-1, 0)
+	assert.NoError(t, decoded.GobDecode(data))
+	assert.Equal(t, 10000, decoded.Size())
+	assert.True(t, concurrent.Equals(decoded))
+
+	var zero ConcurrentHashMap[int, int]
+	assert.EqualError(t, zero.UnmarshalBinary(data), string(errcodes.NullPointerError))
+}
+
+// entries returns an encoding of the kind given with no elements.
+func entries(t *testing.T, kind codec.Kind) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	_, err := codec.WriteSequence(&buf, kind, 0, slices.Values([]codec.Entry[string, int]{}))
+	assert.NoError(t, err)
+	return buf.Bytes()
+}
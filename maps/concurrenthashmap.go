@@ -0,0 +1,605 @@
+package maps
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"iter"
+	"math"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/sets"
+)
+
+// DefaultConcurrencyLevel is the default number of segments in a ConcurrentHashMap
+const DefaultConcurrencyLevel = 16
+
+// segment is one independently locked shard of a ConcurrentHashMap
+type segment[K comparable, V any] struct {
+	mu      sync.RWMutex
+	entries map[K]V
+}
+
+// ConcurrentHashMap is a Map that splits its entries across independently locked segments.
+// Operations on keys that hash to different segments never contend with each other.
+// Operations that span the whole map, such as Size, Clear, iteration and the set views,
+// visit the segments one at a time and are weakly consistent: they never block writers for
+// longer than it takes to read one segment, and they may or may not reflect concurrent updates.
+type ConcurrentHashMap[K comparable, V any] struct {
+	segments []*segment[K, V]
+	mask     uint64
+	seed     maphash.Seed
+	seedBits uint64                 // seed for the integer fast path of hashOf
+	values   collections.Equaler[V] // Compares values; nil in the zero map
+	size     atomic.Int64
+}
+
+var _ collections.ConcurrentMap[string, int] = (*ConcurrentHashMap[string, int])(nil)
+
+// NewConcurrentHashMap creates a new ConcurrentHashMap with the default concurrency level
+func NewConcurrentHashMap[K comparable, V comparable]() *ConcurrentHashMap[K, V] {
+	return NewConcurrentHashMapWithConcurrencyLevel[K, V](DefaultConcurrencyLevel)
+}
+
+// NewConcurrentHashMapWithConcurrencyLevel creates a new ConcurrentHashMap with at least
+// the given number of segments. The level is rounded up to a power of two.
+func NewConcurrentHashMapWithConcurrencyLevel[K comparable, V comparable](concurrencyLevel int) *ConcurrentHashMap[K, V] {
+	return newConcurrentHashMap[K, V](concurrencyLevel, comparableValues[V]{})
+}
+
+// NewConcurrentHashMapWithValueEquality creates a new ConcurrentHashMap with the default
+// concurrency level whose values may be of any type. Values are compared with equaler, or,
+// if it is nil, with == where possible and reflect.DeepEqual otherwise.
+func NewConcurrentHashMapWithValueEquality[K comparable, V any](equaler collections.Equaler[V]) *ConcurrentHashMap[K, V] {
+	return newConcurrentHashMap[K, V](DefaultConcurrencyLevel, valueEqualer(equaler))
+}
+
+// newConcurrentHashMap creates a ConcurrentHashMap with at least concurrencyLevel segments
+// that compares values with equaler.
+func newConcurrentHashMap[K comparable, V any](concurrencyLevel int, equaler collections.Equaler[V]) *ConcurrentHashMap[K, V] {
+	if concurrencyLevel <= 0 {
+		concurrencyLevel = DefaultConcurrencyLevel
+	}
+	count := 1
+	for count < concurrencyLevel {
+		count <<= 1
+	}
+
+	segments := make([]*segment[K, V], count)
+	for i := range segments {
+		segments[i] = &segment[K, V]{entries: make(map[K]V)}
+	}
+	seed := maphash.MakeSeed()
+	return &ConcurrentHashMap[K, V]{
+		segments: segments,
+		mask:     uint64(count - 1),
+		seed:     seed,
+		seedBits: maphash.String(seed, ""),
+		values:   equaler,
+	}
+}
+
+// Clear removes all mappings from the map, one segment at a time
+func (c *ConcurrentHashMap[K, V]) Clear() {
+	for _, seg := range c.segments {
+		seg.mu.Lock()
+		c.size.Add(-int64(len(seg.entries)))
+		seg.entries = make(map[K]V)
+		seg.mu.Unlock()
+	}
+}
+
+// HasKey returns true if this map contains a mapping for the specified key
+func (c *ConcurrentHashMap[K, V]) HasKey(key K) bool {
+	seg := c.segmentFor(key)
+	seg.mu.RLock()
+	defer seg.mu.RUnlock()
+
+	_, ok := seg.entries[key]
+	return ok
+}
+
+// HasValue returns true if this map maps one or more keys to the specified value
+func (c *ConcurrentHashMap[K, V]) HasValue(value V) bool {
+	found := false
+	c.forEachSegment(func(key K, val V) bool {
+		found = equalValues(c.values, val, value)
+		return !found
+	})
+	return found
+}
+
+// EntrySet returns a weakly consistent snapshot of the mappings contained in this map
+func (c *ConcurrentHashMap[K, V]) EntrySet() collections.Set[collections.MapEntry[K, V]] {
+	set := sets.NewHashSet[collections.MapEntry[K, V]]()
+	c.forEachSegment(func(key K, value V) bool {
+		set.Add(collections.NewHashMapEntry(key, value))
+		return true
+	})
+	return set
+}
+
+// Equals returns true if the given map contains the same mappings as this map
+func (c *ConcurrentHashMap[K, V]) Equals(obj any) bool {
+	if obj == nil {
+		return false
+	}
+	mapObj, ok := obj.(collections.Map[K, V])
+	if !ok {
+		return false
+	}
+	if c.Size() != mapObj.Size() {
+		return false
+	}
+
+	equal := true
+	c.forEachSegment(func(key K, value V) bool {
+		other := mapObj.Get(key)
+		equal = other != nil && equalValues(c.values, *other, value)
+		return equal
+	})
+	return equal
+}
+
+// Get returns the value associated with the given key, or nil if there is none
+func (c *ConcurrentHashMap[K, V]) Get(key K) *V {
+	seg := c.segmentFor(key)
+	seg.mu.RLock()
+	defer seg.mu.RUnlock()
+
+	val, ok := seg.entries[key]
+	if !ok {
+		return nil
+	}
+	return &val
+}
+
+// IsEmpty returns true if the map contains no mappings
+func (c *ConcurrentHashMap[K, V]) IsEmpty() bool {
+	return c.Size() == 0
+}
+
+// KeySet returns a weakly consistent snapshot of the keys contained in this map
+func (c *ConcurrentHashMap[K, V]) KeySet() collections.Set[K] {
+	set := sets.NewHashSet[K]()
+	c.forEachSegment(func(key K, value V) bool {
+		set.Add(key)
+		return true
+	})
+	return set
+}
+
+// Put associates the specified value with the specified key and returns the previous value
+func (c *ConcurrentHashMap[K, V]) Put(key K, value V) V {
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	oldValue, ok := seg.entries[key]
+	if !ok {
+		c.size.Add(1)
+	}
+	seg.entries[key] = value
+	return oldValue
+}
+
+// PutAll copies all of the mappings from the specified map to this map
+func (c *ConcurrentHashMap[K, V]) PutAll(m collections.Map[K, V]) {
+	if m == nil {
+		return
+	}
+	for _, entry := range m.EntrySet().ToArray() {
+		c.Put(entry.GetKey(), entry.GetValue())
+	}
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not already associated with a value
+func (c *ConcurrentHashMap[K, V]) PutIfAbsent(key K, value V) V {
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	if oldValue, ok := seg.entries[key]; ok {
+		return oldValue
+	}
+	seg.entries[key] = value
+	c.size.Add(1)
+	var zero V
+	return zero
+}
+
+// Remove removes the mapping for a key from this map and returns the previous value
+func (c *ConcurrentHashMap[K, V]) Remove(key K) V {
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	oldValue, ok := seg.entries[key]
+	if ok {
+		delete(seg.entries, key)
+		c.size.Add(-1)
+	}
+	return oldValue
+}
+
+// RemoveKeyWithValue removes the entry for the specified key only if it is currently mapped to the specified value
+func (c *ConcurrentHashMap[K, V]) RemoveKeyWithValue(key K, value V) bool {
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	val, ok := seg.entries[key]
+	if !ok || !equalValues(c.values, val, value) {
+		return false
+	}
+	delete(seg.entries, key)
+	c.size.Add(-1)
+	return true
+}
+
+// Replace replaces the entry for the specified key only if it is currently mapped to some value
+func (c *ConcurrentHashMap[K, V]) Replace(key K, value V) V {
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	oldValue, ok := seg.entries[key]
+	if ok {
+		seg.entries[key] = value
+	}
+	return oldValue
+}
+
+// ReplaceKeyWithValue replaces the entry for the specified key only if currently mapped to the given old value
+func (c *ConcurrentHashMap[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bool {
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	val, ok := seg.entries[key]
+	if !ok || !equalValues(c.values, val, oldValue) {
+		return false
+	}
+	seg.entries[key] = newValue
+	return true
+}
+
+// Size returns the number of mappings in the map
+func (c *ConcurrentHashMap[K, V]) Size() int {
+	return int(c.size.Load())
+}
+
+// Values returns a weakly consistent snapshot of the values contained in this map.
+// Duplicate values are kept.
+func (c *ConcurrentHashMap[K, V]) Values() collections.Collection[V] {
+	var values []V
+	c.forEachSegment(func(key K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return valueList(values, c.values)
+}
+
+// GetOrDefault returns the value to which the specified key is mapped, or defaultValue if not mapped
+func (c *ConcurrentHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	seg := c.segmentFor(key)
+	seg.mu.RLock()
+	defer seg.mu.RUnlock()
+
+	value, ok := seg.entries[key]
+	if !ok {
+		return defaultValue
+	}
+	return value
+}
+
+// ForEachEntry performs the given action for each entry.
+// The action runs without holding any lock, so it may safely modify the map.
+func (c *ConcurrentHashMap[K, V]) ForEachEntry(action func(key K, value V)) {
+	if action == nil {
+		return
+	}
+	for it := c.Iterator(); it.HasNext(); {
+		entry, err := it.Next()
+		if err != nil {
+			return
+		}
+		action((*entry).GetKey(), (*entry).GetValue())
+	}
+}
+
+// ComputeIfAbsent computes a value if key is not already associated with a value.
+// The mapping function runs while the key's segment is locked, so it must not modify this map.
+func (c *ConcurrentHashMap[K, V]) ComputeIfAbsent(key K, mappingFunction func(K) V) (V, error) {
+	if mappingFunction == nil {
+		return *new(V), errcodes.New(errcodes.NullPointerError, "ConcurrentHashMap", "ComputeIfAbsent")
+	}
+
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	if value, ok := seg.entries[key]; ok {
+		return value, nil
+	}
+	value := mappingFunction(key)
+	seg.entries[key] = value
+	c.size.Add(1)
+	return value, nil
+}
+
+// Compute atomically computes a new mapping for the key from its current value.
+// The function receives nil if the key is absent. If it returns false, the mapping is
+// removed (or not created) and Compute returns nil; otherwise the returned value is stored
+// and a pointer to it is returned.
+// The function runs while the key's segment is locked, so it must not modify this map.
+func (c *ConcurrentHashMap[K, V]) Compute(key K, remappingFunction func(K, *V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "ConcurrentHashMap", "Compute")
+	}
+
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	var current *V
+	if value, ok := seg.entries[key]; ok {
+		current = &value
+	}
+	value, keep := remappingFunction(key, current)
+	return c.store(seg, key, current != nil, value, keep), nil
+}
+
+// ComputeIfPresent atomically computes a new mapping for the key if it is present.
+// If the function returns false, the mapping is removed and nil is returned.
+// It returns nil without calling the function if the key is absent.
+// The function runs while the key's segment is locked, so it must not modify this map.
+func (c *ConcurrentHashMap[K, V]) ComputeIfPresent(key K, remappingFunction func(K, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "ConcurrentHashMap", "ComputeIfPresent")
+	}
+
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	current, ok := seg.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	value, keep := remappingFunction(key, current)
+	return c.store(seg, key, true, value, keep), nil
+}
+
+// Merge atomically associates the key with value if it is absent, or otherwise with the
+// result of combining the current value and value. If the function returns false, the
+// mapping is removed and nil is returned.
+// The function runs while the key's segment is locked, so it must not modify this map.
+func (c *ConcurrentHashMap[K, V]) Merge(key K, value V, remappingFunction func(V, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "ConcurrentHashMap", "Merge")
+	}
+
+	seg := c.segmentFor(key)
+	seg.mu.Lock()
+	defer seg.mu.Unlock()
+
+	current, ok := seg.entries[key]
+	if !ok {
+		return c.store(seg, key, false, value, true), nil
+	}
+	merged, keep := remappingFunction(current, value)
+	return c.store(seg, key, true, merged, keep), nil
+}
+
+// Iterator returns a weakly consistent iterator over the entries of this map.
+// Each segment is copied when the iterator reaches it, so the iterator never holds a lock
+// between calls and never fails with ConcurrentModificationError. It reflects each segment
+// as it was when visited.
+func (c *ConcurrentHashMap[K, V]) Iterator() collections.Iterator[collections.MapEntry[K, V]] {
+	it := &concurrentHashMapIterator[K, V]{m: c}
+	it.advance()
+	return it
+}
+
+// All returns a weakly consistent sequence over the entries of this map.
+// Like Iterator, it copies one segment at a time and holds no lock while the loop body runs,
+// so the body may modify the map.
+func (c *ConcurrentHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, seg := range c.segments {
+			seg.mu.RLock()
+			pairs := snapshotPairs(seg.entries)
+			seg.mu.RUnlock()
+			for _, p := range pairs {
+				if !yield(p.key, p.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keys returns a weakly consistent sequence over the keys of this map.
+func (c *ConcurrentHashMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(c.All())
+}
+
+// AllValues returns a weakly consistent sequence over the values of this map.
+func (c *ConcurrentHashMap[K, V]) AllValues() iter.Seq[V] {
+	return valuesOf(c.All())
+}
+
+// store applies the result of a remapping function to the segment and returns a pointer to
+// the stored value, or nil if the mapping was removed.
+// It assumes the segment's write lock is already held.
+func (c *ConcurrentHashMap[K, V]) store(seg *segment[K, V], key K, present bool, value V, keep bool) *V {
+	if !keep {
+		if present {
+			delete(seg.entries, key)
+			c.size.Add(-1)
+		}
+		return nil
+	}
+	if !present {
+		c.size.Add(1)
+	}
+	seg.entries[key] = value
+	return &value
+}
+
+// forEachSegment calls fn for every mapping, holding the read lock of one segment at a time.
+// Iteration stops when fn returns false.
+func (c *ConcurrentHashMap[K, V]) forEachSegment(fn func(key K, value V) bool) {
+	for _, seg := range c.segments {
+		seg.mu.RLock()
+		for k, v := range seg.entries {
+			if !fn(k, v) {
+				seg.mu.RUnlock()
+				return
+			}
+		}
+		seg.mu.RUnlock()
+	}
+}
+
+// segmentFor returns the segment responsible for the key
+func (c *ConcurrentHashMap[K, V]) segmentFor(key K) *segment[K, V] {
+	return c.segments[hashOf(c.seed, c.seedBits, key)&c.mask]
+}
+
+// concurrentHashMapIterator iterates over a ConcurrentHashMap one segment at a time
+type concurrentHashMapIterator[K comparable, V any] struct {
+	m       *ConcurrentHashMap[K, V]
+	segment int
+	pending []collections.MapEntry[K, V]
+}
+
+// HasNext returns true if the iteration has more elements
+func (it *concurrentHashMapIterator[K, V]) HasNext() bool {
+	return len(it.pending) > 0
+}
+
+// Next returns the next entry in the iteration
+func (it *concurrentHashMapIterator[K, V]) Next() (*collections.MapEntry[K, V], error) {
+	if len(it.pending) == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ConcurrentHashMap", "Iterator.Next")
+	}
+	entry := it.pending[0]
+	it.pending = it.pending[1:]
+	if len(it.pending) == 0 {
+		it.advance()
+	}
+	return &entry, nil
+}
+
+// advance copies the next non-empty segment into pending
+func (it *concurrentHashMapIterator[K, V]) advance() {
+	for len(it.pending) == 0 && it.segment < len(it.m.segments) {
+		seg := it.m.segments[it.segment]
+		it.segment++
+
+		seg.mu.RLock()
+		entries := make([]collections.MapEntry[K, V], 0, len(seg.entries))
+		for k, v := range seg.entries {
+			entries = append(entries, collections.NewHashMapEntry(k, v))
+		}
+		seg.mu.RUnlock()
+		it.pending = entries
+	}
+}
+
+// hashOf hashes a comparable key so that keys that are == hash equally.
+// Common key types take a fast path; everything else is hashed by walking its value with reflection.
+func hashOf[K comparable](seed maphash.Seed, seedBits uint64, key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return maphash.String(seed, k)
+	case int:
+		return mixUint64(seedBits, uint64(k))
+	case int64:
+		return mixUint64(seedBits, uint64(k))
+	case int32:
+		return mixUint64(seedBits, uint64(k))
+	case uint:
+		return mixUint64(seedBits, uint64(k))
+	case uint64:
+		return mixUint64(seedBits, k)
+	case uint32:
+		return mixUint64(seedBits, uint64(k))
+	case float64:
+		return mixUint64(seedBits, floatBits(k))
+	}
+
+	var h maphash.Hash
+	h.SetSeed(seed)
+	// Take the address so that interface-typed keys keep their interface kind
+	writeHash(&h, reflect.ValueOf(&key).Elem())
+	return h.Sum64()
+}
+
+// mixUint64 scrambles x with the seed using the splitmix64 finalizer, so that
+// sequential integers spread evenly across segments
+func mixUint64(seedBits uint64, x uint64) uint64 {
+	x ^= seedBits
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// floatBits returns the bits of f with negative zero folded into positive zero, since the two compare equal
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+// writeHash feeds the value into h, consistently with ==
+func writeHash(h *maphash.Hash, v reflect.Value) {
+	var buf [8]byte
+	writeUint64 := func(x uint64) {
+		binary.LittleEndian.PutUint64(buf[:], x)
+		h.Write(buf[:])
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint64(1)
+		} else {
+			writeUint64(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint64(floatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeUint64(floatBits(real(c)))
+		writeUint64(floatBits(imag(c)))
+	case reflect.String:
+		h.WriteString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint64(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			writeUint64(0)
+			return
+		}
+		writeHash(h, v.Elem())
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeHash(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeHash(h, v.Field(i))
+		}
+	}
+}
@@ -0,0 +1,364 @@
+package maps
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConcurrentHashMap(t *testing.T) {
+	var _ collections.ConcurrentMap[string, int] = NewConcurrentHashMap[string, int]()
+
+	m := NewConcurrentHashMap[string, int]()
+	assert.Len(t, m.segments, DefaultConcurrencyLevel)
+	assert.True(t, m.IsEmpty())
+
+	tests := []struct {
+		level    int
+		segments int
+	}{
+		{0, DefaultConcurrencyLevel},
+		{-1, DefaultConcurrencyLevel},
+		{1, 1},
+		{5, 8},
+		{64, 64},
+	}
+	for _, tt := range tests {
+		m := NewConcurrentHashMapWithConcurrencyLevel[int, int](tt.level)
+		assert.Len(t, m.segments, tt.segments, "level %d", tt.level)
+	}
+}
+
+func TestConcurrentHashMap_BasicOperations(t *testing.T) {
+	m := NewConcurrentHashMap[string, int]()
+
+	assert.Equal(t, 0, m.Put("one", 1))
+	assert.Equal(t, 0, m.Put("two", 2))
+	assert.Equal(t, 1, m.Put("one", 11))
+	assert.Equal(t, 2, m.Size())
+
+	assert.Equal(t, 11, *m.Get("one"))
+	assert.Nil(t, m.Get("three"))
+	assert.True(t, m.HasKey("two"))
+	assert.False(t, m.HasKey("three"))
+	assert.True(t, m.HasValue(11))
+	assert.False(t, m.HasValue(1))
+	assert.Equal(t, 2, m.GetOrDefault("two", -1))
+	assert.Equal(t, -1, m.GetOrDefault("three", -1))
+
+	assert.Equal(t, 2, m.PutIfAbsent("two", 22))
+	assert.Equal(t, 0, m.PutIfAbsent("three", 3))
+	assert.Equal(t, 3, m.Size())
+
+	assert.Equal(t, 3, m.Replace("three", 33))
+	assert.Equal(t, 0, m.Replace("four", 4))
+	assert.False(t, m.HasKey("four"))
+	assert.False(t, m.ReplaceKeyWithValue("three", 3, 333))
+	assert.True(t, m.ReplaceKeyWithValue("three", 33, 333))
+
+	assert.False(t, m.RemoveKeyWithValue("three", 33))
+	assert.True(t, m.RemoveKeyWithValue("three", 333))
+	assert.Equal(t, 11, m.Remove("one"))
+	assert.Equal(t, 0, m.Remove("one"))
+	assert.Equal(t, 1, m.Size())
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Nil(t, m.Get("two"))
+}
+
+func TestConcurrentHashMap_Snapshots(t *testing.T) {
+	m := NewConcurrentHashMap[int, string]()
+	for i := 0; i < 100; i++ {
+		m.Put(i, fmt.Sprintf("v%d", i%10))
+	}
+
+	assert.Equal(t, 100, m.KeySet().Size())
+	assert.Equal(t, 100, m.EntrySet().Size())
+	assert.Equal(t, 100, m.Values().Size(), "Values should keep duplicates")
+	assert.True(t, m.Values().Contains("v9"))
+
+	other := NewHashMap[int, string]()
+	other.PutAll(m)
+	assert.True(t, m.Equals(other))
+	other.Put(0, "changed")
+	assert.False(t, m.Equals(other))
+	assert.False(t, m.Equals(nil))
+	assert.False(t, m.Equals("not a map"))
+
+	copied := NewConcurrentHashMap[int, string]()
+	copied.PutAll(m)
+	copied.PutAll(nil)
+	assert.True(t, copied.Equals(m))
+}
+
+func TestConcurrentHashMap_ComputeIfAbsent(t *testing.T) {
+	m := NewConcurrentHashMap[string, int]()
+
+	calls := 0
+	length := func(k string) int {
+		calls++
+		return len(k)
+	}
+	value, err := m.ComputeIfAbsent("four", length)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, value)
+	value, err = m.ComputeIfAbsent("four", length)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, value)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, m.Size())
+
+	_, err = m.ComputeIfAbsent("x", nil)
+	assert.EqualError(t, err, string(errcodes.NullPointerError))
+}
+
+func TestConcurrentHashMap_Compute(t *testing.T) {
+	m := NewConcurrentHashMap[string, int]()
+	increment := func(k string, v *int) (int, bool) {
+		if v == nil {
+			return 1, true
+		}
+		return *v + 1, true
+	}
+
+	value, err := m.Compute("a", increment)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *value)
+	value, err = m.Compute("a", increment)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *value)
+
+	// Returning false removes the mapping
+	value, err = m.Compute("a", func(k string, v *int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.False(t, m.HasKey("a"))
+	assert.Equal(t, 0, m.Size())
+
+	// Returning false for an absent key creates nothing
+	value, err = m.Compute("b", func(k string, v *int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.Equal(t, 0, m.Size())
+
+	_, err = m.Compute("a", nil)
+	assert.EqualError(t, err, string(errcodes.NullPointerError))
+}
+
+func TestConcurrentHashMap_ComputeIfPresent(t *testing.T) {
+	m := NewConcurrentHashMap[string, int]()
+	double := func(k string, v int) (int, bool) { return v * 2, true }
+
+	value, err := m.ComputeIfPresent("a", double)
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.False(t, m.HasKey("a"))
+
+	m.Put("a", 3)
+	value, err = m.ComputeIfPresent("a", double)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, *value)
+	assert.Equal(t, 6, *m.Get("a"))
+
+	value, err = m.ComputeIfPresent("a", func(k string, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.Equal(t, 0, m.Size())
+
+	_, err = m.ComputeIfPresent("a", nil)
+	assert.EqualError(t, err, string(errcodes.NullPointerError))
+}
+
+func TestConcurrentHashMap_Merge(t *testing.T) {
+	m := NewConcurrentHashMap[string, int]()
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	value, err := m.Merge("a", 5, sum)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, *value)
+	value, err = m.Merge("a", 7, sum)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, *value)
+
+	value, err = m.Merge("a", 1, func(old, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.False(t, m.HasKey("a"))
+
+	_, err = m.Merge("a", 1, nil)
+	assert.EqualError(t, err, string(errcodes.NullPointerError))
+}
+
+func TestConcurrentHashMap_Iterator(t *testing.T) {
+	m := NewConcurrentHashMap[int, int]()
+	it := m.Iterator()
+	assert.False(t, it.HasNext())
+	_, err := it.Next()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+
+	for i := 0; i < 50; i++ {
+		m.Put(i, i*i)
+	}
+	seen := make(map[int]int)
+	for it := m.Iterator(); it.HasNext(); {
+		entry, err := it.Next()
+		assert.NoError(t, err)
+		seen[(*entry).GetKey()] = (*entry).GetValue()
+		// Writers are never blocked or reported as concurrent modification
+		m.Put(1000+(*entry).GetKey(), 0)
+	}
+	for i := 0; i < 50; i++ {
+		assert.Equal(t, i*i, seen[i])
+	}
+
+	visited := 0
+	m.ForEachEntry(func(k, v int) {
+		visited++
+		m.Remove(k)
+	})
+	assert.GreaterOrEqual(t, visited, 50)
+	m.ForEachEntry(nil)
+}
+
+func TestConcurrentHashMap_KeyHashing(t *testing.T) {
+	type point struct {
+		x, y int
+		name string
+	}
+	points := NewConcurrentHashMap[point, int]()
+	points.Put(point{1, 2, "a"}, 1)
+	assert.Equal(t, 1, *points.Get(point{1, 2, "a"}))
+	assert.Nil(t, points.Get(point{2, 1, "a"}))
+
+	arrays := NewConcurrentHashMap[[2]string, int]()
+	arrays.Put([2]string{"a", "b"}, 1)
+	assert.True(t, arrays.HasKey([2]string{"a", "b"}))
+
+	floats := NewConcurrentHashMap[float64, string]()
+	floats.Put(0.0, "zero")
+	assert.Equal(t, "zero", *floats.Get(math.Copysign(0, -1)), "-0 and +0 should be the same key")
+
+	float32s := NewConcurrentHashMap[float32, string]()
+	float32s.Put(0, "zero")
+	assert.Equal(t, "zero", *float32s.Get(float32(math.Copysign(0, -1))))
+
+	ifaces := NewConcurrentHashMap[any, int]()
+	ifaces.Put(1, 1)
+	ifaces.Put("1", 2)
+	ifaces.Put(nil, 3)
+	ifaces.Put(point{1, 2, "a"}, 4)
+	assert.Equal(t, 1, *ifaces.Get(1))
+	assert.Equal(t, 2, *ifaces.Get("1"))
+	assert.Equal(t, 3, *ifaces.Get(nil))
+	assert.Equal(t, 4, *ifaces.Get(point{1, 2, "a"}))
+
+	a, b := new(int), new(int)
+	pointers := NewConcurrentHashMap[*int, string]()
+	pointers.Put(a, "a")
+	pointers.Put(b, "b")
+	assert.Equal(t, "a", *pointers.Get(a))
+	assert.Equal(t, "b", *pointers.Get(b))
+
+	type flags struct {
+		on    bool
+		level uint8
+		ratio complex64
+	}
+	structs := NewConcurrentHashMap[flags, int]()
+	structs.Put(flags{true, 3, complex(1, 0)}, 1)
+	assert.Equal(t, 1, *structs.Get(flags{true, 3, complex(1, float32(math.Copysign(0, -1)))}))
+}
+
+func TestConcurrentHashMap_Concurrent(t *testing.T) {
+	m := NewConcurrentHashMap[int, int]()
+	const workers = 8
+	const perWorker = 1000
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				key := w*perWorker + i
+				m.Put(key, i)
+				_, _ = m.Merge(-1, 1, func(old, v int) (int, bool) { return old + v, true })
+				_, _ = m.Compute(-2, func(k int, v *int) (int, bool) {
+					if v == nil {
+						return 1, true
+					}
+					return *v + 1, true
+				})
+				m.Get(key)
+			}
+		}(w)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			for it := m.Iterator(); it.HasNext(); {
+				_, _ = it.Next()
+			}
+			_ = m.Size()
+		}
+	}()
+	wg.Wait()
+
+	assert.Equal(t, workers*perWorker+2, m.Size())
+	assert.Equal(t, workers*perWorker, *m.Get(-1))
+	assert.Equal(t, workers*perWorker, *m.Get(-2))
+}
+
+func TestConcurrentHashMap_All(t *testing.T) {
+	m := NewConcurrentHashMapWithConcurrencyLevel[int, string](4)
+	expected := make(map[int]string)
+	for i := 0; i < 100; i++ {
+		m.Put(i, fmt.Sprint(i))
+		expected[i] = fmt.Sprint(i)
+	}
+
+	assert.Equal(t, expected, maps.Collect(m.All()))
+	assert.ElementsMatch(t, slices.Collect(maps.Keys(expected)), slices.Collect(m.Keys()))
+	assert.ElementsMatch(t, slices.Collect(maps.Values(expected)), slices.Collect(m.AllValues()))
+
+	// No lock is held while the loop body runs, so it may write to the map
+	for k := range m.Keys() {
+		m.Remove(k)
+	}
+	assert.True(t, m.IsEmpty())
+}
+
+func TestNewConcurrentHashMapWithValueEquality(t *testing.T) {
+	m := NewConcurrentHashMapWithValueEquality[string, []byte](nil)
+	m.Put("a", []byte("one"))
+	m.Put("b", []byte("two"))
+
+	assert.True(t, m.HasValue([]byte("one")))
+	assert.False(t, m.HasValue([]byte("three")))
+	assert.True(t, m.Values().Contains([]byte("two")))
+	assert.False(t, m.ReplaceKeyWithValue("a", []byte("two"), []byte("uno")))
+	assert.True(t, m.ReplaceKeyWithValue("a", []byte("one"), []byte("uno")))
+	assert.Equal(t, []byte("uno"), *m.Get("a"))
+
+	other := NewConcurrentHashMapWithValueEquality[string, []byte](nil)
+	other.Put("a", []byte("uno"))
+	other.Put("b", []byte("two"))
+	assert.True(t, m.Equals(other))
+	other.Put("b", []byte("dos"))
+	assert.False(t, m.Equals(other))
+
+	assert.False(t, m.RemoveKeyWithValue("b", []byte("dos")))
+	assert.True(t, m.RemoveKeyWithValue("b", []byte("two")))
+	assert.Equal(t, 1, m.Size())
+
+	var _ collections.Map[string, []byte] = m
+}
@@ -0,0 +1,420 @@
+package maps
+
+import (
+	"iter"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/hashing"
+	"github.com/chiranjeevipavurala/gocollections/internal/locking"
+	"github.com/chiranjeevipavurala/gocollections/sets"
+)
+
+// CustomHashMap is a hash map that hashes and compares its keys with a Hasher instead of
+// ==, so its keys need not be comparable. Iteration order is unspecified.
+type CustomHashMap[K any, V any] struct {
+	table  *hashing.Table[K, V]
+	values collections.Equaler[V] // Compares values; nil in the zero map
+	mu     locking.RWMutex
+}
+
+var _ collections.ConcurrentMap[string, int] = (*CustomHashMap[string, int])(nil)
+
+// NewHashMapWithHasher returns an empty map that hashes and compares keys with hasher.
+// Values are compared with ==. It returns nil if hasher is nil.
+func NewHashMapWithHasher[K any, V comparable](hasher collections.Hasher[K]) collections.Map[K, V] {
+	if hasher == nil {
+		return nil
+	}
+	return &CustomHashMap[K, V]{
+		table:  hashing.New[K, V](hasher, DefaultCapacity),
+		values: comparableValues[V]{},
+	}
+}
+
+// NewHashMapWithHasherAndValueEquality returns an empty map that hashes and compares keys
+// with hasher, and whose values may be of any type. Values are compared with equaler, or,
+// if it is nil, with == where possible and reflect.DeepEqual otherwise. It returns nil if
+// hasher is nil.
+func NewHashMapWithHasherAndValueEquality[K any, V any](hasher collections.Hasher[K], equaler collections.Equaler[V]) collections.Map[K, V] {
+	if hasher == nil {
+		return nil
+	}
+	return &CustomHashMap[K, V]{
+		table:  hashing.New[K, V](hasher, DefaultCapacity),
+		values: valueEqualer(equaler),
+	}
+}
+
+// customHashMapEntry is a key-value pair of a CustomHashMap, whose key may not be comparable.
+type customHashMapEntry[K any, V any] struct {
+	key   K
+	value V
+}
+
+func (e *customHashMapEntry[K, V]) GetKey() K { return e.key }
+
+func (e *customHashMapEntry[K, V]) GetValue() V { return e.value }
+
+// entryHasher hashes map entries by key, and compares them by key and value.
+type entryHasher[K any, V any] struct {
+	keys   collections.Hasher[K]
+	values collections.Equaler[V]
+}
+
+func (h entryHasher[K, V]) Hash(entry collections.MapEntry[K, V]) uint64 {
+	return h.keys.Hash(entry.GetKey())
+}
+
+func (h entryHasher[K, V]) Equal(a, b collections.MapEntry[K, V]) bool {
+	return h.keys.Equal(a.GetKey(), b.GetKey()) && equalValues(h.values, a.GetValue(), b.GetValue())
+}
+
+func (h *CustomHashMap[K, V]) Clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.table.Clear()
+}
+
+func (h *CustomHashMap[K, V]) HasKey(key K) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.table.Index(key) >= 0
+}
+
+func (h *CustomHashMap[K, V]) HasValue(value V) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for i := range h.table.Len() {
+		if equalValues(h.values, h.table.Value(i), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// EntrySet returns a snapshot of the entries. The set matches entries by key, using the
+// map's hasher, and by value.
+func (h *CustomHashMap[K, V]) EntrySet() collections.Set[collections.MapEntry[K, V]] {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	set := sets.NewHashSetWithHasher[collections.MapEntry[K, V]](entryHasher[K, V]{keys: h.table.Hasher(), values: h.values})
+	for i := range h.table.Len() {
+		set.Add(&customHashMapEntry[K, V]{key: h.table.Key(i), value: h.table.Value(i)})
+	}
+	return set
+}
+
+func (h *CustomHashMap[K, V]) Equals(obj any) bool {
+	if obj == nil {
+		return false
+	}
+	mapObj, ok := obj.(collections.Map[K, V])
+	if !ok {
+		return false
+	}
+
+	// Compare a snapshot, so that the lock is not held while calling the other map
+	pairs := h.snapshot()
+	if len(pairs) != mapObj.Size() {
+		return false
+	}
+	for _, p := range pairs {
+		value := mapObj.Get(p.key)
+		if value == nil || !equalValues(h.values, *value, p.value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (h *CustomHashMap[K, V]) Get(key K) *V {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	i := h.table.Index(key)
+	if i < 0 {
+		return nil
+	}
+	value := h.table.Value(i)
+	return &value
+}
+
+func (h *CustomHashMap[K, V]) IsEmpty() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.table.Len() == 0
+}
+
+// KeySet returns a snapshot of the keys, in a set that uses the map's hasher.
+func (h *CustomHashMap[K, V]) KeySet() collections.Set[K] {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	set := sets.NewHashSetWithHasher(h.table.Hasher())
+	for i := range h.table.Len() {
+		set.Add(h.table.Key(i))
+	}
+	return set
+}
+
+func (h *CustomHashMap[K, V]) Put(key K, value V) V {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	oldValue, _ := h.table.Put(key, value)
+	return oldValue
+}
+
+func (h *CustomHashMap[K, V]) PutAll(m collections.Map[K, V]) {
+	if m == nil {
+		return
+	}
+
+	// Get all entries first to minimize lock time
+	var pairs []pair[K, V]
+	for k, v := range m.All() {
+		pairs = append(pairs, pair[K, V]{key: k, value: v})
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, p := range pairs {
+		h.table.Put(p.key, p.value)
+	}
+}
+
+func (h *CustomHashMap[K, V]) PutIfAbsent(key K, value V) V {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if i := h.table.Index(key); i >= 0 {
+		return h.table.Value(i)
+	}
+	h.table.Put(key, value)
+	var zero V
+	return zero
+}
+
+func (h *CustomHashMap[K, V]) Remove(key K) V {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	oldValue, _ := h.table.Remove(key)
+	return oldValue
+}
+
+func (h *CustomHashMap[K, V]) RemoveKeyWithValue(key K, value V) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := h.table.Index(key)
+	if i < 0 || !equalValues(h.values, h.table.Value(i), value) {
+		return false
+	}
+	h.table.RemoveAt(i)
+	return true
+}
+
+func (h *CustomHashMap[K, V]) Replace(key K, value V) V {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := h.table.Index(key)
+	if i < 0 {
+		var zero V
+		return zero
+	}
+	return h.table.SetValue(i, value)
+}
+
+func (h *CustomHashMap[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := h.table.Index(key)
+	if i < 0 || !equalValues(h.values, h.table.Value(i), oldValue) {
+		return false
+	}
+	h.table.SetValue(i, newValue)
+	return true
+}
+
+// GetOrDefault returns the value to which the specified key is mapped, or defaultValue if this map contains no mapping for the key.
+func (h *CustomHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if i := h.table.Index(key); i >= 0 {
+		return h.table.Value(i)
+	}
+	return defaultValue
+}
+
+// ForEachEntry performs the given action for each entry in a snapshot of this map.
+// The action runs without holding the lock, so it may modify the map.
+func (h *CustomHashMap[K, V]) ForEachEntry(action func(key K, value V)) {
+	if action == nil {
+		return
+	}
+	for key, value := range h.All() {
+		action(key, value)
+	}
+}
+
+// ComputeIfAbsent computes a value for the specified key if the key is not already associated with a value.
+// The mapping function runs while the map is locked, so it must not use this map.
+func (h *CustomHashMap[K, V]) ComputeIfAbsent(key K, mappingFunction func(K) V) (V, error) {
+	if mappingFunction == nil {
+		var zero V
+		return zero, errcodes.New(errcodes.NullPointerError, "CustomHashMap", "ComputeIfAbsent")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if i := h.table.Index(key); i >= 0 {
+		return h.table.Value(i), nil
+	}
+	value := mappingFunction(key)
+	h.table.Put(key, value)
+	return value, nil
+}
+
+// Compute atomically computes a new mapping for the key from its current value.
+// The function receives nil if the key is absent. If it returns false, the mapping is
+// removed (or not created) and Compute returns nil.
+// The function runs while the map is locked, so it must not use this map.
+func (h *CustomHashMap[K, V]) Compute(key K, remappingFunction func(K, *V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "CustomHashMap", "Compute")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := h.table.Index(key)
+	var current *V
+	if i >= 0 {
+		value := h.table.Value(i)
+		current = &value
+	}
+	value, keep := remappingFunction(key, current)
+	return h.store(key, i, value, keep), nil
+}
+
+// ComputeIfPresent atomically computes a new mapping for the key if it is present.
+// If the function returns false, the mapping is removed and nil is returned.
+// The function runs while the map is locked, so it must not use this map.
+func (h *CustomHashMap[K, V]) ComputeIfPresent(key K, remappingFunction func(K, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "CustomHashMap", "ComputeIfPresent")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := h.table.Index(key)
+	if i < 0 {
+		return nil, nil
+	}
+	value, keep := remappingFunction(key, h.table.Value(i))
+	return h.store(key, i, value, keep), nil
+}
+
+// Merge atomically associates the key with value if it is absent, or otherwise with the
+// result of combining the current value and value. If the function returns false, the
+// mapping is removed and nil is returned.
+// The function runs while the map is locked, so it must not use this map.
+func (h *CustomHashMap[K, V]) Merge(key K, value V, remappingFunction func(V, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "CustomHashMap", "Merge")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := h.table.Index(key)
+	if i < 0 {
+		return h.store(key, i, value, true), nil
+	}
+	merged, keep := remappingFunction(h.table.Value(i), value)
+	return h.store(key, i, merged, keep), nil
+}
+
+// store applies the result of a remapping function to the entry of key at position i, or -1
+// if the key is absent, and returns a pointer to the stored value, or nil if the mapping was
+// removed. It assumes the write lock is already held.
+func (h *CustomHashMap[K, V]) store(key K, i int, value V, keep bool) *V {
+	switch {
+	case !keep:
+		if i >= 0 {
+			h.table.RemoveAt(i)
+		}
+		return nil
+	case i >= 0:
+		h.table.SetValue(i, value)
+	default:
+		h.table.Put(key, value)
+	}
+	return &value
+}
+
+func (h *CustomHashMap[K, V]) Size() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.table.Len()
+}
+
+// Values returns a snapshot of the values in a list, which, unlike a set, keeps duplicates.
+func (h *CustomHashMap[K, V]) Values() collections.Collection[V] {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return valueList(h.table.Values(), h.values)
+}
+
+// All returns a sequence over a snapshot of the entries in this map, in no particular order.
+// The snapshot is taken when iteration starts, so the loop body may modify the map.
+func (h *CustomHashMap[K, V]) All() iter.Seq2[K, V] {
+	return pairsSeq(h.snapshot)
+}
+
+// Keys returns a sequence over a snapshot of the keys in this map, in no particular order.
+func (h *CustomHashMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(h.All())
+}
+
+// AllValues returns a sequence over a snapshot of the values in this map, in no particular order.
+func (h *CustomHashMap[K, V]) AllValues() iter.Seq[V] {
+	return valuesOf(h.All())
+}
+
+func (h *CustomHashMap[K, V]) snapshot() []pair[K, V] {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	pairs := make([]pair[K, V], h.table.Len())
+	for i := range pairs {
+		pairs[i] = pair[K, V]{key: h.table.Key(i), value: h.table.Value(i)}
+	}
+	return pairs
+}
+
+// Clone returns a copy of the map that uses the same hasher.
+func (h *CustomHashMap[K, V]) Clone() collections.Map[K, V] {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return &CustomHashMap[K, V]{
+		table:  h.table.Clone(),
+		values: h.values,
+	}
+}
@@ -0,0 +1,227 @@
+package maps
+
+import (
+	"bytes"
+	"hash/maphash"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	"github.com/stretchr/testify/assert"
+)
+
+var seed = maphash.MakeSeed()
+
+// caseInsensitive treats strings that differ only in case as equal.
+var caseInsensitive = collections.NewHasher(
+	func(s string) uint64 { return maphash.String(seed, strings.ToLower(s)) },
+	strings.EqualFold,
+)
+
+func TestNewHashMapWithHasher(t *testing.T) {
+	m := NewHashMapWithHasher[string, int](caseInsensitive)
+	assert.True(t, m.IsEmpty())
+
+	assert.Equal(t, 0, m.Put("Go", 1))
+	assert.Equal(t, 1, m.Put("GO", 2))
+	assert.Equal(t, 1, m.Size())
+	assert.Equal(t, 2, *m.Get("go"))
+	assert.Nil(t, m.Get("rust"))
+	assert.True(t, m.HasKey("gO"))
+	assert.True(t, m.HasValue(2))
+	assert.False(t, m.HasValue(1))
+	assert.Equal(t, []string{"Go"}, slices.Collect(m.Keys()))
+
+	assert.Equal(t, 2, m.PutIfAbsent("go", 3))
+	assert.Equal(t, 0, m.PutIfAbsent("Rust", 3))
+	assert.Equal(t, 3, m.Replace("RUST", 4))
+	assert.Equal(t, 0, m.Replace("zig", 4))
+	assert.False(t, m.HasKey("zig"))
+	assert.False(t, m.ReplaceKeyWithValue("rust", 3, 5))
+	assert.True(t, m.ReplaceKeyWithValue("rust", 4, 5))
+	assert.False(t, m.RemoveKeyWithValue("rust", 4))
+	assert.True(t, m.RemoveKeyWithValue("rust", 5))
+	assert.Equal(t, 2, m.Remove("GO"))
+	assert.Equal(t, 0, m.Remove("GO"))
+	assert.True(t, m.IsEmpty())
+
+	assert.Nil(t, NewHashMapWithHasher[string, int](nil), "map should be nil when hasher is nil")
+	assert.Nil(t, NewHashMapWithHasherAndValueEquality[string, int](nil, nil), "map should be nil when hasher is nil")
+}
+
+func TestCustomHashMap_NonComparableKeys(t *testing.T) {
+	hasher := collections.NewHasher(
+		func(b []byte) uint64 { return maphash.Bytes(seed, b) },
+		bytes.Equal,
+	)
+	m := NewHashMapWithHasher[[]byte, string](hasher)
+	m.Put([]byte("k1"), "v1")
+	m.Put([]byte("k2"), "v2")
+	m.Put([]byte("k1"), "v3")
+
+	assert.Equal(t, 2, m.Size())
+	assert.Equal(t, "v3", *m.Get([]byte("k1")))
+	assert.True(t, m.KeySet().Contains([]byte("k2")))
+	assert.ElementsMatch(t, []string{"v3", "v2"}, slices.Collect(m.AllValues()))
+
+	entries := m.EntrySet()
+	assert.Equal(t, 2, entries.Size())
+	assert.True(t, entries.Contains(&customHashMapEntry[[]byte, string]{key: []byte("k2"), value: "v2"}))
+	assert.False(t, entries.Contains(&customHashMapEntry[[]byte, string]{key: []byte("k2"), value: "v1"}))
+}
+
+func TestCustomHashMap_ViewsAndEquality(t *testing.T) {
+	m := NewHashMapWithHasher[string, int](caseInsensitive)
+	m.Put("a", 1)
+	m.Put("b", 1)
+
+	assert.ElementsMatch(t, []int{1, 1}, m.Values().ToArray())
+	keys := m.KeySet()
+	assert.True(t, keys.Contains("A"))
+	keys.Add("c")
+	assert.False(t, m.HasKey("c"))
+
+	other := NewHashMap[string, int]()
+	other.Put("a", 1)
+	other.Put("b", 1)
+	assert.True(t, m.Equals(other))
+	other.Put("b", 2)
+	assert.False(t, m.Equals(other))
+	assert.False(t, m.Equals(nil))
+	assert.False(t, m.Equals("map"))
+
+	m.PutAll(other)
+	assert.Equal(t, 2, *m.Get("B"))
+	m.PutAll(m)
+	assert.Equal(t, 2, m.Size())
+
+	clone := m.(*CustomHashMap[string, int]).Clone()
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, 2, clone.Size())
+	assert.Equal(t, 1, *clone.Get("A"))
+}
+
+func TestCustomHashMap_Concurrent(t *testing.T) {
+	m := NewHashMapWithHasher[string, int](caseInsensitive)
+	var wg sync.WaitGroup
+	for g := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				m.Put(string(rune('a'+i%26)), g)
+				_ = m.Get(string(rune('A' + i%26)))
+				for range m.All() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 26, m.Size())
+}
+
+func TestCustomHashMap_ComputeAndMerge(t *testing.T) {
+	m := NewHashMapWithHasher[string, int](caseInsensitive)
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	value, err := m.Merge("Go", 1, sum)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *value)
+	value, err = m.Merge("GO", 2, sum)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *value)
+	value, err = m.Compute("go", func(k string, v *int) (int, bool) { return *v * 10, true })
+	assert.NoError(t, err)
+	assert.Equal(t, 30, *value)
+	value, err = m.Compute("Rust", func(k string, v *int) (int, bool) {
+		assert.Nil(t, v)
+		return 7, true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, *value)
+	assert.Equal(t, 2, m.Size())
+
+	value, err = m.ComputeIfPresent("RUST", func(k string, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = m.ComputeIfPresent("rust", func(k string, v int) (int, bool) { return 1, true })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = m.Merge("gO", 0, func(old, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.True(t, m.IsEmpty())
+
+	_, err = m.Compute("a", nil)
+	assert.Error(t, err)
+	_, err = m.ComputeIfPresent("a", nil)
+	assert.Error(t, err)
+	_, err = m.Merge("a", 1, nil)
+	assert.Error(t, err)
+}
+
+func TestNewHashMapWithHasherAndValueEquality(t *testing.T) {
+	m := NewHashMapWithHasherAndValueEquality[string, []byte](caseInsensitive, collections.NewHasher(
+		func(b []byte) uint64 { return maphash.Bytes(seed, b) },
+		bytes.Equal,
+	))
+	m.Put("Key", []byte("value"))
+	m.Put("other", []byte("value"))
+
+	assert.True(t, m.HasValue([]byte("value")))
+	assert.False(t, m.HasValue([]byte("VALUE")))
+	assert.True(t, m.Values().Contains([]byte("value")))
+	assert.True(t, m.ReplaceKeyWithValue("KEY", []byte("value"), []byte("new")))
+	assert.False(t, m.RemoveKeyWithValue("key", []byte("value")))
+	assert.True(t, m.EntrySet().Contains(&customHashMapEntry[string, []byte]{key: "KEY", value: []byte("new")}))
+
+	other := NewHashMapWithHasherAndValueEquality[string, []byte](caseInsensitive, nil)
+	other.Put("KEY", []byte("new"))
+	other.Put("OTHER", []byte("value"))
+	assert.True(t, m.Equals(other))
+	other.Put("OTHER", []byte("changed"))
+	assert.False(t, m.Equals(other))
+
+	assert.True(t, m.RemoveKeyWithValue("key", []byte("new")))
+	assert.Equal(t, 1, m.Size())
+}
+
+func TestCustomHashMap_CloneKeepsValueEquality(t *testing.T) {
+	m := NewHashMapWithHasherAndValueEquality[string, string](caseInsensitive, caseInsensitive)
+	m.Put("key", "value")
+
+	clone := m.(*CustomHashMap[string, string]).Clone()
+	assert.True(t, clone.HasValue("VALUE"))
+	assert.True(t, clone.RemoveKeyWithValue("KEY", "Value"))
+	assert.True(t, m.HasValue("VALUE"))
+}
+
+func TestCustomHashMap_ConcurrentMapMethods(t *testing.T) {
+	m := NewHashMapWithHasher[string, int](caseInsensitive).(*CustomHashMap[string, int])
+	m.Put("Two", 2)
+
+	assert.Equal(t, 2, m.GetOrDefault("TWO", -1))
+	assert.Equal(t, -1, m.GetOrDefault("one", -1))
+
+	value, err := m.ComputeIfAbsent("Four", func(k string) int { return len(k) })
+	assert.NoError(t, err)
+	assert.Equal(t, 4, value)
+	value, err = m.ComputeIfAbsent("FOUR", func(k string) int { return 0 })
+	assert.NoError(t, err)
+	assert.Equal(t, 4, value)
+	_, err = m.ComputeIfAbsent("x", nil)
+	assert.Error(t, err)
+	assert.False(t, m.HasKey("x"))
+
+	seen := map[string]int{}
+	m.ForEachEntry(func(k string, v int) {
+		seen[k] = v
+		m.Remove(k)
+	})
+	assert.Equal(t, map[string]int{"Two": 2, "Four": 4}, seen)
+	assert.True(t, m.IsEmpty())
+	m.ForEachEntry(nil)
+}
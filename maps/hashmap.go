@@ -1,11 +1,11 @@
 package maps
 
 import (
-	"errors"
-	"sync"
+	"iter"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
 	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/locking"
 	"github.com/chiranjeevipavurala/gocollections/sets"
 )
 
@@ -15,26 +15,42 @@ const DefaultCapacity = 16
 // LoadFactor is the factor at which the map will be resized
 const LoadFactor = 0.75
 
-type HashMap[K comparable, V comparable] struct {
+type HashMap[K comparable, V any] struct {
 	entries map[K]V
-	mu      sync.RWMutex
+	values  collections.Equaler[V] // Compares values; nil in the zero map
+	mu      locking.RWMutex
 }
 
-func NewHashMap[K comparable, V comparable]() collections.Map[K, V] {
-	return &HashMap[K, V]{
-		entries: make(map[K]V, DefaultCapacity),
-		mu:      sync.RWMutex{},
-	}
+var _ collections.ConcurrentMap[string, int] = (*HashMap[string, int])(nil)
+
+// NewHashMap returns an empty HashMap configured by options.
+func NewHashMap[K comparable, V comparable](options ...Option) collections.Map[K, V] {
+	return newHashMap[K, V](comparableValues[V]{}, options)
+}
+
+// NewHashMapWithValueEquality returns an empty HashMap whose values may be of any type,
+// such as slices or functions. HasValue, RemoveKeyWithValue, ReplaceKeyWithValue and Equals
+// compare values with equaler; if it is nil, values that == cannot compare are compared
+// with reflect.DeepEqual.
+func NewHashMapWithValueEquality[K comparable, V any](equaler collections.Equaler[V], options ...Option) collections.Map[K, V] {
+	return newHashMap[K, V](valueEqualer(equaler), options)
 }
 
+// NewHashMapWithCapacity is equivalent to NewHashMap(WithCapacity(capacity)).
 func NewHashMapWithCapacity[K comparable, V comparable](capacity int) collections.Map[K, V] {
-	if capacity <= 0 {
-		capacity = DefaultCapacity
+	return NewHashMap[K, V](WithCapacity(capacity))
+}
+
+func newHashMap[K comparable, V any](values collections.Equaler[V], options []Option) *HashMap[K, V] {
+	c := newConfig(options)
+	m := &HashMap[K, V]{
+		entries: make(map[K]V, c.capacity),
+		values:  values,
 	}
-	return &HashMap[K, V]{
-		entries: make(map[K]V, capacity),
-		mu:      sync.RWMutex{},
+	if c.unsynchronized {
+		m.mu.Disable()
 	}
+	return m
 }
 
 func (h *HashMap[K, V]) Clear() {
@@ -57,7 +73,7 @@ func (h *HashMap[K, V]) ContainsValue(value V) bool {
 	defer h.mu.RUnlock()
 
 	for _, val := range h.entries {
-		if val == value {
+		if equalValues(h.values, val, value) {
 			return true
 		}
 	}
@@ -103,7 +119,7 @@ func (h *HashMap[K, V]) Equals(obj any) bool {
 	defer h.mu.RLock()
 
 	for k, v := range entries {
-		if !mapObj.HasKey(k) || *mapObj.Get(k) != v {
+		if !mapObj.HasKey(k) || !equalValues(h.values, *mapObj.Get(k), v) {
 			return false
 		}
 	}
@@ -178,7 +194,7 @@ func (h *HashMap[K, V]) RemoveKeyWithValue(key K, value V) bool {
 	defer h.mu.Unlock()
 
 	val, ok := h.entries[key]
-	if !ok || val != value {
+	if !ok || !equalValues(h.values, val, value) {
 		return false
 	}
 	delete(h.entries, key)
@@ -201,7 +217,7 @@ func (h *HashMap[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bool
 	defer h.mu.Unlock()
 
 	val, ok := h.entries[key]
-	if !ok || val != oldValue {
+	if !ok || !equalValues(h.values, val, oldValue) {
 		return false
 	}
 	h.entries[key] = newValue
@@ -219,11 +235,33 @@ func (h *HashMap[K, V]) Values() collections.Collection[V] {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
-	collection := sets.NewHashSet[V]()
+	values := make([]V, 0, len(h.entries))
 	for _, val := range h.entries {
-		_ = collection.Add(val)
+		values = append(values, val)
 	}
-	return collection
+	return valueList(values, h.values)
+}
+
+// All returns a sequence over a snapshot of the entries in this map, in no particular order.
+// The snapshot is taken when iteration starts, so the loop body may modify the map.
+func (h *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return pairsSeq(h.snapshot)
+}
+
+// Keys returns a sequence over a snapshot of the keys in this map, in no particular order.
+func (h *HashMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(h.All())
+}
+
+// AllValues returns a sequence over a snapshot of the values in this map, in no particular order.
+func (h *HashMap[K, V]) AllValues() iter.Seq[V] {
+	return valuesOf(h.All())
+}
+
+func (h *HashMap[K, V]) snapshot() []pair[K, V] {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return snapshotPairs(h.entries)
 }
 
 func (h *HashMap[K, V]) PutIfAbsent(key K, value V) V {
@@ -276,7 +314,7 @@ func (h *HashMap[K, V]) ForEachEntry(action func(key K, value V)) {
 // ComputeIfAbsent computes a value if key is not already associated with a value
 func (h *HashMap[K, V]) ComputeIfAbsent(key K, mappingFunction func(K) V) (V, error) {
 	if mappingFunction == nil {
-		return *new(V), errors.New(string(errcodes.NullPointerError))
+		return *new(V), errcodes.New(errcodes.NullPointerError, "HashMap", "ComputeIfAbsent")
 	}
 
 	h.mu.Lock()
@@ -291,10 +329,81 @@ func (h *HashMap[K, V]) ComputeIfAbsent(key K, mappingFunction func(K) V) (V, er
 	return value, nil
 }
 
+// Compute atomically computes a new mapping for the key from its current value.
+// The function receives nil if the key is absent. If it returns false, the mapping is
+// removed (or not created) and Compute returns nil.
+// The function runs while the map is locked, so it must not use this map.
+func (h *HashMap[K, V]) Compute(key K, remappingFunction func(K, *V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "HashMap", "Compute")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var current *V
+	if value, ok := h.entries[key]; ok {
+		current = &value
+	}
+	value, keep := remappingFunction(key, current)
+	return h.store(key, value, keep), nil
+}
+
+// ComputeIfPresent atomically computes a new mapping for the key if it is present.
+// If the function returns false, the mapping is removed and nil is returned.
+// The function runs while the map is locked, so it must not use this map.
+func (h *HashMap[K, V]) ComputeIfPresent(key K, remappingFunction func(K, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "HashMap", "ComputeIfPresent")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	current, ok := h.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	value, keep := remappingFunction(key, current)
+	return h.store(key, value, keep), nil
+}
+
+// Merge atomically associates the key with value if it is absent, or otherwise with the
+// result of combining the current value and value. If the function returns false, the
+// mapping is removed and nil is returned.
+// The function runs while the map is locked, so it must not use this map.
+func (h *HashMap[K, V]) Merge(key K, value V, remappingFunction func(V, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "HashMap", "Merge")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	current, ok := h.entries[key]
+	if !ok {
+		return h.store(key, value, true), nil
+	}
+	merged, keep := remappingFunction(current, value)
+	return h.store(key, merged, keep), nil
+}
+
+// store applies the result of a remapping function and returns a pointer to the stored
+// value, or nil if the mapping was removed.
+// It assumes the write lock is already held.
+func (h *HashMap[K, V]) store(key K, value V, keep bool) *V {
+	if !keep {
+		delete(h.entries, key)
+		return nil
+	}
+	h.entries[key] = value
+	return &value
+}
+
 // PutAllBatch performs a batch put operation for better performance
 func (h *HashMap[K, V]) PutAllBatch(entries []collections.MapEntry[K, V]) error {
 	if entries == nil {
-		return errors.New(string(errcodes.NullPointerError))
+		return errcodes.New(errcodes.NullPointerError, "HashMap", "PutAllBatch")
 	}
 
 	h.mu.Lock()
@@ -309,7 +418,7 @@ func (h *HashMap[K, V]) PutAllBatch(entries []collections.MapEntry[K, V]) error
 // RemoveAllBatch performs a batch remove operation for better performance
 func (h *HashMap[K, V]) RemoveAllBatch(keys []K) error {
 	if keys == nil {
-		return errors.New(string(errcodes.NullPointerError))
+		return errcodes.New(errcodes.NullPointerError, "HashMap", "RemoveAllBatch")
 	}
 
 	h.mu.Lock()
@@ -321,7 +430,7 @@ func (h *HashMap[K, V]) RemoveAllBatch(keys []K) error {
 	return nil
 }
 
-// Clone creates a deep copy of the map
+// Clone creates a deep copy of the map. The copy is unsynchronized if the map is.
 func (h *HashMap[K, V]) Clone() collections.Map[K, V] {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
@@ -330,10 +439,14 @@ func (h *HashMap[K, V]) Clone() collections.Map[K, V] {
 	for k, v := range h.entries {
 		newMap[k] = v
 	}
-	return &HashMap[K, V]{
+	clone := &HashMap[K, V]{
 		entries: newMap,
-		mu:      sync.RWMutex{},
+		values:  h.values,
+	}
+	if h.mu.Disabled() {
+		clone.mu.Disable()
 	}
+	return clone
 }
 
 // RemoveIf removes all entries that satisfy the given predicate
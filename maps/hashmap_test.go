@@ -1,10 +1,16 @@
 package maps
 
 import (
+	"errors"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
 	"testing"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 )
 
 func TestNewHashMap(t *testing.T) {
@@ -36,6 +42,28 @@ func TestNewHashMap(t *testing.T) {
 	}
 }
 
+func TestNewHashMap_Unsynchronized(t *testing.T) {
+	hm := NewHashMap[string, int](Unsynchronized())
+	if !hm.(*HashMap[string, int]).mu.Disabled() {
+		t.Error("Unsynchronized should disable locking")
+	}
+	if !hm.IsEmpty() {
+		t.Error("New HashMap should be empty")
+	}
+
+	hm.Put("a", 1)
+	hm.Put("b", 2)
+	if got := hm.Get("a"); got == nil || *got != 1 {
+		t.Errorf("Expected 1, got %v", got)
+	}
+	if !hm.Equals(hm.(*HashMap[string, int]).Clone()) {
+		t.Error("Clone should equal the original map")
+	}
+	if !hm.(*HashMap[string, int]).Clone().(*HashMap[string, int]).mu.Disabled() {
+		t.Error("Clone of an unsynchronized HashMap should be unsynchronized")
+	}
+}
+
 func TestHashMap_BasicOperations(t *testing.T) {
 	hm := NewHashMap[string, int]()
 
@@ -540,3 +568,179 @@ func TestHashMap_Get(t *testing.T) {
 		t.Errorf("Get('one') after removal = %v; want nil", val)
 	}
 }
+
+func TestHashMap_All(t *testing.T) {
+	hm := NewHashMap[string, int]()
+	hm.Put("a", 1)
+	hm.Put("b", 2)
+	hm.Put("c", 3)
+
+	got := make(map[string]int)
+	for k, v := range hm.All() {
+		got[k] = v
+	}
+	if !maps.Equal(got, map[string]int{"a": 1, "b": 2, "c": 3}) {
+		t.Errorf("Expected all entries, got %v", got)
+	}
+
+	keys := slices.Collect(hm.Keys())
+	sort.Strings(keys)
+	if !slices.Equal(keys, []string{"a", "b", "c"}) {
+		t.Errorf("Expected keys [a b c], got %v", keys)
+	}
+	values := slices.Collect(hm.AllValues())
+	sort.Ints(values)
+	if !slices.Equal(values, []int{1, 2, 3}) {
+		t.Errorf("Expected values [1 2 3], got %v", values)
+	}
+
+	// The sequence iterates over a snapshot, so the loop body may modify the map
+	for k := range hm.Keys() {
+		hm.Remove(k)
+	}
+	if !hm.IsEmpty() {
+		t.Errorf("Expected empty map after removing every key, got size %d", hm.Size())
+	}
+}
+
+func TestNewHashMapWithValueEquality(t *testing.T) {
+	hm := NewHashMapWithValueEquality[string, []byte](nil)
+	hm.Put("a", []byte("x"))
+	hm.Put("b", []byte("y"))
+
+	if !hm.HasValue([]byte("x")) {
+		t.Error("Expected HasValue to compare slices by content")
+	}
+	if hm.RemoveKeyWithValue("a", []byte("y")) {
+		t.Error("Expected RemoveKeyWithValue to fail for a different value")
+	}
+	if !hm.ReplaceKeyWithValue("a", []byte("x"), []byte("z")) {
+		t.Error("Expected ReplaceKeyWithValue to succeed for an equal value")
+	}
+	if !hm.RemoveKeyWithValue("a", []byte("z")) {
+		t.Error("Expected RemoveKeyWithValue to succeed for an equal value")
+	}
+	if !hm.Values().Contains([]byte("y")) {
+		t.Error("Expected Values to contain the remaining value")
+	}
+
+	other := NewHashMapWithValueEquality[string, []byte](nil)
+	other.Put("b", []byte("y"))
+	if !hm.Equals(other) {
+		t.Error("Expected maps with equal slice values to be equal")
+	}
+
+	caseInsensitive := NewHashMapWithValueEquality[int, string](collections.EqualerFunc[string](strings.EqualFold))
+	caseInsensitive.Put(1, "Go")
+	if !caseInsensitive.HasValue("GO") {
+		t.Error("Expected HasValue to use the supplied Equaler")
+	}
+}
+
+func TestHashMap_ValuesKeepsDuplicates(t *testing.T) {
+	hm := NewHashMap[string, int]()
+	hm.Put("a", 1)
+	hm.Put("b", 1)
+
+	if got := hm.Values().Size(); got != 2 {
+		t.Errorf("Values().Size() = %d; want 2", got)
+	}
+}
+
+func TestHashMap_Compute(t *testing.T) {
+	hm := NewHashMap[string, int]()
+	increment := func(k string, v *int) (int, bool) {
+		if v == nil {
+			return 1, true
+		}
+		return *v + 1, true
+	}
+
+	if value, err := hm.Compute("a", increment); err != nil || *value != 1 {
+		t.Errorf("Compute on an absent key = %v, %v; want 1, nil", value, err)
+	}
+	if value, _ := hm.Compute("a", increment); *value != 2 {
+		t.Errorf("Compute on a present key = %d; want 2", *value)
+	}
+	if value, _ := hm.Compute("a", func(k string, v *int) (int, bool) { return 0, false }); value != nil || hm.HasKey("a") {
+		t.Error("Expected Compute returning false to remove the mapping")
+	}
+	if value, _ := hm.Compute("b", func(k string, v *int) (int, bool) { return 0, false }); value != nil || hm.Size() != 0 {
+		t.Error("Expected Compute returning false for an absent key to add nothing")
+	}
+	if _, err := hm.Compute("a", nil); !errors.Is(err, errcodes.ErrNullPointer) {
+		t.Errorf("Compute(nil) error = %v; want NullPointerError", err)
+	}
+}
+
+func TestHashMap_ComputeIfPresentAndMerge(t *testing.T) {
+	hm := NewHashMap[string, int]()
+	double := func(k string, v int) (int, bool) { return v * 2, true }
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	if value, _ := hm.ComputeIfPresent("a", double); value != nil || hm.HasKey("a") {
+		t.Error("Expected ComputeIfPresent to do nothing for an absent key")
+	}
+	if value, _ := hm.Merge("a", 3, sum); *value != 3 {
+		t.Errorf("Merge on an absent key = %d; want 3", *value)
+	}
+	if value, _ := hm.Merge("a", 4, sum); *value != 7 {
+		t.Errorf("Merge on a present key = %d; want 7", *value)
+	}
+	if value, _ := hm.ComputeIfPresent("a", double); *value != 14 || *hm.Get("a") != 14 {
+		t.Errorf("ComputeIfPresent = %d; want 14", *value)
+	}
+	if value, _ := hm.Merge("a", 1, func(old, v int) (int, bool) { return 0, false }); value != nil || hm.HasKey("a") {
+		t.Error("Expected Merge returning false to remove the mapping")
+	}
+	hm.Put("b", 1)
+	if value, _ := hm.ComputeIfPresent("b", func(k string, v int) (int, bool) { return 0, false }); value != nil || hm.HasKey("b") {
+		t.Error("Expected ComputeIfPresent returning false to remove the mapping")
+	}
+	if _, err := hm.ComputeIfPresent("a", nil); !errors.Is(err, errcodes.ErrNullPointer) {
+		t.Errorf("ComputeIfPresent(nil) error = %v; want NullPointerError", err)
+	}
+	if _, err := hm.Merge("a", 1, nil); !errors.Is(err, errcodes.ErrNullPointer) {
+		t.Errorf("Merge(nil) error = %v; want NullPointerError", err)
+	}
+}
+
+func TestHashMap_MergeConcurrent(t *testing.T) {
+	hm := NewHashMap[string, int]()
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				_, _ = hm.Merge("count", 1, sum)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := *hm.Get("count"); got != 8000 {
+		t.Errorf("count = %d; want 8000", got)
+	}
+}
+
+func TestNewHashMapWithValueEqualityOptions(t *testing.T) {
+	for name, hm := range map[string]collections.Map[string, []byte]{
+		"capacity":       NewHashMapWithValueEquality[string, []byte](nil, WithCapacity(64)),
+		"zero capacity":  NewHashMapWithValueEquality[string, []byte](nil, WithCapacity(0)),
+		"unsynchronized": NewHashMapWithValueEquality[string, []byte](nil, Unsynchronized()),
+	} {
+		hm.Put("a", []byte("x"))
+		if !hm.HasValue([]byte("x")) {
+			t.Errorf("%s: expected HasValue to compare slices by content", name)
+		}
+		if !hm.ReplaceKeyWithValue("a", []byte("x"), []byte("y")) {
+			t.Errorf("%s: expected ReplaceKeyWithValue to succeed for an equal value", name)
+		}
+		if !hm.RemoveKeyWithValue("a", []byte("y")) {
+			t.Errorf("%s: expected RemoveKeyWithValue to succeed for an equal value", name)
+		}
+	}
+}
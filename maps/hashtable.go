@@ -1,10 +1,11 @@
 package maps
 
 import (
+	"iter"
 	"sync"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
-	"github.com/chiranjeevipavurala/gocollections/lists"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 	"github.com/chiranjeevipavurala/gocollections/sets"
 )
 
@@ -14,15 +15,29 @@ import (
 // It is the responsibility of the user to handle zero values appropriately for their use case.
 // For example, if zero values are not desired, the user should implement their own checks
 // before calling Put, PutIfAbsent, or Replace operations.
-type HashTable[K comparable, V comparable] struct {
-	items map[K]V
-	mu    sync.RWMutex
+type HashTable[K comparable, V any] struct {
+	items  map[K]V
+	values collections.Equaler[V] // Compares values; nil in the zero map
+	mu     sync.RWMutex
 }
 
+var _ collections.ConcurrentMap[string, int] = (*HashTable[string, int])(nil)
+
 // NewHashTable creates a new HashTable.
 func NewHashTable[K comparable, V comparable]() *HashTable[K, V] {
 	return &HashTable[K, V]{
-		items: make(map[K]V),
+		items:  make(map[K]V),
+		values: comparableValues[V]{},
+	}
+}
+
+// NewHashTableWithValueEquality creates a new HashTable whose values may be of any type.
+// Values are compared with equaler, or, if it is nil, with == where possible and
+// reflect.DeepEqual otherwise.
+func NewHashTableWithValueEquality[K comparable, V any](equaler collections.Equaler[V]) *HashTable[K, V] {
+	return &HashTable[K, V]{
+		items:  make(map[K]V),
+		values: valueEqualer(equaler),
 	}
 }
 
@@ -36,6 +51,118 @@ func (ht *HashTable[K, V]) Put(key K, value V) V {
 	return oldValue
 }
 
+// GetOrDefault returns the value to which the specified key is mapped, or defaultValue if this map contains no mapping for the key.
+func (ht *HashTable[K, V]) GetOrDefault(key K, defaultValue V) V {
+	ht.mu.RLock()
+	defer ht.mu.RUnlock()
+
+	if value, exists := ht.items[key]; exists {
+		return value
+	}
+	return defaultValue
+}
+
+// ForEachEntry performs the given action for each entry in a snapshot of this map.
+// The action runs without holding the lock, so it may modify the table.
+func (ht *HashTable[K, V]) ForEachEntry(action func(key K, value V)) {
+	if action == nil {
+		return
+	}
+	for key, value := range ht.All() {
+		action(key, value)
+	}
+}
+
+// ComputeIfAbsent computes a value for the specified key if the key is not already associated with a value.
+// The mapping function runs while the table is locked, so it must not use this table.
+func (ht *HashTable[K, V]) ComputeIfAbsent(key K, mappingFunction func(K) V) (V, error) {
+	if mappingFunction == nil {
+		var zero V
+		return zero, errcodes.New(errcodes.NullPointerError, "HashTable", "ComputeIfAbsent")
+	}
+
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+
+	if value, exists := ht.items[key]; exists {
+		return value, nil
+	}
+	value := mappingFunction(key)
+	ht.items[key] = value
+	return value, nil
+}
+
+// Compute atomically computes a new mapping for the key from its current value.
+// The function receives nil if the key is absent. If it returns false, the mapping is
+// removed (or not created) and Compute returns nil.
+// The function runs while the table is locked, so it must not use this table.
+func (ht *HashTable[K, V]) Compute(key K, remappingFunction func(K, *V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "HashTable", "Compute")
+	}
+
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+
+	var current *V
+	if value, exists := ht.items[key]; exists {
+		current = &value
+	}
+	value, keep := remappingFunction(key, current)
+	return ht.store(key, value, keep), nil
+}
+
+// ComputeIfPresent atomically computes a new mapping for the key if it is present.
+// If the function returns false, the mapping is removed and nil is returned.
+// The function runs while the table is locked, so it must not use this table.
+func (ht *HashTable[K, V]) ComputeIfPresent(key K, remappingFunction func(K, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "HashTable", "ComputeIfPresent")
+	}
+
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+
+	current, exists := ht.items[key]
+	if !exists {
+		return nil, nil
+	}
+	value, keep := remappingFunction(key, current)
+	return ht.store(key, value, keep), nil
+}
+
+// Merge atomically associates the key with value if it is absent, or otherwise with the
+// result of combining the current value and value. If the function returns false, the
+// mapping is removed and nil is returned.
+// The function runs while the table is locked, so it must not use this table.
+func (ht *HashTable[K, V]) Merge(key K, value V, remappingFunction func(V, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "HashTable", "Merge")
+	}
+
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+
+	current, exists := ht.items[key]
+	if !exists {
+		return ht.store(key, value, true), nil
+	}
+	merged, keep := remappingFunction(current, value)
+	return ht.store(key, merged, keep), nil
+}
+
+// store applies the result of a remapping function and returns a pointer to the stored
+// value, or nil if the mapping was removed.
+// It assumes the write lock is already held.
+func (ht *HashTable[K, V]) store(key K, value V, keep bool) *V {
+	if !keep {
+		delete(ht.items, key)
+		return nil
+	}
+	ht.items[key] = value
+	return &value
+}
+
 // Get returns the value associated with the specified key.
 func (ht *HashTable[K, V]) Get(key K) *V {
 	ht.mu.RLock()
@@ -72,7 +199,7 @@ func (ht *HashTable[K, V]) ContainsValue(value V) bool {
 	defer ht.mu.RUnlock()
 
 	for _, v := range ht.items {
-		if v == value {
+		if equalValues(ht.values, v, value) {
 			return true
 		}
 	}
@@ -144,11 +271,30 @@ func (ht *HashTable[K, V]) Values() collections.Collection[V] {
 	for _, v := range ht.items {
 		values = append(values, v)
 	}
-	list := lists.NewArrayList[V]()
-	for _, value := range values {
-		list.Add(value)
-	}
-	return list
+	return valueList(values, ht.values)
+}
+
+// All returns a sequence over a snapshot of the entries in this map, in no particular order.
+// The snapshot is taken when iteration starts, so the loop body may modify the map.
+func (ht *HashTable[K, V]) All() iter.Seq2[K, V] {
+	return pairsSeq(ht.snapshot)
+}
+
+// Keys returns a sequence over a snapshot of the keys in this map, in no particular order.
+func (ht *HashTable[K, V]) Keys() iter.Seq[K] {
+	return keysOf(ht.All())
+}
+
+// AllValues returns a sequence over a snapshot of the values in this map, in no particular order.
+func (ht *HashTable[K, V]) AllValues() iter.Seq[V] {
+	return valuesOf(ht.All())
+}
+
+// snapshot copies the entries of this map under the read lock.
+func (ht *HashTable[K, V]) snapshot() []pair[K, V] {
+	ht.mu.RLock()
+	defer ht.mu.RUnlock()
+	return snapshotPairs(ht.items)
 }
 
 // Equals returns true if this map equals the given map.
@@ -170,7 +316,7 @@ func (ht *HashTable[K, V]) Equals(other any) bool {
 
 	for k, v := range ht.items {
 		otherValue := otherMap.Get(k)
-		if otherValue == nil || *otherValue != v {
+		if otherValue == nil || !equalValues(ht.values, *otherValue, v) {
 			return false
 		}
 	}
@@ -208,7 +354,7 @@ func (ht *HashTable[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bo
 	ht.mu.Lock()
 	defer ht.mu.Unlock()
 
-	if currentValue, exists := ht.items[key]; exists && currentValue == oldValue {
+	if currentValue, exists := ht.items[key]; exists && equalValues(ht.values, currentValue, oldValue) {
 		ht.items[key] = newValue
 		return true
 	}
@@ -220,7 +366,7 @@ func (ht *HashTable[K, V]) RemoveKeyWithValue(key K, value V) bool {
 	ht.mu.Lock()
 	defer ht.mu.Unlock()
 
-	if currentValue, exists := ht.items[key]; exists && currentValue == value {
+	if currentValue, exists := ht.items[key]; exists && equalValues(ht.values, currentValue, value) {
 		delete(ht.items, key)
 		return true
 	}
@@ -1,6 +1,8 @@
 package maps
 
 import (
+	"maps"
+	"slices"
 	"sync"
 	"testing"
 
@@ -328,3 +330,108 @@ func TestHashTable_Equals_DifferentSizes(t *testing.T) {
 	assert.False(t, ht1.Equals(ht2), "Equals should return false when sizes differ")
 	assert.False(t, ht2.Equals(ht1), "Equals should return false when sizes differ (reverse)")
 }
+
+func TestHashTable_All(t *testing.T) {
+	ht := NewHashTable[string, int]()
+	ht.Put("a", 1)
+	ht.Put("b", 2)
+
+	got := make(map[string]int)
+	for k, v := range ht.All() {
+		got[k] = v
+	}
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, got)
+	assert.ElementsMatch(t, []string{"a", "b"}, slices.Collect(ht.Keys()))
+	assert.ElementsMatch(t, []int{1, 2}, slices.Collect(ht.AllValues()))
+	assert.Empty(t, maps.Collect(NewHashTable[string, int]().All()))
+}
+
+func TestNewHashTableWithValueEquality(t *testing.T) {
+	type config struct {
+		name  string
+		hosts []string
+	}
+	ht := NewHashTableWithValueEquality[string, config](nil)
+	ht.Put("db", config{name: "db", hosts: []string{"a", "b"}})
+
+	assert.True(t, ht.HasValue(config{name: "db", hosts: []string{"a", "b"}}))
+	assert.False(t, ht.HasValue(config{name: "db", hosts: []string{"a"}}))
+	assert.True(t, ht.ReplaceKeyWithValue("db", config{name: "db", hosts: []string{"a", "b"}}, config{name: "db"}))
+	assert.True(t, ht.Values().Contains(config{name: "db"}))
+	assert.True(t, ht.RemoveKeyWithValue("db", config{name: "db"}))
+	assert.True(t, ht.IsEmpty())
+}
+
+func TestHashTable_ComputeAndMerge(t *testing.T) {
+	ht := NewHashTable[string, int]()
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				_, _ = ht.Merge("merged", 2, sum)
+				_, _ = ht.Compute("computed", func(k string, v *int) (int, bool) {
+					if v == nil {
+						return 1, true
+					}
+					return *v + 1, true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1600, *ht.Get("merged"))
+	assert.Equal(t, 800, *ht.Get("computed"))
+
+	value, err := ht.ComputeIfPresent("computed", func(k string, v int) (int, bool) { return v / 2, true })
+	assert.NoError(t, err)
+	assert.Equal(t, 400, *value)
+	value, err = ht.ComputeIfPresent("absent", func(k string, v int) (int, bool) { return v, true })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = ht.Compute("computed", func(k string, v *int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = ht.Merge("merged", 0, func(old, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.True(t, ht.IsEmpty())
+
+	_, err = ht.Compute("a", nil)
+	assert.Error(t, err)
+	_, err = ht.ComputeIfPresent("a", nil)
+	assert.Error(t, err)
+	_, err = ht.Merge("a", 1, nil)
+	assert.Error(t, err)
+}
+
+func TestHashTable_ConcurrentMapMethods(t *testing.T) {
+	ht := NewHashTable[string, int]()
+	ht.Put("two", 2)
+
+	assert.Equal(t, 2, ht.GetOrDefault("two", -1))
+	assert.Equal(t, -1, ht.GetOrDefault("one", -1))
+
+	value, err := ht.ComputeIfAbsent("four", func(k string) int { return len(k) })
+	assert.NoError(t, err)
+	assert.Equal(t, 4, value)
+	value, err = ht.ComputeIfAbsent("four", func(k string) int { return 0 })
+	assert.NoError(t, err)
+	assert.Equal(t, 4, value)
+	_, err = ht.ComputeIfAbsent("x", nil)
+	assert.Error(t, err)
+	assert.False(t, ht.ContainsKey("x"))
+
+	seen := map[string]int{}
+	ht.ForEachEntry(func(k string, v int) {
+		seen[k] = v
+		ht.Remove(k)
+	})
+	assert.Equal(t, map[string]int{"two": 2, "four": 4}, seen)
+	assert.True(t, ht.IsEmpty())
+	ht.ForEachEntry(nil)
+}
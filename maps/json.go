@@ -0,0 +1,284 @@
+package maps
+
+import (
+	"bytes"
+	"cmp"
+	"encoding"
+	"encoding/json"
+	"iter"
+	"reflect"
+	"slices"
+	"strconv"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// Maps are encoded as JSON objects. Keys follow the rules of encoding/json: string keys
+// are used as they are, keys implementing encoding.TextMarshaler are marshaled as text,
+// and integer keys are written in base 10. LinkedHashMap and TreeMap write their entries
+// in iteration order and LinkedHashMap restores that order when decoding; the hash-based
+// maps sort the keys, as encoding/json does for Go maps. Decoding replaces the current
+// contents, and, as encoding/json recommends, decoding a JSON null is a no-op.
+
+// MarshalJSON encodes the map as a JSON object with its keys sorted.
+func (m *HashMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSONObject(m.All(), true)
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON object.
+func (m *HashMap[K, V]) UnmarshalJSON(data []byte) error {
+	pairs, err := unmarshalJSONObject[K, V](data)
+	if err != nil || pairs == nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = make(map[K]V, max(len(pairs), DefaultCapacity))
+	for _, p := range pairs {
+		m.entries[p.key] = p.value
+	}
+	return nil
+}
+
+// MarshalJSON encodes the map as a JSON object with its keys sorted.
+func (ht *HashTable[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSONObject(ht.All(), true)
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON object.
+func (ht *HashTable[K, V]) UnmarshalJSON(data []byte) error {
+	pairs, err := unmarshalJSONObject[K, V](data)
+	if err != nil || pairs == nil {
+		return err
+	}
+
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	ht.items = make(map[K]V, len(pairs))
+	for _, p := range pairs {
+		ht.items[p.key] = p.value
+	}
+	return nil
+}
+
+// MarshalJSON encodes the map as a JSON object with its keys sorted.
+// Like the rest of the whole-map operations, it is weakly consistent.
+func (m *ConcurrentHashMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSONObject(m.All(), true)
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON object.
+// The map must have been created with NewConcurrentHashMap; decoding into a zero
+// ConcurrentHashMap returns NullPointerError. The replacement clears and fills one
+// segment at a time, so concurrent readers may see a mix of old and new entries.
+func (m *ConcurrentHashMap[K, V]) UnmarshalJSON(data []byte) error {
+	if m.segments == nil {
+		return errcodes.New(errcodes.NullPointerError, "ConcurrentHashMap", "UnmarshalJSON")
+	}
+	pairs, err := unmarshalJSONObject[K, V](data)
+	if err != nil || pairs == nil {
+		return err
+	}
+
+	m.Clear()
+	for _, p := range pairs {
+		m.Put(p.key, p.value)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the map as a JSON object in insertion order.
+func (lhm *LinkedHashMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSONObject(lhm.All(), false)
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON object,
+// in the order they appear in the document.
+func (lhm *LinkedHashMap[K, V]) UnmarshalJSON(data []byte) error {
+	pairs, err := unmarshalJSONObject[K, V](data)
+	if err != nil || pairs == nil {
+		return err
+	}
+
+	lhm.mu.Lock()
+	defer lhm.mu.Unlock()
+	lhm.head = nil
+	lhm.tail = nil
+	lhm.items = make(map[K]*node[K, V], len(pairs))
+	for _, p := range pairs {
+		lhm.put(p.key, p.value)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the map as a JSON object in ascending key order.
+func (t *TreeMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSONObject(t.All(), false)
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON object.
+// The map keeps its comparator, so it must have been created with NewTreeMap; decoding
+// into a zero TreeMap returns NullPointerError.
+func (t *TreeMap[K, V]) UnmarshalJSON(data []byte) error {
+	if t.comparator == nil {
+		return errcodes.New(errcodes.NullPointerError, "TreeMap", "UnmarshalJSON")
+	}
+	pairs, err := unmarshalJSONObject[K, V](data)
+	if err != nil || pairs == nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.root = nil
+	t.size = 0
+	t.modCount++
+	for _, p := range pairs {
+		t.put(p.key, p.value)
+	}
+	return nil
+}
+
+// marshalJSONObject encodes the entries of seq as a JSON object. If sortKeys is true the
+// members are sorted by their encoded key, otherwise they are written in the order of seq.
+func marshalJSONObject[K comparable, V any](seq iter.Seq2[K, V], sortKeys bool) ([]byte, error) {
+	type member struct {
+		key   string
+		value V
+	}
+	var members []member
+	for k, v := range seq {
+		key, err := encodeJSONKey(k)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member{key: key, value: v})
+	}
+	if sortKeys {
+		slices.SortFunc(members, func(a, b member) int { return cmp.Compare(a.key, b.key) })
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// unmarshalJSONObject decodes the members of a JSON object in document order.
+// It returns nil without an error for a JSON null, and a non-nil empty slice for an empty object.
+func unmarshalJSONObject[K comparable, V any](data []byte) ([]pair[K, V], error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+	if token != json.Delim('{') {
+		return nil, &json.UnmarshalTypeError{Value: jsonKind(token), Type: reflect.TypeFor[map[K]V]()}
+	}
+
+	pairs := []pair[K, V]{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, err := decodeJSONKey[K](token.(string))
+		if err != nil {
+			return nil, err
+		}
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, pair[K, V]{key: key, value: value})
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// encodeJSONKey converts a map key to the string used as its JSON object key.
+func encodeJSONKey[K comparable](key K) (string, error) {
+	v := reflect.ValueOf(key)
+	if !v.IsValid() {
+		return "", &json.UnsupportedTypeError{Type: reflect.TypeFor[K]()}
+	}
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	if marshaler, ok := any(key).(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+	return "", &json.UnsupportedTypeError{Type: v.Type()}
+}
+
+// decodeJSONKey converts a JSON object key back to a map key.
+func decodeJSONKey[K comparable](s string) (K, error) {
+	var key K
+	if unmarshaler, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := unmarshaler.UnmarshalText([]byte(s))
+		return key, err
+	}
+	v := reflect.ValueOf(&key).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return key, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err == nil && !v.OverflowInt(n) {
+			v.SetInt(n)
+			return key, nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err == nil && !v.OverflowUint(n) {
+			v.SetUint(n)
+			return key, nil
+		}
+	}
+	return key, &json.UnmarshalTypeError{Value: "string " + strconv.Quote(s), Type: v.Type()}
+}
+
+// jsonKind describes the JSON value that starts with token, for error messages.
+func jsonKind(token json.Token) string {
+	switch token.(type) {
+	case json.Delim:
+		return "array"
+	case bool:
+		return "bool"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	}
+	return "value"
+}
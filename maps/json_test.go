@@ -0,0 +1,128 @@
+package maps
+
+import (
+	"encoding/json"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashMap_JSON(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":2,"c":3}`, string(data))
+
+	var decoded HashMap[string, int]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, 3, decoded.Size())
+	assert.Equal(t, 2, *decoded.Get("b"))
+	assert.True(t, m.Equals(&decoded))
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &decoded))
+	assert.Equal(t, 3, decoded.Size())
+	assert.NoError(t, json.Unmarshal([]byte(`{}`), &decoded))
+	assert.True(t, decoded.IsEmpty())
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`{"a":"x"}`), &decoded))
+}
+
+func TestMap_JSONKeys(t *testing.T) {
+	ints := NewHashMap[int, string]()
+	ints.Put(10, "ten")
+	ints.Put(-2, "minus two")
+	data, err := json.Marshal(ints)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"-2":"minus two","10":"ten"}`, string(data))
+	var decodedInts HashMap[int, string]
+	assert.NoError(t, json.Unmarshal(data, &decodedInts))
+	assert.Equal(t, "ten", *decodedInts.Get(10))
+
+	var small HashMap[int8, int]
+	assert.Error(t, json.Unmarshal([]byte(`{"300":1}`), &small))
+	assert.Error(t, json.Unmarshal([]byte(`{"x":1}`), &small))
+
+	// Keys implementing encoding.TextMarshaler are encoded as text
+	addrs := NewLinkedHashMap[netip.Addr, bool]()
+	addrs.Put(netip.MustParseAddr("10.0.0.1"), true)
+	data, err = json.Marshal(addrs)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"10.0.0.1":true}`, string(data))
+	var decodedAddrs LinkedHashMap[netip.Addr, bool]
+	assert.NoError(t, json.Unmarshal(data, &decodedAddrs))
+	assert.True(t, decodedAddrs.HasKey(netip.MustParseAddr("10.0.0.1")))
+
+	// Keys that encoding/json cannot represent are rejected
+	floats := NewHashMap[float64, int]()
+	floats.Put(1.5, 1)
+	_, err = json.Marshal(floats)
+	assert.Error(t, err)
+}
+
+func TestLinkedHashMap_JSON(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("z", 26)
+	m.Put("a", 1)
+	m.Put("m", 13)
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"z":26,"a":1,"m":13}`, string(data))
+
+	decoded := NewLinkedHashMap[string, int]()
+	decoded.Put("old", 0)
+	assert.NoError(t, json.Unmarshal([]byte(`{"y":2,"b":1,"y":3,"x":0}`), decoded))
+	assert.Equal(t, []string{"y", "b", "x"}, slices.Collect(decoded.Keys()))
+	assert.Equal(t, 3, *decoded.Get("y"))
+	assert.False(t, decoded.HasKey("old"))
+}
+
+func TestTreeMap_JSON(t *testing.T) {
+	m := NewTreeMap[string, int](comparators.Reverse(comparators.Natural[string]()))
+	m.Put("a", 1)
+	m.Put("c", 3)
+	m.Put("b", 2)
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"c":3,"b":2,"a":1}`, string(data))
+
+	decoded := NewTreeMap[string, int](comparators.Natural[string]())
+	decoded.Put("old", 0)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(decoded.Keys()))
+	assert.False(t, decoded.HasKey("old"))
+
+	var zero TreeMap[string, int]
+	assert.EqualError(t, json.Unmarshal(data, &zero), string(errcodes.NullPointerError))
+}
+
+func TestHashTableAndConcurrentHashMap_JSON(t *testing.T) {
+	table := NewHashTable[string, int]()
+	table.Put("b", 2)
+	table.Put("a", 1)
+	data, err := json.Marshal(table)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":2}`, string(data))
+
+	concurrent := NewConcurrentHashMap[string, int]()
+	concurrent.Put("stale", 0)
+	assert.NoError(t, json.Unmarshal(data, concurrent))
+	assert.Equal(t, 2, concurrent.Size())
+	assert.False(t, concurrent.HasKey("stale"))
+	data, err = json.Marshal(concurrent)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":2}`, string(data))
+
+	var decoded HashTable[string, int]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, 1, *decoded.Get("a"))
+
+	var zero ConcurrentHashMap[string, int]
+	assert.EqualError(t, json.Unmarshal(data, &zero), string(errcodes.NullPointerError))
+}
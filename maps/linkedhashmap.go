@@ -1,33 +1,186 @@
 package maps
 
 import (
-	"errors"
-	"sync"
+	"iter"
+	"slices"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/locking"
 	"github.com/chiranjeevipavurala/gocollections/sets"
 )
 
 // node represents a key-value pair in the linked list.
-type node[K comparable, V comparable] struct {
+type node[K comparable, V any] struct {
 	key   K
 	value V
 	prev  *node[K, V]
 	next  *node[K, V]
 }
 
-// LinkedHashMap is a Map that maintains insertion order.
-type LinkedHashMap[K comparable, V comparable] struct {
-	head  *node[K, V]
-	tail  *node[K, V]
-	items map[K]*node[K, V]
-	mu    sync.RWMutex
+// LinkedHashMap is a Map that maintains insertion order, or, if created with
+// WithAccessOrder, the order in which its entries were last accessed.
+type LinkedHashMap[K comparable, V any] struct {
+	head         *node[K, V]
+	tail         *node[K, V]
+	items        map[K]*node[K, V]
+	values       collections.Equaler[V] // Compares values; nil in the zero map
+	accessOrder  bool                   // Whether accessing an entry moves it to the tail
+	removeEldest func(eldest collections.MapEntry[K, V], size int) bool
+	mu           locking.RWMutex
 }
 
-// NewLinkedHashMap creates a new LinkedHashMap.
-func NewLinkedHashMap[K comparable, V comparable]() collections.Map[K, V] {
-	return &LinkedHashMap[K, V]{
-		items: make(map[K]*node[K, V]),
+var _ collections.ConcurrentMap[string, int] = (*LinkedHashMap[string, int])(nil)
+
+// NewLinkedHashMap creates a new LinkedHashMap configured by options.
+func NewLinkedHashMap[K comparable, V comparable](options ...Option) collections.Map[K, V] {
+	return newLinkedHashMap[K, V](comparableValues[V]{}, options)
+}
+
+// NewLinkedHashMapWithValueEquality creates a new LinkedHashMap whose values may be of any
+// type. Values are compared with equaler, or, if it is nil, with == where possible and
+// reflect.DeepEqual otherwise.
+func NewLinkedHashMapWithValueEquality[K comparable, V any](equaler collections.Equaler[V], options ...Option) collections.Map[K, V] {
+	return newLinkedHashMap[K, V](valueEqualer(equaler), options)
+}
+
+func newLinkedHashMap[K comparable, V any](values collections.Equaler[V], options []Option) *LinkedHashMap[K, V] {
+	c := newConfig(options)
+	m := &LinkedHashMap[K, V]{
+		items:       make(map[K]*node[K, V], c.capacity),
+		values:      values,
+		accessOrder: c.accessOrder,
+	}
+	if c.unsynchronized {
+		m.mu.Disable()
+	}
+	return m
+}
+
+// SetRemoveEldest sets the policy that decides whether to evict the eldest entry, the first
+// in iteration order. After every insertion of a new key, including by PutAll, it is called
+// with a copy of the eldest entry and the number of entries in the map, and the entry is
+// removed if it returns true; it is then asked again about the new eldest entry until it
+// returns false or the map is empty. It runs in the same critical section as the insertion,
+// so the map never holds more entries than the policy allows, and it must not use the map.
+// A nil policy never evicts.
+func (lhm *LinkedHashMap[K, V]) SetRemoveEldest(removeEldest func(eldest collections.MapEntry[K, V], size int) bool) {
+	lhm.mu.Lock()
+	defer lhm.mu.Unlock()
+
+	lhm.removeEldest = removeEldest
+}
+
+// FirstEntry returns the first entry in iteration order: the eldest insertion, or in an
+// access-ordered map, the least recently accessed entry. It does not count as an access.
+func (lhm *LinkedHashMap[K, V]) FirstEntry() (collections.MapEntry[K, V], error) {
+	lhm.mu.RLock()
+	defer lhm.mu.RUnlock()
+
+	if lhm.head == nil {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedHashMap", "FirstEntry")
+	}
+	return collections.NewHashMapEntry(lhm.head.key, lhm.head.value), nil
+}
+
+// LastEntry returns the last entry in iteration order: the newest insertion, or in an
+// access-ordered map, the most recently accessed entry. It does not count as an access.
+func (lhm *LinkedHashMap[K, V]) LastEntry() (collections.MapEntry[K, V], error) {
+	lhm.mu.RLock()
+	defer lhm.mu.RUnlock()
+
+	if lhm.tail == nil {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedHashMap", "LastEntry")
+	}
+	return collections.NewHashMapEntry(lhm.tail.key, lhm.tail.value), nil
+}
+
+// PollFirstEntry removes and returns the first entry in iteration order.
+func (lhm *LinkedHashMap[K, V]) PollFirstEntry() (collections.MapEntry[K, V], error) {
+	lhm.mu.Lock()
+	defer lhm.mu.Unlock()
+
+	if lhm.head == nil {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedHashMap", "PollFirstEntry")
+	}
+	eldest := lhm.head
+	lhm.removeNode(eldest)
+	return collections.NewHashMapEntry(eldest.key, eldest.value), nil
+}
+
+// Helper methods. Unless noted otherwise, they assume the write lock is already held.
+
+// insert adds a new entry for key, which must not be present, at the tail, and then
+// evicts the entries that the removeEldest policy asks to.
+func (lhm *LinkedHashMap[K, V]) insert(key K, value V) {
+	newNode := &node[K, V]{
+		key:   key,
+		value: value,
+	}
+	lhm.items[key] = newNode
+	lhm.linkLast(newNode)
+	lhm.evictEldest()
+}
+
+// linkLast links n in at the tail.
+func (lhm *LinkedHashMap[K, V]) linkLast(n *node[K, V]) {
+	n.prev = lhm.tail
+	n.next = nil
+	if lhm.tail == nil {
+		lhm.head = n
+	} else {
+		lhm.tail.next = n
+	}
+	lhm.tail = n
+}
+
+// unlink takes n out of the iteration order. n keeps its own links, so a walk that
+// has reached it can continue.
+func (lhm *LinkedHashMap[K, V]) unlink(n *node[K, V]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		lhm.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		lhm.tail = n.prev
+	}
+}
+
+// removeNode removes the entry of n from the map.
+func (lhm *LinkedHashMap[K, V]) removeNode(n *node[K, V]) {
+	lhm.unlink(n)
+	delete(lhm.items, n.key)
+}
+
+// recordAccess moves n to the tail if the map is access-ordered.
+func (lhm *LinkedHashMap[K, V]) recordAccess(n *node[K, V]) {
+	if lhm.accessOrder && n != lhm.tail {
+		lhm.unlink(n)
+		lhm.linkLast(n)
+	}
+}
+
+// lockForAccess takes the lock that a read needs, which is the write lock if the read
+// reorders an access-ordered map, and returns the function that releases it.
+func (lhm *LinkedHashMap[K, V]) lockForAccess() func() {
+	if lhm.accessOrder {
+		lhm.mu.Lock()
+		return lhm.mu.Unlock
+	}
+	lhm.mu.RLock()
+	return lhm.mu.RUnlock
+}
+
+// evictEldest removes the eldest entry for as long as the removeEldest policy asks to.
+func (lhm *LinkedHashMap[K, V]) evictEldest() {
+	if lhm.removeEldest == nil {
+		return
+	}
+	for lhm.head != nil && lhm.removeEldest(collections.NewHashMapEntry(lhm.head.key, lhm.head.value), len(lhm.items)) {
+		lhm.removeNode(lhm.head)
 	}
 }
 
@@ -56,7 +209,7 @@ func (lhm *LinkedHashMap[K, V]) HasValue(value V) bool {
 	defer lhm.mu.RUnlock()
 
 	for current := lhm.head; current != nil; current = current.next {
-		if current.value == value {
+		if equalValues(lhm.values, current.value, value) {
 			return true
 		}
 	}
@@ -113,7 +266,7 @@ func (lhm *LinkedHashMap[K, V]) Equals(obj any) bool {
 	// Check values without holding our lock
 	for _, entry := range entries {
 		value := mapObj.Get(entry.key)
-		if value == nil || *value != entry.value {
+		if value == nil || !equalValues(lhm.values, *value, entry.value) {
 			return false
 		}
 	}
@@ -121,11 +274,12 @@ func (lhm *LinkedHashMap[K, V]) Equals(obj any) bool {
 }
 
 // Get returns the value to which the specified key is mapped.
+// In an access-ordered map it also moves the entry to the end of the iteration order.
 func (lhm *LinkedHashMap[K, V]) Get(key K) *V {
-	lhm.mu.RLock()
-	defer lhm.mu.RUnlock()
+	defer lhm.lockForAccess()()
 
 	if node, exists := lhm.items[key]; exists {
+		lhm.recordAccess(node)
 		return &node.value
 	}
 	return nil
@@ -162,29 +316,24 @@ func (lhm *LinkedHashMap[K, V]) Put(key K, value V) V {
 	lhm.mu.Lock()
 	defer lhm.mu.Unlock()
 
+	oldValue, _ := lhm.put(key, value)
+	return oldValue
+}
+
+// put associates the value with the key, appending a new entry at the end of the
+// iteration order if the key is not present, and reports whether it did.
+// It assumes the write lock is already held.
+func (lhm *LinkedHashMap[K, V]) put(key K, value V) (V, bool) {
 	if existingNode, exists := lhm.items[key]; exists {
 		oldValue := existingNode.value
 		existingNode.value = value
-		return oldValue
-	}
-
-	newNode := &node[K, V]{
-		key:   key,
-		value: value,
-	}
-	lhm.items[key] = newNode
-
-	if lhm.head == nil {
-		lhm.head = newNode
-		lhm.tail = newNode
-	} else {
-		newNode.prev = lhm.tail
-		lhm.tail.next = newNode
-		lhm.tail = newNode
+		lhm.recordAccess(existingNode)
+		return oldValue, false
 	}
 
+	lhm.insert(key, value)
 	var zero V
-	return zero
+	return zero, true
 }
 
 // PutAll copies all of the mappings from the specified map to this map.
@@ -193,61 +342,17 @@ func (lhm *LinkedHashMap[K, V]) PutAll(m collections.Map[K, V]) {
 		return
 	}
 
-	// If the source map is also a LinkedHashMap, use ForEachEntry to preserve order
-	if sourceLinkedMap, ok := m.(*LinkedHashMap[K, V]); ok {
-		lhm.mu.Lock()
-		defer lhm.mu.Unlock()
-
-		sourceLinkedMap.ForEachEntry(func(key K, value V) {
-			if existingNode, exists := lhm.items[key]; exists {
-				existingNode.value = value
-			} else {
-				newNode := &node[K, V]{
-					key:   key,
-					value: value,
-				}
-				lhm.items[key] = newNode
-				if lhm.head == nil {
-					lhm.head = newNode
-					lhm.tail = newNode
-				} else {
-					newNode.prev = lhm.tail
-					lhm.tail.next = newNode
-					lhm.tail = newNode
-				}
-			}
-		})
-		return
+	// Get entries before acquiring lock. All is in iteration order for a LinkedHashMap.
+	var pairs []pair[K, V]
+	for key, value := range m.All() {
+		pairs = append(pairs, pair[K, V]{key: key, value: value})
 	}
 
-	// For other map types, use the original approach
-	// Get entries before acquiring lock
-	entries := m.EntrySet().ToArray()
-
 	lhm.mu.Lock()
 	defer lhm.mu.Unlock()
 
-	for _, entry := range entries {
-		key := entry.GetKey()
-		value := entry.GetValue()
-
-		if existingNode, exists := lhm.items[key]; exists {
-			existingNode.value = value
-		} else {
-			newNode := &node[K, V]{
-				key:   key,
-				value: value,
-			}
-			lhm.items[key] = newNode
-			if lhm.head == nil {
-				lhm.head = newNode
-				lhm.tail = newNode
-			} else {
-				newNode.prev = lhm.tail
-				lhm.tail.next = newNode
-				lhm.tail = newNode
-			}
-		}
+	for _, p := range pairs {
+		lhm.put(p.key, p.value)
 	}
 }
 
@@ -257,24 +362,10 @@ func (lhm *LinkedHashMap[K, V]) PutIfAbsent(key K, value V) V {
 	defer lhm.mu.Unlock()
 
 	if existingNode, exists := lhm.items[key]; exists {
+		lhm.recordAccess(existingNode)
 		return existingNode.value
 	}
-
-	newNode := &node[K, V]{
-		key:   key,
-		value: value,
-	}
-	lhm.items[key] = newNode
-
-	if lhm.head == nil {
-		lhm.head = newNode
-		lhm.tail = newNode
-	} else {
-		newNode.prev = lhm.tail
-		lhm.tail.next = newNode
-		lhm.tail = newNode
-	}
-
+	lhm.insert(key, value)
 	var zero V
 	return zero
 }
@@ -285,17 +376,7 @@ func (lhm *LinkedHashMap[K, V]) Remove(key K) V {
 	defer lhm.mu.Unlock()
 
 	if existingNode, exists := lhm.items[key]; exists {
-		if existingNode.prev != nil {
-			existingNode.prev.next = existingNode.next
-		} else {
-			lhm.head = existingNode.next
-		}
-		if existingNode.next != nil {
-			existingNode.next.prev = existingNode.prev
-		} else {
-			lhm.tail = existingNode.prev
-		}
-		delete(lhm.items, key)
+		lhm.removeNode(existingNode)
 		return existingNode.value
 	}
 
@@ -308,18 +389,8 @@ func (lhm *LinkedHashMap[K, V]) RemoveKeyWithValue(key K, value V) bool {
 	lhm.mu.Lock()
 	defer lhm.mu.Unlock()
 
-	if existingNode, exists := lhm.items[key]; exists && existingNode.value == value {
-		if existingNode.prev != nil {
-			existingNode.prev.next = existingNode.next
-		} else {
-			lhm.head = existingNode.next
-		}
-		if existingNode.next != nil {
-			existingNode.next.prev = existingNode.prev
-		} else {
-			lhm.tail = existingNode.prev
-		}
-		delete(lhm.items, key)
+	if existingNode, exists := lhm.items[key]; exists && equalValues(lhm.values, existingNode.value, value) {
+		lhm.removeNode(existingNode)
 		return true
 	}
 	return false
@@ -333,6 +404,7 @@ func (lhm *LinkedHashMap[K, V]) Replace(key K, value V) V {
 	if existingNode, exists := lhm.items[key]; exists {
 		oldValue := existingNode.value
 		existingNode.value = value
+		lhm.recordAccess(existingNode)
 		return oldValue
 	}
 
@@ -345,8 +417,9 @@ func (lhm *LinkedHashMap[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue
 	lhm.mu.Lock()
 	defer lhm.mu.Unlock()
 
-	if existingNode, exists := lhm.items[key]; exists && existingNode.value == oldValue {
+	if existingNode, exists := lhm.items[key]; exists && equalValues(lhm.values, existingNode.value, oldValue) {
 		existingNode.value = newValue
+		lhm.recordAccess(existingNode)
 		return true
 	}
 	return false
@@ -370,20 +443,72 @@ func (lhm *LinkedHashMap[K, V]) Values() collections.Collection[V] {
 	}
 	lhm.mu.RUnlock()
 
-	// Create set without holding lock
-	set := sets.NewHashSet[V]()
-	if hashSet, ok := set.(*sets.HashSet[V]); ok {
-		hashSet.AddAllBatch(values)
+	return valueList(values, lhm.values)
+}
+
+// All returns a sequence over the entries in this map in iteration order.
+// The entries are fixed when iteration starts and the read lock is released while the loop
+// body runs, so the body may modify the map. An entry removed before it is reached is
+// skipped, and an entry added during the loop is not yielded. In an access-ordered map,
+// accessing entries during the loop does not change the order in which they are yielded.
+func (lhm *LinkedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		lhm.walk(lhm.nodes(), yield)
 	}
-	return set
 }
 
-// GetOrDefault returns the value to which the specified key is mapped, or defaultValue if this map contains no mapping for the key.
-func (lhm *LinkedHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+// Backward returns a sequence over the entries in this map in reverse iteration order.
+// Like All, it may be used while the loop body modifies the map.
+func (lhm *LinkedHashMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		nodes := lhm.nodes()
+		slices.Reverse(nodes)
+		lhm.walk(nodes, yield)
+	}
+}
+
+// Keys returns a sequence over the keys in this map in iteration order.
+func (lhm *LinkedHashMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(lhm.All())
+}
+
+// AllValues returns a sequence over the values in this map in iteration order.
+func (lhm *LinkedHashMap[K, V]) AllValues() iter.Seq[V] {
+	return valuesOf(lhm.All())
+}
+
+// nodes returns the nodes of this map in iteration order under the read lock.
+func (lhm *LinkedHashMap[K, V]) nodes() []*node[K, V] {
 	lhm.mu.RLock()
 	defer lhm.mu.RUnlock()
 
+	nodes := make([]*node[K, V], 0, len(lhm.items))
+	for current := lhm.head; current != nil; current = current.next {
+		nodes = append(nodes, current)
+	}
+	return nodes
+}
+
+// walk yields the entries of nodes that are still in the map when they are reached,
+// reading each one under the read lock.
+func (lhm *LinkedHashMap[K, V]) walk(nodes []*node[K, V], yield func(K, V) bool) {
+	for _, n := range nodes {
+		lhm.mu.RLock()
+		linked := lhm.items[n.key] == n
+		value := n.value
+		lhm.mu.RUnlock()
+		if linked && !yield(n.key, value) {
+			return
+		}
+	}
+}
+
+// GetOrDefault returns the value to which the specified key is mapped, or defaultValue if this map contains no mapping for the key.
+func (lhm *LinkedHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	defer lhm.lockForAccess()()
+
 	if existingNode, exists := lhm.items[key]; exists {
+		lhm.recordAccess(existingNode)
 		return existingNode.value
 	}
 	return defaultValue
@@ -415,31 +540,97 @@ func (lhm *LinkedHashMap[K, V]) ForEachEntry(action func(key K, value V)) {
 func (lhm *LinkedHashMap[K, V]) ComputeIfAbsent(key K, mappingFunction func(K) V) (V, error) {
 	if mappingFunction == nil {
 		var zero V
-		return zero, errors.New("mapping function cannot be nil")
+		return zero, errcodes.New(errcodes.NullPointerError, "LinkedHashMap", "ComputeIfAbsent")
 	}
 
 	lhm.mu.Lock()
 	defer lhm.mu.Unlock()
 
 	if existingNode, exists := lhm.items[key]; exists {
+		lhm.recordAccess(existingNode)
 		return existingNode.value, nil
 	}
-
 	value := mappingFunction(key)
-	newNode := &node[K, V]{
-		key:   key,
-		value: value,
+	lhm.insert(key, value)
+	return value, nil
+}
+
+// Compute atomically computes a new mapping for the key from its current value.
+// The function receives nil if the key is absent. If it returns false, the mapping is
+// removed (or not created) and Compute returns nil.
+// The function runs while the map is locked, so it must not use this map.
+func (lhm *LinkedHashMap[K, V]) Compute(key K, remappingFunction func(K, *V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "LinkedHashMap", "Compute")
 	}
-	lhm.items[key] = newNode
 
-	if lhm.head == nil {
-		lhm.head = newNode
-		lhm.tail = newNode
-	} else {
-		newNode.prev = lhm.tail
-		lhm.tail.next = newNode
-		lhm.tail = newNode
+	lhm.mu.Lock()
+	defer lhm.mu.Unlock()
+
+	node := lhm.items[key]
+	var current *V
+	if node != nil {
+		value := node.value
+		current = &value
 	}
+	value, keep := remappingFunction(key, current)
+	return lhm.store(key, node, value, keep), nil
+}
 
-	return value, nil
+// ComputeIfPresent atomically computes a new mapping for the key if it is present.
+// If the function returns false, the mapping is removed and nil is returned.
+// The function runs while the map is locked, so it must not use this map.
+func (lhm *LinkedHashMap[K, V]) ComputeIfPresent(key K, remappingFunction func(K, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "LinkedHashMap", "ComputeIfPresent")
+	}
+
+	lhm.mu.Lock()
+	defer lhm.mu.Unlock()
+
+	node, exists := lhm.items[key]
+	if !exists {
+		return nil, nil
+	}
+	value, keep := remappingFunction(key, node.value)
+	return lhm.store(key, node, value, keep), nil
+}
+
+// Merge atomically associates the key with value if it is absent, or otherwise with the
+// result of combining the current value and value. If the function returns false, the
+// mapping is removed and nil is returned.
+// The function runs while the map is locked, so it must not use this map.
+func (lhm *LinkedHashMap[K, V]) Merge(key K, value V, remappingFunction func(V, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "LinkedHashMap", "Merge")
+	}
+
+	lhm.mu.Lock()
+	defer lhm.mu.Unlock()
+
+	node, exists := lhm.items[key]
+	if !exists {
+		return lhm.store(key, nil, value, true), nil
+	}
+	merged, keep := remappingFunction(node.value, value)
+	return lhm.store(key, node, merged, keep), nil
+}
+
+// store applies the result of a remapping function to existing, the node of key or nil if
+// the key is absent, and returns a pointer to the stored value, or nil if the mapping was
+// removed. It assumes the write lock is already held.
+func (lhm *LinkedHashMap[K, V]) store(key K, existing *node[K, V], value V, keep bool) *V {
+	switch {
+	case !keep:
+		if existing != nil {
+			lhm.removeNode(existing)
+		}
+		return nil
+	case existing != nil:
+		existing.value = value
+		lhm.recordAccess(existing)
+	default:
+		lhm.insert(key, value)
+	}
+	return &value
 }
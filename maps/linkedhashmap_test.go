@@ -2,10 +2,13 @@ package maps
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -615,7 +618,7 @@ func TestLinkedHashMap_ComputeIfAbsent(t *testing.T) {
 	// Test ComputeIfAbsent with nil mapping function
 	value, err := linkedMap.ComputeIfAbsent("key", nil)
 	assert.Error(t, err)
-	assert.Equal(t, "mapping function cannot be nil", err.Error())
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
 	assert.Equal(t, 0, value)
 	assert.Equal(t, 0, lhm.Size())
 
@@ -794,3 +797,406 @@ func TestLinkedHashMap_InsertionOrder(t *testing.T) {
 	assert.Equal(t, 4, foundEntries["two"])
 	assert.Equal(t, 3, foundEntries["three"])
 }
+
+func TestLinkedHashMap_All(t *testing.T) {
+	lhm := NewLinkedHashMap[string, int]().(*LinkedHashMap[string, int])
+	lhm.Put("c", 3)
+	lhm.Put("a", 1)
+	lhm.Put("b", 2)
+
+	var keys []string
+	var values []int
+	for k, v := range lhm.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	assert.Equal(t, []string{"c", "a", "b"}, keys)
+	assert.Equal(t, []int{3, 1, 2}, values)
+	assert.Equal(t, []string{"c", "a", "b"}, slices.Collect(lhm.Keys()))
+	assert.Equal(t, []int{3, 1, 2}, slices.Collect(lhm.AllValues()))
+
+	keys = nil
+	for k := range lhm.Backward() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"b", "a", "c"}, keys)
+
+	// Removing the current entry from the loop body does not end the iteration
+	keys = nil
+	for k := range lhm.Keys() {
+		lhm.Remove(k)
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"c", "a", "b"}, keys)
+	assert.True(t, lhm.IsEmpty())
+}
+
+func TestLinkedHashMap_AllModifiedInLoop(t *testing.T) {
+	lhm := NewLinkedHashMap[string, int]().(*LinkedHashMap[string, int])
+	for i, k := range []string{"A", "B", "C", "D"} {
+		lhm.Put(k, i)
+	}
+
+	// Entries removed before they are reached are skipped, new ones are not yielded
+	var keys []string
+	for k := range lhm.Keys() {
+		if k == "A" {
+			lhm.Remove("B")
+			lhm.Put("E", 4)
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"A", "C", "D"}, keys)
+
+	// A key removed and put back is a new entry
+	keys = nil
+	for k := range lhm.Backward() {
+		if k == "E" {
+			lhm.Remove("C")
+			lhm.Put("C", 2)
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"E", "D", "A"}, keys)
+
+	// Values are read when the entry is reached
+	var values []int
+	for _, v := range lhm.All() {
+		lhm.Put("E", 40)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{0, 3, 40, 2}, values)
+}
+
+func TestNewLinkedHashMapWithValueEquality(t *testing.T) {
+	lhm := NewLinkedHashMapWithValueEquality[string, []int](nil)
+	lhm.Put("a", []int{1, 2})
+	lhm.Put("b", []int{3})
+
+	assert.True(t, lhm.HasValue([]int{1, 2}))
+	assert.False(t, lhm.HasValue([]int{2, 1}))
+	assert.True(t, lhm.ReplaceKeyWithValue("b", []int{3}, []int{4}))
+	assert.Equal(t, [][]int{{1, 2}, {4}}, lhm.Values().ToArray())
+	assert.True(t, lhm.RemoveKeyWithValue("a", []int{1, 2}))
+	assert.Equal(t, []string{"b"}, slices.Collect(lhm.Keys()))
+
+	handlers := NewLinkedHashMapWithValueEquality[string, func() int](nil)
+	handlers.Put("one", func() int { return 1 })
+	assert.Equal(t, 1, (*handlers.Get("one"))())
+}
+
+func TestNewLinkedHashMap_Options(t *testing.T) {
+	lhm := NewLinkedHashMap[string, int](WithCapacity(64), Unsynchronized()).(*LinkedHashMap[string, int])
+	assert.True(t, lhm.mu.Disabled())
+	assert.False(t, lhm.accessOrder)
+	lhm.Put("a", 1)
+	lhm.Put("b", 2)
+	lhm.Get("a")
+	assert.Equal(t, []string{"a", "b"}, slices.Collect(lhm.Keys()))
+
+	assert.False(t, NewLinkedHashMap[string, int]().(*LinkedHashMap[string, int]).mu.Disabled())
+}
+
+func TestLinkedHashMap_WithAccessOrder(t *testing.T) {
+	lhm := NewLinkedHashMap[string, int](WithAccessOrder()).(*LinkedHashMap[string, int])
+	lhm.Put("a", 1)
+	lhm.Put("b", 2)
+	lhm.Put("c", 3)
+	lhm.Put("d", 4)
+
+	lhm.Get("a")
+	lhm.Put("b", 20)
+	lhm.GetOrDefault("missing", 0)
+	assert.Equal(t, []string{"c", "d", "a", "b"}, slices.Collect(lhm.Keys()))
+
+	lhm.Replace("c", 30)
+	lhm.PutIfAbsent("d", 40)
+	_, _ = lhm.ComputeIfAbsent("a", func(string) int { return 10 })
+	assert.Equal(t, []string{"b", "c", "d", "a"}, slices.Collect(lhm.Keys()))
+	assert.Equal(t, 4, *lhm.Get("d"))
+
+	// Reads that are not accesses leave the order alone
+	lhm.HasKey("b")
+	lhm.HasValue(20)
+	for range lhm.All() {
+	}
+	assert.Equal(t, []string{"b", "c", "a", "d"}, slices.Collect(lhm.Keys()))
+
+	// Accessing the tail is a no-op
+	lhm.Get("d")
+	lhm.Get("d")
+	assert.Equal(t, []string{"b", "c", "a", "d"}, slices.Collect(lhm.Keys()))
+	assert.Equal(t, []string{"d", "a", "c", "b"}, slices.Collect(keysOf(lhm.Backward())))
+
+	// An insertion-ordered map does not reorder on access
+	ordered := NewLinkedHashMap[string, int]()
+	ordered.Put("a", 1)
+	ordered.Put("b", 2)
+	ordered.Get("a")
+	ordered.Put("a", 10)
+	assert.Equal(t, []string{"a", "b"}, slices.Collect(ordered.Keys()))
+}
+
+func TestLinkedHashMap_AccessDuringIteration(t *testing.T) {
+	lhm := NewLinkedHashMap[string, int](WithAccessOrder()).(*LinkedHashMap[string, int])
+	for i, k := range []string{"A", "B", "C", "D"} {
+		lhm.Put(k, i)
+	}
+
+	var keys []string
+	for k := range lhm.Keys() {
+		if k == "A" {
+			lhm.Get("B")
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"A", "B", "C", "D"}, keys)
+	assert.Equal(t, []string{"A", "C", "D", "B"}, slices.Collect(lhm.Keys()))
+
+	// Accessing the current entry moves it to the tail without ending the loop
+	keys = nil
+	for k, v := range lhm.All() {
+		assert.Equal(t, v, *lhm.Get(k))
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"A", "C", "D", "B"}, keys)
+	assert.Equal(t, []string{"A", "C", "D", "B"}, slices.Collect(lhm.Keys()))
+
+	keys = nil
+	for k := range lhm.Backward() {
+		lhm.Get("C")
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"B", "D", "C", "A"}, keys)
+	assert.Equal(t, []string{"A", "D", "B", "C"}, slices.Collect(lhm.Keys()))
+}
+
+func TestLinkedHashMap_RemoveEldest(t *testing.T) {
+	cache := NewLinkedHashMap[string, int](WithAccessOrder()).(*LinkedHashMap[string, int])
+	var evicted []string
+	cache.SetRemoveEldest(func(eldest collections.MapEntry[string, int], size int) bool {
+		if size <= 3 {
+			return false
+		}
+		evicted = append(evicted, eldest.GetKey())
+		return true
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Put("d", 4)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, []string{"c", "a", "d"}, slices.Collect(cache.Keys()))
+
+	// Updating an existing key is not an insertion
+	cache.Put("c", 30)
+	assert.Equal(t, []string{"b"}, evicted)
+
+	cache.PutIfAbsent("e", 5)
+	_, _ = cache.ComputeIfAbsent("f", func(string) int { return 6 })
+	assert.Equal(t, []string{"b", "a", "d"}, evicted)
+
+	other := NewLinkedHashMap[string, int]()
+	other.Put("g", 7)
+	other.Put("h", 8)
+	cache.PutAll(other)
+	assert.Equal(t, []string{"b", "a", "d", "c", "e"}, evicted)
+	assert.Equal(t, []string{"f", "g", "h"}, slices.Collect(cache.Keys()))
+
+	// Removing the policy stops eviction
+	cache.SetRemoveEldest(nil)
+	cache.Put("i", 9)
+	assert.Equal(t, 4, cache.Size())
+}
+
+func TestLinkedHashMap_FirstAndLastEntry(t *testing.T) {
+	lhm := NewLinkedHashMap[string, int]().(*LinkedHashMap[string, int])
+
+	_, err := lhm.FirstEntry()
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+	_, err = lhm.LastEntry()
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+	_, err = lhm.PollFirstEntry()
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+
+	lhm.Put("a", 1)
+	lhm.Put("b", 2)
+	lhm.Put("c", 3)
+
+	first, err := lhm.FirstEntry()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", first.GetKey())
+	assert.Equal(t, 1, first.GetValue())
+	last, err := lhm.LastEntry()
+	assert.NoError(t, err)
+	assert.Equal(t, "c", last.GetKey())
+	assert.Equal(t, 3, last.GetValue())
+
+	polled, err := lhm.PollFirstEntry()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", polled.GetKey())
+	assert.False(t, lhm.HasKey("a"))
+	assert.Equal(t, []string{"b", "c"}, slices.Collect(lhm.Keys()))
+
+	lhm.PollFirstEntry()
+	lhm.PollFirstEntry()
+	assert.True(t, lhm.IsEmpty())
+	_, err = lhm.LastEntry()
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+
+	// FirstEntry does not count as an access
+	access := NewLinkedHashMap[string, int](WithAccessOrder()).(*LinkedHashMap[string, int])
+	access.Put("x", 1)
+	access.Put("y", 2)
+	access.FirstEntry()
+	first, _ = access.FirstEntry()
+	assert.Equal(t, "x", first.GetKey())
+}
+
+func TestLinkedHashMap_AccessOrderConcurrent(t *testing.T) {
+	cache := NewLinkedHashMap[int, int](WithAccessOrder()).(*LinkedHashMap[int, int])
+	cache.SetRemoveEldest(func(eldest collections.MapEntry[int, int], size int) bool {
+		return size > 50
+	})
+
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 500 {
+				cache.Put(g*1000+i%100, i)
+				cache.Get(g*1000 + i%7)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, cache.Size(), 50)
+	assert.Equal(t, cache.Size(), len(slices.Collect(cache.Keys())))
+}
+
+func TestLinkedHashMap_RemoveEldestConcurrent(t *testing.T) {
+	cache := NewLinkedHashMap[int, int](WithAccessOrder()).(*LinkedHashMap[int, int])
+	cache.SetRemoveEldest(func(eldest collections.MapEntry[int, int], size int) bool {
+		return size > 100
+	})
+
+	done := make(chan struct{})
+	var maxSize int
+	go func() {
+		defer close(done)
+		for range 2000 {
+			maxSize = max(maxSize, cache.Size())
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				cache.Put(g*1000+i, i)
+				cache.Put(g*1000+i/2, -i) // Updates the values the policy reads
+				_, _ = cache.Merge(g*1000+i/3, 1, func(old, v int) (int, bool) { return old + v, true })
+			}
+		}()
+	}
+	wg.Wait()
+	<-done
+
+	assert.LessOrEqual(t, maxSize, 100)
+	assert.Equal(t, 100, cache.Size())
+	assert.Equal(t, 100, len(slices.Collect(cache.Keys())))
+}
+
+func TestLinkedHashMap_ComputeAndMerge(t *testing.T) {
+	lhm := NewLinkedHashMap[string, int]().(*LinkedHashMap[string, int])
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	lhm.Put("a", 1)
+	lhm.Put("b", 2)
+	value, err := lhm.Merge("c", 3, sum)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *value)
+	value, err = lhm.Merge("a", 10, sum)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, *value)
+	value, err = lhm.Compute("b", func(k string, v *int) (int, bool) { return *v * 10, true })
+	assert.NoError(t, err)
+	assert.Equal(t, 20, *value)
+	value, err = lhm.ComputeIfPresent("z", func(k string, v int) (int, bool) { return v, true })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	// Updates keep insertion order, removals unlink the entry
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(lhm.Keys()))
+	value, err = lhm.ComputeIfPresent("b", func(k string, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = lhm.Compute("d", func(k string, v *int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.Equal(t, []string{"a", "c"}, slices.Collect(lhm.Keys()))
+
+	_, err = lhm.Compute("a", nil)
+	assert.Error(t, err)
+	_, err = lhm.ComputeIfPresent("a", nil)
+	assert.Error(t, err)
+	_, err = lhm.Merge("a", 1, nil)
+	assert.Error(t, err)
+}
+
+func TestLinkedHashMap_ComputeAccessOrder(t *testing.T) {
+	cache := NewLinkedHashMap[string, int](WithAccessOrder()).(*LinkedHashMap[string, int])
+	var evicted []string
+	cache.SetRemoveEldest(func(eldest collections.MapEntry[string, int], size int) bool {
+		if size <= 2 {
+			return false
+		}
+		evicted = append(evicted, eldest.GetKey())
+		return true
+	})
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	_, _ = cache.Merge("a", 1, sum)
+	assert.Equal(t, []string{"b", "a"}, slices.Collect(cache.Keys()))
+	_, _ = cache.ComputeIfPresent("b", func(k string, v int) (int, bool) { return v, true })
+	assert.Equal(t, []string{"a", "b"}, slices.Collect(cache.Keys()))
+	assert.Empty(t, evicted)
+
+	// Inserting through Compute or Merge runs the eviction policy
+	_, _ = cache.Compute("c", func(k string, v *int) (int, bool) { return 3, true })
+	assert.Equal(t, []string{"a"}, evicted)
+	_, _ = cache.Merge("d", 4, sum)
+	assert.Equal(t, []string{"a", "b"}, evicted)
+	assert.Equal(t, []string{"c", "d"}, slices.Collect(cache.Keys()))
+	assert.Nil(t, cache.Get("a"))
+}
+
+func TestLinkedHashMap_WithAccessOrderValueEquality(t *testing.T) {
+	cache := NewLinkedHashMapWithValueEquality[string, []byte](nil, WithAccessOrder()).(*LinkedHashMap[string, []byte])
+	cache.SetRemoveEldest(func(eldest collections.MapEntry[string, []byte], size int) bool {
+		return size > 2
+	})
+	cache.Put("a", []byte("x"))
+	cache.Put("b", []byte("y"))
+	cache.Get("a")
+	cache.Put("c", []byte("z"))
+
+	assert.Equal(t, []string{"a", "c"}, slices.Collect(cache.Keys()))
+	assert.True(t, cache.HasValue([]byte("x")))
+	assert.False(t, cache.HasValue([]byte("y")))
+	assert.True(t, cache.ReplaceKeyWithValue("a", []byte("x"), []byte("w")))
+	assert.Equal(t, []string{"c", "a"}, slices.Collect(cache.Keys()))
+	assert.True(t, cache.RemoveKeyWithValue("c", []byte("z")))
+
+	folded := NewLinkedHashMapWithValueEquality[int, string](collections.EqualerFunc[string](strings.EqualFold), WithAccessOrder()).(*LinkedHashMap[int, string])
+	folded.Put(1, "Go")
+	assert.True(t, folded.HasValue("GO"))
+}
@@ -0,0 +1,56 @@
+package maps
+
+// Option configures a HashMap or LinkedHashMap when it is created.
+type Option func(*config)
+
+// config holds the settings chosen by the options of a constructor.
+type config struct {
+	capacity       int
+	unsynchronized bool
+	accessOrder    bool
+}
+
+// WithCapacity sizes the map for about capacity entries. A capacity of zero or less
+// selects DefaultCapacity.
+func WithCapacity(capacity int) Option {
+	return func(c *config) {
+		c.capacity = capacity
+	}
+}
+
+// Unsynchronized makes the map take no locks. It is faster when the map is only used by
+// one goroutine, and must not be used by several at once.
+func Unsynchronized() Option {
+	return func(c *config) {
+		c.unsynchronized = true
+	}
+}
+
+// WithAccessOrder makes a LinkedHashMap iterate over its entries in the order in which
+// they were last accessed, from least to most recently. Get, GetOrDefault, and the methods
+// that put, replace or compute the value of a key all count as an access and move the
+// entry to the tail. Iterating over the map does not. It has no effect on a HashMap.
+//
+// Together with SetRemoveEldest this makes a bounded LRU cache:
+//
+//	cache := maps.NewLinkedHashMapWithValueEquality[string, []byte](nil, maps.WithAccessOrder()).(*maps.LinkedHashMap[string, []byte])
+//	cache.SetRemoveEldest(func(eldest collections.MapEntry[string, []byte], size int) bool {
+//		return size > 100
+//	})
+func WithAccessOrder() Option {
+	return func(c *config) {
+		c.accessOrder = true
+	}
+}
+
+// newConfig applies options to the defaults.
+func newConfig(options []Option) config {
+	c := config{capacity: DefaultCapacity}
+	for _, option := range options {
+		option(&c)
+	}
+	if c.capacity <= 0 {
+		c.capacity = DefaultCapacity
+	}
+	return c
+}
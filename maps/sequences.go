@@ -0,0 +1,52 @@
+package maps
+
+import "iter"
+
+// pair is a key-value pair copied out of a map so that it can be yielded without holding the map's lock.
+type pair[K any, V any] struct {
+	key   K
+	value V
+}
+
+// snapshotPairs copies the entries of m into a slice.
+// It assumes the caller holds the lock protecting m.
+func snapshotPairs[K comparable, V any](m map[K]V) []pair[K, V] {
+	pairs := make([]pair[K, V], 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, pair[K, V]{key: k, value: v})
+	}
+	return pairs
+}
+
+// pairsSeq returns a sequence that yields the pairs returned by snapshot, which is called once per iteration.
+func pairsSeq[K any, V any](snapshot func() []pair[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, p := range snapshot() {
+			if !yield(p.key, p.value) {
+				return
+			}
+		}
+	}
+}
+
+// keysOf returns a sequence over the keys of seq.
+func keysOf[K any, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// valuesOf returns a sequence over the values of seq.
+func valuesOf[K any, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -1,12 +1,12 @@
 package maps
 
 import (
-	"errors"
 	"fmt"
+	"iter"
 	"sync"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
-	"github.com/chiranjeevipavurala/gocollections/sets"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 )
 
 // Color represents the color of a node in the Red-Black tree
@@ -18,7 +18,7 @@ const (
 )
 
 // Node represents a node in the Red-Black tree
-type Node[K comparable, V comparable] struct {
+type Node[K comparable, V any] struct {
 	key    K
 	value  V
 	color  Color
@@ -28,20 +28,21 @@ type Node[K comparable, V comparable] struct {
 }
 
 // TreeMap implements a Red-Black tree based map
-type TreeMap[K comparable, V comparable] struct {
+type TreeMap[K comparable, V any] struct {
 	root       *Node[K, V]
 	size       int
+	modCount   int // Number of structural modifications, for fail-fast iteration
 	comparator collections.Comparator[K]
+	values     collections.Equaler[V] // Compares values; nil in the zero map
 	mu         sync.RWMutex
 }
 
-// SortedMap interface for sorted operations
-type SortedMap[K comparable, V comparable] interface {
-	collections.Map[K, V]
-	FirstKey() (*K, error)
-	LastKey() (*K, error)
-	LowerKey(key K) (*K, error)
-	HigherKey(key K) (*K, error)
+var _ collections.ConcurrentMap[string, int] = (*TreeMap[string, int])(nil)
+
+// SortedMap is the navigable map returned by NewTreeMap.
+// It is a collections.NavigableMap restricted to comparable keys.
+type SortedMap[K comparable, V any] interface {
+	collections.NavigableMap[K, V]
 }
 
 // NewTreeMap creates a new TreeMap with the given comparator
@@ -51,6 +52,20 @@ func NewTreeMap[K comparable, V comparable](comparator collections.Comparator[K]
 	}
 	return &TreeMap[K, V]{
 		comparator: comparator,
+		values:     comparableValues[V]{},
+	}
+}
+
+// NewTreeMapWithValueEquality creates a new TreeMap whose values may be of any type.
+// Values are compared with equaler, or, if it is nil, with == where possible and
+// reflect.DeepEqual otherwise. It returns nil if comparator is nil.
+func NewTreeMapWithValueEquality[K comparable, V any](comparator collections.Comparator[K], equaler collections.Equaler[V]) SortedMap[K, V] {
+	if comparator == nil {
+		return nil
+	}
+	return &TreeMap[K, V]{
+		comparator: comparator,
+		values:     valueEqualer(equaler),
 	}
 }
 
@@ -60,6 +75,7 @@ func (t *TreeMap[K, V]) Clear() {
 	defer t.mu.Unlock()
 	t.root = nil
 	t.size = 0
+	t.modCount++
 }
 
 // ContainsKey returns true if the map contains the given key
@@ -81,7 +97,7 @@ func (t *TreeMap[K, V]) containsValue(node *Node[K, V], value V) bool {
 	if node == nil {
 		return false
 	}
-	if node.value == value {
+	if equalValues(t.values, node.value, value) {
 		return true
 	}
 	return t.containsValue(node.left, value) || t.containsValue(node.right, value)
@@ -136,13 +152,10 @@ func (t *TreeMap[K, V]) verifyBlackHeight(node *Node[K, V], count int, blackCoun
 	return t.verifyBlackHeight(node.left, count, blackCount) && t.verifyBlackHeight(node.right, count, blackCount)
 }
 
-// EntrySet returns a set of all entries in the map
+// EntrySet returns a set view of the entries in the map, ordered by key.
+// The view reflects later changes to the map, and removing an entry from it removes the mapping.
 func (t *TreeMap[K, V]) EntrySet() collections.Set[collections.MapEntry[K, V]] {
-	t.mu.RLock()
-	defer t.mu.RUnlock()
-	entries := sets.NewHashSet[collections.MapEntry[K, V]]()
-	t.collectEntries(t.root, entries)
-	return entries
+	return t.view().EntrySet()
 }
 
 // Get returns the value associated with the given key
@@ -163,13 +176,10 @@ func (t *TreeMap[K, V]) IsEmpty() bool {
 	return t.size == 0
 }
 
-// KeySet returns a set of all keys in the map
+// KeySet returns a set view of the keys in the map, in ascending order.
+// The view reflects later changes to the map, and removing a key from it removes the mapping.
 func (t *TreeMap[K, V]) KeySet() collections.Set[K] {
-	t.mu.RLock()
-	defer t.mu.RUnlock()
-	keys := sets.NewHashSet[K]()
-	t.collectKeys(t.root, keys)
-	return keys
+	return t.view().KeySet()
 }
 
 // PutAll copies all of the mappings from the specified map to this map
@@ -185,55 +195,7 @@ func (t *TreeMap[K, V]) PutAll(m collections.Map[K, V]) {
 	defer t.mu.Unlock()
 
 	for _, entry := range entries {
-		key := entry.GetKey()
-		value := entry.GetValue()
-
-		// Check if key exists first
-		node := t.getNode(key)
-		if node != nil {
-			node.value = value
-			continue
-		}
-
-		// Create new node
-		newNode := &Node[K, V]{
-			key:   key,
-			value: value,
-			color: Red,
-		}
-
-		// Insert the node
-		if t.root == nil {
-			t.root = newNode
-			t.root.color = Black
-			t.size++
-			continue
-		}
-
-		// Find insertion point
-		current := t.root
-		var parent *Node[K, V]
-		for current != nil {
-			parent = current
-			cmp := t.comparator.Compare(key, current.key)
-			if cmp < 0 {
-				current = current.left
-			} else {
-				current = current.right
-			}
-		}
-
-		// Insert the node
-		newNode.parent = parent
-		if t.comparator.Compare(key, parent.key) < 0 {
-			parent.left = newNode
-		} else {
-			parent.right = newNode
-		}
-
-		// Fix the tree
-		t.fixInsert(newNode)
-		t.size++
+		t.put(entry.GetKey(), entry.GetValue())
 	}
 }
 
@@ -241,7 +203,12 @@ func (t *TreeMap[K, V]) PutAll(m collections.Map[K, V]) {
 func (t *TreeMap[K, V]) Put(key K, value V) V {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	return t.put(key, value)
+}
 
+// put associates the value with the key, replacing any existing value.
+// It assumes the write lock is already held.
+func (t *TreeMap[K, V]) put(key K, value V) V {
 	// Check if key exists first
 	node := t.getNode(key)
 	if node != nil {
@@ -250,20 +217,28 @@ func (t *TreeMap[K, V]) Put(key K, value V) V {
 		return oldValue
 	}
 
+	t.insert(key, value)
+	var zero V
+	return zero
+}
+
+// insert adds a new node for a key that is not yet present in the tree.
+// It assumes the write lock is already held.
+func (t *TreeMap[K, V]) insert(key K, value V) {
 	// Create new node
 	newNode := &Node[K, V]{
 		key:   key,
 		value: value,
 		color: Red,
 	}
+	t.size++
+	t.modCount++
 
 	// Insert the node
 	if t.root == nil {
 		t.root = newNode
 		t.root.color = Black
-		t.size++
-		var zero V
-		return zero
+		return
 	}
 
 	// Find insertion point
@@ -289,10 +264,49 @@ func (t *TreeMap[K, V]) Put(key K, value V) V {
 
 	// Fix the tree
 	t.fixInsert(newNode)
-	t.size++
+}
 
-	var zero V
-	return zero
+// buildFromSorted replaces the contents of the map with the given keys and values. The keys
+// must be strictly increasing according to the comparator. It builds a balanced tree in
+// linear time: every level is black except the deepest, incomplete one, which is red.
+// It assumes the write lock is already held.
+func (t *TreeMap[K, V]) buildFromSorted(keys []K, values []V) {
+	redLevel := 0
+	for m := len(keys) - 1; m >= 0; m = m/2 - 1 {
+		redLevel++
+	}
+	var build func(level, lo, hi int) *Node[K, V]
+	build = func(level, lo, hi int) *Node[K, V] {
+		if lo > hi {
+			return nil
+		}
+		mid := int(uint(lo+hi) >> 1)
+		node := &Node[K, V]{key: keys[mid], value: values[mid], color: Black}
+		if level == redLevel {
+			node.color = Red
+		}
+		if node.left = build(level+1, lo, mid-1); node.left != nil {
+			node.left.parent = node
+		}
+		if node.right = build(level+1, mid+1, hi); node.right != nil {
+			node.right.parent = node
+		}
+		return node
+	}
+	t.root = build(0, 0, len(keys)-1)
+	t.size = len(keys)
+	t.modCount++
+}
+
+// removeNode unlinks the node from the tree and updates the bookkeeping.
+// It assumes the write lock is already held.
+func (t *TreeMap[K, V]) removeNode(node *Node[K, V]) {
+	t.deleteNode(node)
+	if t.root != nil {
+		t.root.color = Black
+	}
+	t.size--
+	t.modCount++
 }
 
 func (t *TreeMap[K, V]) fixInsert(node *Node[K, V]) {
@@ -352,7 +366,7 @@ func (t *TreeMap[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bool
 	defer t.mu.Unlock()
 
 	node := t.getNode(key)
-	if node == nil || node.value != oldValue {
+	if node == nil || !equalValues(t.values, node.value, oldValue) {
 		return false
 	}
 
@@ -382,15 +396,11 @@ func (t *TreeMap[K, V]) RemoveKeyWithValue(key K, value V) bool {
 	defer t.mu.Unlock()
 
 	node := t.getNode(key)
-	if node == nil || node.value != value {
+	if node == nil || !equalValues(t.values, node.value, value) {
 		return false
 	}
 
-	t.deleteNode(node)
-	if t.root != nil {
-		t.root.color = Black
-	}
-	t.size--
+	t.removeNode(node)
 	return true
 }
 
@@ -406,14 +416,142 @@ func (t *TreeMap[K, V]) Remove(key K) V {
 	}
 
 	value := node.value
-	t.deleteNode(node)
-	if t.root != nil {
-		t.root.color = Black
-	}
-	t.size--
+	t.removeNode(node)
 	return value
 }
 
+// GetOrDefault returns the value to which the specified key is mapped, or defaultValue if this map contains no mapping for the key.
+func (t *TreeMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if node := t.getNode(key); node != nil {
+		return node.value
+	}
+	return defaultValue
+}
+
+// ForEachEntry performs the given action for each entry in this map in ascending key order.
+// The action runs without holding the lock, so it may modify the map.
+func (t *TreeMap[K, V]) ForEachEntry(action func(key K, value V)) {
+	if action == nil {
+		return
+	}
+	for key, value := range t.All() {
+		action(key, value)
+	}
+}
+
+// ComputeIfAbsent computes a value for the specified key if the key is not already associated with a value.
+// The mapping function runs while the map is locked, so it must not use this map.
+func (t *TreeMap[K, V]) ComputeIfAbsent(key K, mappingFunction func(K) V) (V, error) {
+	if mappingFunction == nil {
+		var zero V
+		return zero, errcodes.New(errcodes.NullPointerError, "TreeMap", "ComputeIfAbsent")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if node := t.getNode(key); node != nil {
+		return node.value, nil
+	}
+	value := mappingFunction(key)
+	t.insert(key, value)
+	return value, nil
+}
+
+// Compute atomically computes a new mapping for the key from its current value.
+// The function receives nil if the key is absent. If it returns false, the mapping is
+// removed (or not created) and Compute returns nil.
+// The function runs while the map is locked, so it must not use this map.
+func (t *TreeMap[K, V]) Compute(key K, remappingFunction func(K, *V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "TreeMap", "Compute")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.compute(key, remappingFunction), nil
+}
+
+// ComputeIfPresent atomically computes a new mapping for the key if it is present.
+// If the function returns false, the mapping is removed and nil is returned.
+// The function runs while the map is locked, so it must not use this map.
+func (t *TreeMap[K, V]) ComputeIfPresent(key K, remappingFunction func(K, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "TreeMap", "ComputeIfPresent")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.computeIfPresent(key, remappingFunction), nil
+}
+
+// Merge atomically associates the key with value if it is absent, or otherwise with the
+// result of combining the current value and value. If the function returns false, the
+// mapping is removed and nil is returned.
+// The function runs while the map is locked, so it must not use this map.
+func (t *TreeMap[K, V]) Merge(key K, value V, remappingFunction func(V, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "TreeMap", "Merge")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.merge(key, value, remappingFunction), nil
+}
+
+// compute, computeIfPresent and merge do the work of the exported methods, which subMap
+// shares. They assume the write lock is already held.
+
+func (t *TreeMap[K, V]) compute(key K, remappingFunction func(K, *V) (V, bool)) *V {
+	node := t.getNode(key)
+	var current *V
+	if node != nil {
+		value := node.value
+		current = &value
+	}
+	value, keep := remappingFunction(key, current)
+	return t.store(key, node, value, keep)
+}
+
+func (t *TreeMap[K, V]) computeIfPresent(key K, remappingFunction func(K, V) (V, bool)) *V {
+	node := t.getNode(key)
+	if node == nil {
+		return nil
+	}
+	value, keep := remappingFunction(key, node.value)
+	return t.store(key, node, value, keep)
+}
+
+func (t *TreeMap[K, V]) merge(key K, value V, remappingFunction func(V, V) (V, bool)) *V {
+	node := t.getNode(key)
+	if node == nil {
+		return t.store(key, nil, value, true)
+	}
+	merged, keep := remappingFunction(node.value, value)
+	return t.store(key, node, merged, keep)
+}
+
+// store applies the result of a remapping function to the node of key, which is nil if the
+// key is absent, and returns a pointer to the stored value, or nil if the mapping was removed.
+// It assumes the write lock is already held.
+func (t *TreeMap[K, V]) store(key K, node *Node[K, V], value V, keep bool) *V {
+	switch {
+	case !keep:
+		if node != nil {
+			t.removeNode(node)
+		}
+		return nil
+	case node != nil:
+		node.value = value
+	default:
+		t.insert(key, value)
+	}
+	return &value
+}
+
 // Size returns the number of elements in the map
 func (t *TreeMap[K, V]) Size() int {
 	t.mu.RLock()
@@ -421,13 +559,10 @@ func (t *TreeMap[K, V]) Size() int {
 	return t.size
 }
 
-// Values returns a collection of all values in the map
+// Values returns a collection view of the values in the map, ordered by key.
+// Duplicate values are kept. The view reflects later changes to the map.
 func (t *TreeMap[K, V]) Values() collections.Collection[V] {
-	t.mu.RLock()
-	defer t.mu.RUnlock()
-	values := sets.NewHashSet[V]()
-	t.collectValues(t.root, values)
-	return values
+	return t.view().Values()
 }
 
 // Equals returns true if this map equals the given map
@@ -444,61 +579,181 @@ func (t *TreeMap[K, V]) Equals(other any) bool {
 	}
 	for _, entry := range t.EntrySet().ToArray() {
 		otherValue := otherMap.Get(entry.GetKey())
-		if otherValue == nil || *otherValue != entry.GetValue() {
+		if otherValue == nil || !equalValues(t.values, *otherValue, entry.GetValue()) {
 			return false
 		}
 	}
 	return true
 }
 
+// Comparator returns the comparator used to order the keys in this map
+func (t *TreeMap[K, V]) Comparator() collections.Comparator[K] {
+	return t.comparator
+}
+
 // FirstKey returns the first (lowest) key in the map
-func (t *TreeMap[K, V]) FirstKey() (*K, error) {
+func (t *TreeMap[K, V]) FirstKey() (K, error) {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
-	if t.root == nil {
-		return nil, errors.New("map is empty")
-	}
-	node := t.getFirstNode(t.root)
-	return &node.key, nil
+	return keyOf("FirstKey", t.getFirstNode(t.root))
 }
 
 // LastKey returns the last (highest) key in the map
-func (t *TreeMap[K, V]) LastKey() (*K, error) {
+func (t *TreeMap[K, V]) LastKey() (K, error) {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
-	if t.root == nil {
-		return nil, errors.New("map is empty")
-	}
-	node := t.getLastNode(t.root)
-	return &node.key, nil
+	return keyOf("LastKey", t.getLastNode(t.root))
 }
 
-// LowerKey returns the greatest key less than the given key
-func (t *TreeMap[K, V]) LowerKey(key K) (*K, error) {
+// LowerKey returns the greatest key strictly less than the given key
+func (t *TreeMap[K, V]) LowerKey(key K) (K, error) {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
-	if t.root == nil {
-		return nil, errors.New("map is empty")
-	}
-	node := t.getLowerNode(key)
-	if node == nil {
-		return nil, errors.New("no lower key exists")
-	}
-	return &node.key, nil
+	return keyOf("LowerKey", t.getLowerNode(key))
 }
 
-// HigherKey returns the least key greater than the given key
-func (t *TreeMap[K, V]) HigherKey(key K) (*K, error) {
+// HigherKey returns the least key strictly greater than the given key
+func (t *TreeMap[K, V]) HigherKey(key K) (K, error) {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
-	if t.root == nil {
-		return nil, errors.New("map is empty")
-	}
-	node := t.getHigherNode(key)
+	return keyOf("HigherKey", t.getHigherNode(key))
+}
+
+// CeilingKey returns the least key greater than or equal to the given key
+func (t *TreeMap[K, V]) CeilingKey(key K) (K, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return keyOf("CeilingKey", t.getCeilingNode(key))
+}
+
+// FloorKey returns the greatest key less than or equal to the given key
+func (t *TreeMap[K, V]) FloorKey(key K) (K, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return keyOf("FloorKey", t.getFloorNode(key))
+}
+
+// FirstEntry returns the entry with the least key in the map
+func (t *TreeMap[K, V]) FirstEntry() (collections.MapEntry[K, V], error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return entryOf("FirstEntry", t.getFirstNode(t.root))
+}
+
+// LastEntry returns the entry with the greatest key in the map
+func (t *TreeMap[K, V]) LastEntry() (collections.MapEntry[K, V], error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return entryOf("LastEntry", t.getLastNode(t.root))
+}
+
+// LowerEntry returns the entry with the greatest key strictly less than the given key
+func (t *TreeMap[K, V]) LowerEntry(key K) (collections.MapEntry[K, V], error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return entryOf("LowerEntry", t.getLowerNode(key))
+}
+
+// HigherEntry returns the entry with the least key strictly greater than the given key
+func (t *TreeMap[K, V]) HigherEntry(key K) (collections.MapEntry[K, V], error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return entryOf("HigherEntry", t.getHigherNode(key))
+}
+
+// CeilingEntry returns the entry with the least key greater than or equal to the given key
+func (t *TreeMap[K, V]) CeilingEntry(key K) (collections.MapEntry[K, V], error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return entryOf("CeilingEntry", t.getCeilingNode(key))
+}
+
+// FloorEntry returns the entry with the greatest key less than or equal to the given key
+func (t *TreeMap[K, V]) FloorEntry(key K) (collections.MapEntry[K, V], error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return entryOf("FloorEntry", t.getFloorNode(key))
+}
+
+// PollFirstEntry removes and returns the entry with the least key in the map
+func (t *TreeMap[K, V]) PollFirstEntry() (collections.MapEntry[K, V], error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.pollNode("PollFirstEntry", t.getFirstNode(t.root))
+}
+
+// PollLastEntry removes and returns the entry with the greatest key in the map
+func (t *TreeMap[K, V]) PollLastEntry() (collections.MapEntry[K, V], error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.pollNode("PollLastEntry", t.getLastNode(t.root))
+}
+
+// HeadMap returns a view of the portion of this map whose keys are strictly less than toKey
+func (t *TreeMap[K, V]) HeadMap(toKey K) (collections.SortedMap[K, V], error) {
+	return t.view().HeadMap(toKey)
+}
+
+// TailMap returns a view of the portion of this map whose keys are greater than or equal to fromKey
+func (t *TreeMap[K, V]) TailMap(fromKey K) (collections.SortedMap[K, V], error) {
+	return t.view().TailMap(fromKey)
+}
+
+// SubMap returns a view of the portion of this map whose keys range from fromKey, inclusive, to toKey, exclusive
+func (t *TreeMap[K, V]) SubMap(fromKey K, toKey K) (collections.SortedMap[K, V], error) {
+	return t.view().SubMap(fromKey, toKey)
+}
+
+// DescendingMap returns a reverse order view of the mappings contained in this map
+func (t *TreeMap[K, V]) DescendingMap() collections.NavigableMap[K, V] {
+	return t.view().DescendingMap()
+}
+
+// NavigableKeySet returns a NavigableSet view of the keys contained in this map
+func (t *TreeMap[K, V]) NavigableKeySet() collections.NavigableSet[K] {
+	return t.view().NavigableKeySet()
+}
+
+// DescendingKeySet returns a reverse order NavigableSet view of the keys contained in this map
+func (t *TreeMap[K, V]) DescendingKeySet() collections.NavigableSet[K] {
+	return t.view().DescendingKeySet()
+}
+
+// All returns a sequence over the entries in this map in ascending key order.
+// The read lock is released while the loop body runs, so the body may modify the map.
+func (t *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return t.view().All()
+}
+
+// Backward returns a sequence over the entries in this map in descending key order
+func (t *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return t.view().Backward()
+}
+
+// Keys returns a sequence over the keys in this map in ascending order
+func (t *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return t.view().Keys()
+}
+
+// AllValues returns a sequence over the values in this map in ascending key order
+func (t *TreeMap[K, V]) AllValues() iter.Seq[V] {
+	return t.view().AllValues()
+}
+
+// view returns an unbounded view of the whole map
+func (t *TreeMap[K, V]) view() *subMap[K, V] {
+	return &subMap[K, V]{m: t, fromStart: true, toEnd: true}
+}
+
+// pollNode removes the given node and returns its entry.
+// It assumes the write lock is already held.
+func (t *TreeMap[K, V]) pollNode(op string, node *Node[K, V]) (collections.MapEntry[K, V], error) {
 	if node == nil {
-		return nil, errors.New("no higher key exists")
+		return nil, errcodes.New(errcodes.NoSuchElementError, "TreeMap", op)
 	}
-	return &node.key, nil
+	entry := collections.NewHashMapEntry(node.key, node.value)
+	t.removeNode(node)
+	return entry, nil
 }
 
 // HasKey returns true if this map contains a mapping for the specified key
@@ -733,31 +988,91 @@ func (t *TreeMap[K, V]) getHigherNode(key K) *Node[K, V] {
 	return result
 }
 
-func (t *TreeMap[K, V]) collectEntries(node *Node[K, V], entries collections.Set[collections.MapEntry[K, V]]) {
-	if node == nil {
-		return
+func (t *TreeMap[K, V]) getCeilingNode(key K) *Node[K, V] {
+	node := t.root
+	var result *Node[K, V]
+	for node != nil {
+		cmp := t.comparator.Compare(key, node.key)
+		if cmp == 0 {
+			return node
+		}
+		if cmp < 0 {
+			result = node
+			node = node.left
+		} else {
+			node = node.right
+		}
 	}
-	t.collectEntries(node.left, entries)
-	entries.Add(collections.NewHashMapEntry(node.key, node.value))
-	t.collectEntries(node.right, entries)
+	return result
 }
 
-func (t *TreeMap[K, V]) collectKeys(node *Node[K, V], keys collections.Set[K]) {
+func (t *TreeMap[K, V]) getFloorNode(key K) *Node[K, V] {
+	node := t.root
+	var result *Node[K, V]
+	for node != nil {
+		cmp := t.comparator.Compare(key, node.key)
+		if cmp == 0 {
+			return node
+		}
+		if cmp > 0 {
+			result = node
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	return result
+}
+
+// successor returns the node with the next higher key, or nil if there is none
+func successor[K comparable, V any](node *Node[K, V]) *Node[K, V] {
+	if node.right != nil {
+		node = node.right
+		for node.left != nil {
+			node = node.left
+		}
+		return node
+	}
+	parent := node.parent
+	for parent != nil && node == parent.right {
+		node = parent
+		parent = parent.parent
+	}
+	return parent
+}
+
+// predecessor returns the node with the next lower key, or nil if there is none
+func predecessor[K comparable, V any](node *Node[K, V]) *Node[K, V] {
+	if node.left != nil {
+		node = node.left
+		for node.right != nil {
+			node = node.right
+		}
+		return node
+	}
+	parent := node.parent
+	for parent != nil && node == parent.left {
+		node = parent
+		parent = parent.parent
+	}
+	return parent
+}
+
+// keyOf returns the key of the node, or NoSuchElementError if the node is nil
+func keyOf[K comparable, V any](op string, node *Node[K, V]) (K, error) {
 	if node == nil {
-		return
+		var zero K
+		return zero, errcodes.New(errcodes.NoSuchElementError, "TreeMap", op)
 	}
-	t.collectKeys(node.left, keys)
-	keys.Add(node.key)
-	t.collectKeys(node.right, keys)
+	return node.key, nil
 }
 
-func (t *TreeMap[K, V]) collectValues(node *Node[K, V], values collections.Set[V]) {
+// entryOf returns a snapshot entry of the node, or NoSuchElementError if the node is nil
+func entryOf[K comparable, V any](op string, node *Node[K, V]) (collections.MapEntry[K, V], error) {
 	if node == nil {
-		return
+		return nil, errcodes.New(errcodes.NoSuchElementError, "TreeMap", op)
 	}
-	t.collectValues(node.left, values)
-	values.Add(node.value)
-	t.collectValues(node.right, values)
+	return collections.NewHashMapEntry(node.key, node.value), nil
 }
 
 // PutIfAbsent associates the specified value with the specified key in this map if the key is not already associated with a value
@@ -770,47 +1085,7 @@ func (t *TreeMap[K, V]) PutIfAbsent(key K, value V) V {
 		return node.value
 	}
 
-	// Create new node
-	newNode := &Node[K, V]{
-		key:   key,
-		value: value,
-		color: Red,
-	}
-
-	// Insert the node
-	if t.root == nil {
-		t.root = newNode
-		t.root.color = Black
-		t.size++
-		var zero V
-		return zero
-	}
-
-	// Find insertion point
-	current := t.root
-	var parent *Node[K, V]
-	for current != nil {
-		parent = current
-		cmp := t.comparator.Compare(key, current.key)
-		if cmp < 0 {
-			current = current.left
-		} else {
-			current = current.right
-		}
-	}
-
-	// Insert the node
-	newNode.parent = parent
-	if t.comparator.Compare(key, parent.key) < 0 {
-		parent.left = newNode
-	} else {
-		parent.right = newNode
-	}
-
-	// Fix the tree
-	t.fixInsert(newNode)
-	t.size++
-
+	t.insert(key, value)
 	var zero V
 	return zero
 }
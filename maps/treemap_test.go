@@ -1,12 +1,15 @@
 package maps
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -148,22 +151,22 @@ func TestTreeMap_SortedOperations(t *testing.T) {
 	// Test FirstKey
 	firstKey, err := tm.FirstKey()
 	assert.Nil(t, err, "FirstKey should not return error")
-	assert.Equal(t, 1, *firstKey, "FirstKey should return smallest key")
+	assert.Equal(t, 1, firstKey, "FirstKey should return smallest key")
 
 	// Test LastKey
 	lastKey, err := tm.LastKey()
 	assert.Nil(t, err, "LastKey should not return error")
-	assert.Equal(t, 3, *lastKey, "LastKey should return largest key")
+	assert.Equal(t, 3, lastKey, "LastKey should return largest key")
 
 	// Test LowerKey
 	lowerKey, err := tm.LowerKey(2)
 	assert.Nil(t, err, "LowerKey should not return error")
-	assert.Equal(t, 1, *lowerKey, "LowerKey should return greatest key less than given key")
+	assert.Equal(t, 1, lowerKey, "LowerKey should return greatest key less than given key")
 
 	// Test HigherKey
 	higherKey, err := tm.HigherKey(2)
 	assert.Nil(t, err, "HigherKey should not return error")
-	assert.Equal(t, 3, *higherKey, "HigherKey should return least key greater than given key")
+	assert.Equal(t, 3, higherKey, "HigherKey should return least key greater than given key")
 }
 
 func TestTreeMap_EmptyOperations(t *testing.T) {
@@ -172,19 +175,19 @@ func TestTreeMap_EmptyOperations(t *testing.T) {
 	// Test operations on empty map
 	firstKey, err := tm.FirstKey()
 	assert.NotNil(t, err, "FirstKey should return error on empty map")
-	assert.Nil(t, firstKey, "FirstKey should return nil on empty map")
+	assert.Zero(t, firstKey, "FirstKey should return zero value on empty map")
 
 	lastKey, err := tm.LastKey()
 	assert.NotNil(t, err, "LastKey should return error on empty map")
-	assert.Nil(t, lastKey, "LastKey should return nil on empty map")
+	assert.Zero(t, lastKey, "LastKey should return zero value on empty map")
 
 	lowerKey, err := tm.LowerKey(1)
 	assert.NotNil(t, err, "LowerKey should return error on empty map")
-	assert.Nil(t, lowerKey, "LowerKey should return nil on empty map")
+	assert.Zero(t, lowerKey, "LowerKey should return zero value on empty map")
 
 	higherKey, err := tm.HigherKey(1)
 	assert.NotNil(t, err, "HigherKey should return error on empty map")
-	assert.Nil(t, higherKey, "HigherKey should return nil on empty map")
+	assert.Zero(t, higherKey, "HigherKey should return zero value on empty map")
 }
 
 func TestTreeMap_Concurrent(t *testing.T) {
@@ -787,21 +790,21 @@ func TestTreeMap_LowerHigherKey(t *testing.T) {
 	// Test empty map
 	lower, err := tm.LowerKey(5)
 	assert.Error(t, err, "LowerKey should return error for empty map")
-	assert.Nil(t, lower, "LowerKey should return nil for empty map")
+	assert.Zero(t, lower, "LowerKey should return zero value for empty map")
 
 	higher, err := tm.HigherKey(5)
 	assert.Error(t, err, "HigherKey should return error for empty map")
-	assert.Nil(t, higher, "HigherKey should return nil for empty map")
+	assert.Zero(t, higher, "HigherKey should return zero value for empty map")
 
 	// Test single node
 	tm.Put(5, "five")
 	lower, err = tm.LowerKey(5)
 	assert.Error(t, err, "LowerKey should return error when no lower key exists")
-	assert.Nil(t, lower, "LowerKey should return nil when no lower key exists")
+	assert.Zero(t, lower, "LowerKey should return zero value when no lower key exists")
 
 	higher, err = tm.HigherKey(5)
 	assert.Error(t, err, "HigherKey should return error when no higher key exists")
-	assert.Nil(t, higher, "HigherKey should return nil when no higher key exists")
+	assert.Zero(t, higher, "HigherKey should return zero value when no higher key exists")
 
 	// Test multiple nodes
 	tm.Put(3, "three")
@@ -812,20 +815,20 @@ func TestTreeMap_LowerHigherKey(t *testing.T) {
 	// Test LowerKey
 	lower, err = tm.LowerKey(5)
 	assert.NoError(t, err, "LowerKey should not return error")
-	assert.Equal(t, 3, *lower, "LowerKey should return greatest key less than given key")
+	assert.Equal(t, 3, lower, "LowerKey should return greatest key less than given key")
 
 	lower, err = tm.LowerKey(1)
 	assert.Error(t, err, "LowerKey should return error when no lower key exists")
-	assert.Nil(t, lower, "LowerKey should return nil when no lower key exists")
+	assert.Zero(t, lower, "LowerKey should return zero value when no lower key exists")
 
 	// Test HigherKey
 	higher, err = tm.HigherKey(5)
 	assert.NoError(t, err, "HigherKey should not return error")
-	assert.Equal(t, 7, *higher, "HigherKey should return least key greater than given key")
+	assert.Equal(t, 7, higher, "HigherKey should return least key greater than given key")
 
 	higher, err = tm.HigherKey(9)
 	assert.Error(t, err, "HigherKey should return error when no higher key exists")
-	assert.Nil(t, higher, "HigherKey should return nil when no higher key exists")
+	assert.Zero(t, higher, "HigherKey should return zero value when no higher key exists")
 }
 
 // Comprehensive test for PutIfAbsent
@@ -1015,3 +1018,283 @@ func TestTreeMap_DeleteNode_WithBothChildren(t *testing.T) {
 	assert.Equal(t, "one", tm.root.left.value, "Left child value should remain unchanged")
 	assert.Nil(t, tm.root.right, "Right child should be nil after successor moves up")
 }
+
+func TestTreeMap_NavigableMap(t *testing.T) {
+	var _ collections.NavigableMap[int, string] = NewTreeMap[int, string](&IntComparator{})
+
+	tm := NewTreeMap[int, string](&IntComparator{})
+	assert.NotNil(t, tm.Comparator())
+	for _, k := range []int{10, 20, 30, 40} {
+		tm.Put(k, fmt.Sprintf("v%d", k))
+	}
+
+	tests := []struct {
+		name    string
+		fn      func(int) (int, error)
+		key     int
+		want    int
+		wantErr bool
+	}{
+		{"CeilingKey exact", tm.CeilingKey, 20, 20, false},
+		{"CeilingKey between", tm.CeilingKey, 21, 30, false},
+		{"CeilingKey above", tm.CeilingKey, 41, 0, true},
+		{"FloorKey exact", tm.FloorKey, 20, 20, false},
+		{"FloorKey between", tm.FloorKey, 21, 20, false},
+		{"FloorKey below", tm.FloorKey, 9, 0, true},
+		{"HigherKey exact", tm.HigherKey, 20, 30, false},
+		{"HigherKey last", tm.HigherKey, 40, 0, true},
+		{"LowerKey exact", tm.LowerKey, 20, 10, false},
+		{"LowerKey first", tm.LowerKey, 10, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(tt.key)
+			if tt.wantErr {
+				assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	entry, err := tm.CeilingEntry(25)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, entry.GetKey())
+	assert.Equal(t, "v30", entry.GetValue())
+
+	entry, err = tm.FloorEntry(25)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, entry.GetKey())
+
+	entry, err = tm.HigherEntry(30)
+	assert.NoError(t, err)
+	assert.Equal(t, 40, entry.GetKey())
+
+	entry, err = tm.LowerEntry(30)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, entry.GetKey())
+
+	entry, err = tm.HigherEntry(40)
+	assert.Error(t, err)
+	assert.Nil(t, entry)
+
+	entry, err = tm.FirstEntry()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, entry.GetKey())
+
+	entry, err = tm.LastEntry()
+	assert.NoError(t, err)
+	assert.Equal(t, 40, entry.GetKey())
+	assert.Equal(t, 4, tm.Size(), "FirstEntry and LastEntry should not remove")
+}
+
+func TestTreeMap_PollEntries(t *testing.T) {
+	tm := NewTreeMap[int, string](&IntComparator{})
+
+	entry, err := tm.PollFirstEntry()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+	assert.Nil(t, entry)
+	entry, err = tm.PollLastEntry()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+	assert.Nil(t, entry)
+
+	for i := 1; i <= 5; i++ {
+		tm.Put(i, fmt.Sprintf("v%d", i))
+	}
+
+	entry, err = tm.PollFirstEntry()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, entry.GetKey())
+	assert.Equal(t, "v1", entry.GetValue())
+
+	entry, err = tm.PollLastEntry()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, entry.GetKey())
+
+	assert.Equal(t, 3, tm.Size())
+	assert.False(t, tm.HasKey(1))
+	assert.False(t, tm.HasKey(5))
+	assert.True(t, tm.(*TreeMap[int, string]).verifyRedBlackProperties())
+}
+
+func TestTreeMap_EmptyNavigation(t *testing.T) {
+	tm := NewTreeMap[int, string](&IntComparator{})
+
+	for _, fn := range []func() (collections.MapEntry[int, string], error){tm.FirstEntry, tm.LastEntry} {
+		entry, err := fn()
+		assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+		assert.Nil(t, entry)
+	}
+	for _, fn := range []func(int) (collections.MapEntry[int, string], error){tm.CeilingEntry, tm.FloorEntry, tm.HigherEntry, tm.LowerEntry} {
+		entry, err := fn(1)
+		assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+		assert.Nil(t, entry)
+	}
+}
+
+func TestTreeMap_All(t *testing.T) {
+	tm := NewTreeMap[int, string](&IntComparator{})
+	for _, k := range []int{3, 1, 4, 2} {
+		tm.Put(k, fmt.Sprint(k))
+	}
+
+	var keys []int
+	var values []string
+	for k, v := range tm.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4}, keys)
+	assert.Equal(t, []string{"1", "2", "3", "4"}, values)
+	assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(tm.Keys()))
+	assert.Equal(t, []string{"1", "2", "3", "4"}, slices.Collect(tm.AllValues()))
+
+	keys = nil
+	for k := range tm.Backward() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{4, 3, 2, 1}, keys)
+
+	// The loop body may modify the map; iteration resumes after the last key yielded
+	keys = nil
+	for k := range tm.Keys() {
+		if k == 2 {
+			tm.Remove(2)
+			tm.Remove(3)
+			tm.Put(5, "5")
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{1, 2, 4, 5}, keys)
+
+	keys = nil
+	for k := range tm.Keys() {
+		keys = append(keys, k)
+		break
+	}
+	assert.Equal(t, []int{1}, keys)
+}
+
+func TestTreeMap_ErrorDetails(t *testing.T) {
+	tm := NewTreeMap[int, string](&IntComparator{})
+	_, err := tm.FirstKey()
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+	var e *errcodes.Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, errcodes.Error{Code: errcodes.NoSuchElementError, Type: "TreeMap", Op: "FirstKey"}, *e)
+
+	tm.Put(1, "a")
+	it := tm.EntrySet().Iterator()
+	tm.Put(2, "b")
+	_, err = it.Next()
+	assert.ErrorIs(t, err, errcodes.ErrConcurrentModification)
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, "Iterator.Next", e.Op)
+}
+
+func TestNewTreeMapWithValueEquality(t *testing.T) {
+	assert.Nil(t, NewTreeMapWithValueEquality[int, []string](nil, nil))
+
+	tm := NewTreeMapWithValueEquality[int, []string](&IntComparator{}, nil)
+	tm.Put(1, []string{"a"})
+	tm.Put(2, []string{"b", "c"})
+	tm.Put(3, []string{"a"})
+
+	assert.True(t, tm.HasValue([]string{"b", "c"}))
+	assert.True(t, tm.Values().Contains([]string{"a"}))
+	assert.True(t, tm.Values().Remove([]string{"a"}))
+	assert.Equal(t, []int{2, 3}, slices.Collect(tm.Keys()))
+	assert.False(t, tm.Values().RemoveAll(NewTreeMapWithValueEquality[int, []string](&IntComparator{}, nil).Values()))
+
+	head, err := tm.HeadMap(3)
+	assert.NoError(t, err)
+	assert.True(t, head.HasValue([]string{"b", "c"}))
+	assert.False(t, head.HasValue([]string{"a"}))
+	assert.True(t, tm.ReplaceKeyWithValue(3, []string{"a"}, []string{"d"}))
+	assert.True(t, tm.RemoveKeyWithValue(3, []string{"d"}))
+
+	other := NewTreeMapWithValueEquality[int, []string](&IntComparator{}, nil)
+	other.Put(2, []string{"b", "c"})
+	assert.True(t, tm.Equals(other))
+	assert.True(t, tm.EntrySet().Contains(collections.NewHashMapEntry(2, []string{"b", "c"})))
+}
+
+func TestTreeMap_ComputeAndMerge(t *testing.T) {
+	tm := NewTreeMap[int, int](&IntComparator{})
+	increment := func(k int, v *int) (int, bool) {
+		if v == nil {
+			return 1, true
+		}
+		return *v + 1, true
+	}
+	sum := func(old, v int) (int, bool) { return old + v, true }
+
+	for i := range 50 {
+		_, err := tm.Compute(i%10, increment)
+		assert.NoError(t, err)
+		_, err = tm.Merge(i%7+100, i, sum)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 17, tm.Size())
+	assert.Equal(t, 5, *tm.Get(3))
+	assert.Equal(t, 0+7+14+21+28+35+42+49, *tm.Get(100))
+
+	value, err := tm.ComputeIfPresent(3, func(k int, v int) (int, bool) { return v * 10, true })
+	assert.NoError(t, err)
+	assert.Equal(t, 50, *value)
+	value, err = tm.ComputeIfPresent(99, func(k int, v int) (int, bool) { return v, true })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.False(t, tm.HasKey(99))
+
+	// Returning false removes the mapping and keeps the tree balanced
+	for i := range 10 {
+		value, err = tm.Compute(i, func(k int, v *int) (int, bool) { return 0, false })
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	}
+	value, err = tm.Merge(100, 1, func(old, v int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = tm.Compute(200, func(k int, v *int) (int, bool) { return 0, false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.Equal(t, []int{101, 102, 103, 104, 105, 106}, slices.Collect(tm.Keys()))
+	assert.True(t, tm.(*TreeMap[int, int]).verifyRedBlackProperties())
+
+	_, err = tm.Compute(1, nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+	_, err = tm.ComputeIfPresent(1, nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+	_, err = tm.Merge(1, 1, nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+}
+
+func TestTreeMap_ConcurrentMapMethods(t *testing.T) {
+	tm := NewTreeMap[int, string](&IntComparator{}).(*TreeMap[int, string])
+	tm.Put(2, "two")
+
+	assert.Equal(t, "two", tm.GetOrDefault(2, "none"))
+	assert.Equal(t, "none", tm.GetOrDefault(1, "none"))
+
+	value, err := tm.ComputeIfAbsent(1, func(k int) string { return "one" })
+	assert.NoError(t, err)
+	assert.Equal(t, "one", value)
+	value, err = tm.ComputeIfAbsent(1, func(k int) string { return "uno" })
+	assert.NoError(t, err)
+	assert.Equal(t, "one", value)
+	_, err = tm.ComputeIfAbsent(3, nil)
+	assert.Error(t, err)
+	assert.False(t, tm.ContainsKey(3))
+
+	// Entries are visited in key order, and the action may modify the map
+	var keys []int
+	tm.ForEachEntry(func(k int, v string) {
+		keys = append(keys, k)
+		tm.Remove(k)
+	})
+	assert.Equal(t, []int{1, 2}, keys)
+	assert.True(t, tm.IsEmpty())
+	tm.ForEachEntry(nil)
+}
@@ -0,0 +1,1260 @@
+package maps
+
+import (
+	"iter"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// subMap is a view of a range of a TreeMap, optionally in descending order.
+// It shares the tree with the TreeMap that created it, so changes made through
+// either one are visible in the other.
+type subMap[K comparable, V any] struct {
+	m *TreeMap[K, V]
+
+	// Range bounds of this view. The view returned by TreeMap.view is unbounded.
+	fromStart   bool
+	lo          K
+	loInclusive bool
+	toEnd       bool
+	hi          K
+	hiInclusive bool
+
+	// descending reports whether this view iterates in reverse order.
+	descending bool
+}
+
+// Clear removes all mappings within the range of this view.
+func (s *subMap[K, V]) Clear() {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+
+	if s.fromStart && s.toEnd {
+		s.m.root = nil
+		s.m.size = 0
+		s.m.modCount++
+		return
+	}
+	for node := s.absLowest(); node != nil; node = s.absLowest() {
+		s.m.removeNode(node)
+	}
+}
+
+// HasKey returns true if this view contains a mapping for the specified key.
+func (s *subMap[K, V]) HasKey(key K) bool {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return s.inRange(key) && s.m.getNode(key) != nil
+}
+
+// HasValue returns true if this view maps one or more keys to the specified value.
+func (s *subMap[K, V]) HasValue(value V) bool {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	for node := s.absLowest(); node != nil; node = s.absSuccessor(node) {
+		if equalValues(s.m.values, node.value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// EntrySet returns a set view of the entries in this view, in the order of this view.
+func (s *subMap[K, V]) EntrySet() collections.Set[collections.MapEntry[K, V]] {
+	return &treeEntrySet[K, V]{m: s}
+}
+
+// Equals returns true if this view equals the given map.
+func (s *subMap[K, V]) Equals(other any) bool {
+	if other == nil {
+		return false
+	}
+	otherMap, ok := other.(collections.Map[K, V])
+	if !ok {
+		return false
+	}
+	if s.Size() != otherMap.Size() {
+		return false
+	}
+	for _, entry := range s.EntrySet().ToArray() {
+		otherValue := otherMap.Get(entry.GetKey())
+		if otherValue == nil || !equalValues(s.m.values, *otherValue, entry.GetValue()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns the value associated with the given key, or nil if the key lies outside this view.
+func (s *subMap[K, V]) Get(key K) *V {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	if !s.inRange(key) {
+		return nil
+	}
+	node := s.m.getNode(key)
+	if node == nil {
+		return nil
+	}
+	return &node.value
+}
+
+// IsEmpty returns true if this view contains no mappings.
+func (s *subMap[K, V]) IsEmpty() bool {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return s.absLowest() == nil
+}
+
+// KeySet returns a set view of the keys in this view, in the order of this view.
+func (s *subMap[K, V]) KeySet() collections.Set[K] {
+	return s.NavigableKeySet()
+}
+
+// Put associates the specified value with the specified key.
+// Keys outside the range of this view are ignored and the zero value is returned.
+func (s *subMap[K, V]) Put(key K, value V) V {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if !s.inRange(key) {
+		var zero V
+		return zero
+	}
+	return s.m.put(key, value)
+}
+
+// PutAll copies all of the mappings from the specified map that lie within the range of this view.
+func (s *subMap[K, V]) PutAll(m collections.Map[K, V]) {
+	if m == nil {
+		return
+	}
+
+	// Get all entries first to minimize lock time
+	entries := m.EntrySet().ToArray()
+
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+
+	for _, entry := range entries {
+		if s.inRange(entry.GetKey()) {
+			s.m.put(entry.GetKey(), entry.GetValue())
+		}
+	}
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not already associated with a value.
+// Keys outside the range of this view are ignored and the zero value is returned.
+func (s *subMap[K, V]) PutIfAbsent(key K, value V) V {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if !s.inRange(key) {
+		var zero V
+		return zero
+	}
+	if node := s.m.getNode(key); node != nil {
+		return node.value
+	}
+	s.m.insert(key, value)
+	var zero V
+	return zero
+}
+
+// Remove removes the mapping for a key from this view if it is present.
+func (s *subMap[K, V]) Remove(key K) V {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var zero V
+	if !s.inRange(key) {
+		return zero
+	}
+	node := s.m.getNode(key)
+	if node == nil {
+		return zero
+	}
+	value := node.value
+	s.m.removeNode(node)
+	return value
+}
+
+// RemoveKeyWithValue removes the entry for the specified key only if it is currently mapped to the specified value.
+func (s *subMap[K, V]) RemoveKeyWithValue(key K, value V) bool {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if !s.inRange(key) {
+		return false
+	}
+	node := s.m.getNode(key)
+	if node == nil || !equalValues(s.m.values, node.value, value) {
+		return false
+	}
+	s.m.removeNode(node)
+	return true
+}
+
+// Replace replaces the entry for the specified key only if it is currently mapped to some value.
+func (s *subMap[K, V]) Replace(key K, value V) V {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var zero V
+	if !s.inRange(key) {
+		return zero
+	}
+	node := s.m.getNode(key)
+	if node == nil {
+		return zero
+	}
+	oldValue := node.value
+	node.value = value
+	return oldValue
+}
+
+// ReplaceKeyWithValue replaces the entry for the specified key only if currently mapped to the given old value.
+func (s *subMap[K, V]) ReplaceKeyWithValue(key K, oldValue V, newValue V) bool {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if !s.inRange(key) {
+		return false
+	}
+	node := s.m.getNode(key)
+	if node == nil || !equalValues(s.m.values, node.value, oldValue) {
+		return false
+	}
+	node.value = newValue
+	return true
+}
+
+// Compute atomically computes a new mapping for the key from its current value, as
+// TreeMap.Compute does. It fails with IllegalArgumentError if the key is outside the range
+// of this view.
+func (s *subMap[K, V]) Compute(key K, remappingFunction func(K, *V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "TreeMap", "Compute")
+	}
+
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if !s.inRange(key) {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "TreeMap", "Compute")
+	}
+	return s.m.compute(key, remappingFunction), nil
+}
+
+// ComputeIfPresent atomically computes a new mapping for the key if it is present in this
+// view, as TreeMap.ComputeIfPresent does. Keys outside the range of this view are absent.
+func (s *subMap[K, V]) ComputeIfPresent(key K, remappingFunction func(K, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "TreeMap", "ComputeIfPresent")
+	}
+
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if !s.inRange(key) {
+		return nil, nil
+	}
+	return s.m.computeIfPresent(key, remappingFunction), nil
+}
+
+// Merge atomically associates the key with value, or with its combination with the current
+// value, as TreeMap.Merge does. It fails with IllegalArgumentError if the key is outside
+// the range of this view.
+func (s *subMap[K, V]) Merge(key K, value V, remappingFunction func(V, V) (V, bool)) (*V, error) {
+	if remappingFunction == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "TreeMap", "Merge")
+	}
+
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if !s.inRange(key) {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "TreeMap", "Merge")
+	}
+	return s.m.merge(key, value, remappingFunction), nil
+}
+
+// Size returns the number of mappings in this view.
+// The size of a range view is computed by walking the range.
+func (s *subMap[K, V]) Size() int {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	if s.fromStart && s.toEnd {
+		return s.m.size
+	}
+	count := 0
+	for node := s.absLowest(); node != nil; node = s.absSuccessor(node) {
+		count++
+	}
+	return count
+}
+
+// Values returns a collection view of the values in this view, in the order of this view.
+func (s *subMap[K, V]) Values() collections.Collection[V] {
+	return &treeValues[K, V]{m: s}
+}
+
+// Comparator returns the comparator used to order the keys in this view.
+func (s *subMap[K, V]) Comparator() collections.Comparator[K] {
+	if s.descending {
+		return comparators.Reverse(s.m.comparator)
+	}
+	return s.m.comparator
+}
+
+// FirstKey returns the first key in the order of this view.
+func (s *subMap[K, V]) FirstKey() (K, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return keyOf("FirstKey", s.first())
+}
+
+// LastKey returns the last key in the order of this view.
+func (s *subMap[K, V]) LastKey() (K, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return keyOf("LastKey", s.last())
+}
+
+// HeadMap returns a view of the portion of this view whose keys come strictly before toKey.
+func (s *subMap[K, V]) HeadMap(toKey K) (collections.SortedMap[K, V], error) {
+	view, err := s.headMap(toKey, false)
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// TailMap returns a view of the portion of this view whose keys come at or after fromKey.
+func (s *subMap[K, V]) TailMap(fromKey K) (collections.SortedMap[K, V], error) {
+	view, err := s.tailMap(fromKey, true)
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// SubMap returns a view of the portion of this view whose keys range from fromKey,
+// inclusive, to toKey, exclusive.
+func (s *subMap[K, V]) SubMap(fromKey K, toKey K) (collections.SortedMap[K, V], error) {
+	if s.compare(fromKey, toKey) > 0 {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "TreeMap", "SubMap")
+	}
+	tail, err := s.tailMap(fromKey, true)
+	if err != nil {
+		return nil, err
+	}
+	view, err := tail.headMap(toKey, false)
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// CeilingEntry returns the entry with the least key at or after the given key in the order of this view.
+func (s *subMap[K, V]) CeilingEntry(key K) (collections.MapEntry[K, V], error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return entryOf("CeilingEntry", s.ceiling(key))
+}
+
+// CeilingKey returns the least key at or after the given key in the order of this view.
+func (s *subMap[K, V]) CeilingKey(key K) (K, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return keyOf("CeilingKey", s.ceiling(key))
+}
+
+// FloorEntry returns the entry with the greatest key at or before the given key in the order of this view.
+func (s *subMap[K, V]) FloorEntry(key K) (collections.MapEntry[K, V], error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return entryOf("FloorEntry", s.floor(key))
+}
+
+// FloorKey returns the greatest key at or before the given key in the order of this view.
+func (s *subMap[K, V]) FloorKey(key K) (K, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return keyOf("FloorKey", s.floor(key))
+}
+
+// HigherEntry returns the entry with the least key strictly after the given key in the order of this view.
+func (s *subMap[K, V]) HigherEntry(key K) (collections.MapEntry[K, V], error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return entryOf("HigherEntry", s.higher(key))
+}
+
+// HigherKey returns the least key strictly after the given key in the order of this view.
+func (s *subMap[K, V]) HigherKey(key K) (K, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return keyOf("HigherKey", s.higher(key))
+}
+
+// LowerEntry returns the entry with the greatest key strictly before the given key in the order of this view.
+func (s *subMap[K, V]) LowerEntry(key K) (collections.MapEntry[K, V], error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return entryOf("LowerEntry", s.lower(key))
+}
+
+// LowerKey returns the greatest key strictly before the given key in the order of this view.
+func (s *subMap[K, V]) LowerKey(key K) (K, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return keyOf("LowerKey", s.lower(key))
+}
+
+// FirstEntry returns the first entry in the order of this view.
+func (s *subMap[K, V]) FirstEntry() (collections.MapEntry[K, V], error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return entryOf("FirstEntry", s.first())
+}
+
+// LastEntry returns the last entry in the order of this view.
+func (s *subMap[K, V]) LastEntry() (collections.MapEntry[K, V], error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+	return entryOf("LastEntry", s.last())
+}
+
+// PollFirstEntry removes and returns the first entry in the order of this view.
+func (s *subMap[K, V]) PollFirstEntry() (collections.MapEntry[K, V], error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	return s.m.pollNode("PollFirstEntry", s.first())
+}
+
+// PollLastEntry removes and returns the last entry in the order of this view.
+func (s *subMap[K, V]) PollLastEntry() (collections.MapEntry[K, V], error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	return s.m.pollNode("PollLastEntry", s.last())
+}
+
+// DescendingMap returns a reverse order view of the mappings contained in this view.
+func (s *subMap[K, V]) DescendingMap() collections.NavigableMap[K, V] {
+	view := *s
+	view.descending = !s.descending
+	return &view
+}
+
+// NavigableKeySet returns a NavigableSet view of the keys contained in this view.
+func (s *subMap[K, V]) NavigableKeySet() collections.NavigableSet[K] {
+	return &treeKeySet[K, V]{m: s}
+}
+
+// DescendingKeySet returns a reverse order NavigableSet view of the keys contained in this view.
+func (s *subMap[K, V]) DescendingKeySet() collections.NavigableSet[K] {
+	view := *s
+	view.descending = !s.descending
+	return &treeKeySet[K, V]{m: &view}
+}
+
+// All returns a sequence over the entries in the order of this view.
+// The read lock is released while the loop body runs. If the map is modified
+// meanwhile, iteration continues with the key that follows the last one yielded.
+func (s *subMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s.m.mu.RLock()
+		node := s.first()
+		for node != nil {
+			key, value := node.key, node.value
+			modCount := s.m.modCount
+			s.m.mu.RUnlock()
+			if !yield(key, value) {
+				return
+			}
+			s.m.mu.RLock()
+			if s.m.modCount != modCount {
+				// The node may have been removed or reused, so find the position again by key
+				node = s.higher(key)
+			} else {
+				node = s.next(node)
+			}
+		}
+		s.m.mu.RUnlock()
+	}
+}
+
+// Backward returns a sequence over the entries in the reverse order of this view.
+func (s *subMap[K, V]) Backward() iter.Seq2[K, V] {
+	view := *s
+	view.descending = !s.descending
+	return view.All()
+}
+
+// Keys returns a sequence over the keys in the order of this view.
+func (s *subMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(s.All())
+}
+
+// AllValues returns a sequence over the values in the order of this view.
+func (s *subMap[K, V]) AllValues() iter.Seq[V] {
+	return valuesOf(s.All())
+}
+
+// headMap returns a view of the keys that come before toKey in the order of this view.
+func (s *subMap[K, V]) headMap(toKey K, inclusive bool) (*subMap[K, V], error) {
+	if !s.inRange(toKey) && !s.onOpenBound(toKey) {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "TreeMap", "HeadMap")
+	}
+	view := *s
+	if s.descending {
+		view.fromStart = false
+		view.lo = toKey
+		view.loInclusive = inclusive
+	} else {
+		view.toEnd = false
+		view.hi = toKey
+		view.hiInclusive = inclusive
+	}
+	return &view, nil
+}
+
+// tailMap returns a view of the keys that come after fromKey in the order of this view.
+func (s *subMap[K, V]) tailMap(fromKey K, inclusive bool) (*subMap[K, V], error) {
+	if !s.inRange(fromKey) && !s.onOpenBound(fromKey) {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "TreeMap", "TailMap")
+	}
+	view := *s
+	if s.descending {
+		view.toEnd = false
+		view.hi = fromKey
+		view.hiInclusive = inclusive
+	} else {
+		view.fromStart = false
+		view.lo = fromKey
+		view.loInclusive = inclusive
+	}
+	return &view, nil
+}
+
+// compare compares two keys according to the order of this view.
+func (s *subMap[K, V]) compare(a, b K) int {
+	if s.descending {
+		return s.m.comparator.Compare(b, a)
+	}
+	return s.m.comparator.Compare(a, b)
+}
+
+// Range checks. All of the helpers below assume the lock is already held.
+
+func (s *subMap[K, V]) tooLow(key K) bool {
+	if s.fromStart {
+		return false
+	}
+	c := s.m.comparator.Compare(key, s.lo)
+	return c < 0 || (c == 0 && !s.loInclusive)
+}
+
+func (s *subMap[K, V]) tooHigh(key K) bool {
+	if s.toEnd {
+		return false
+	}
+	c := s.m.comparator.Compare(key, s.hi)
+	return c > 0 || (c == 0 && !s.hiInclusive)
+}
+
+func (s *subMap[K, V]) inRange(key K) bool {
+	return !s.tooLow(key) && !s.tooHigh(key)
+}
+
+// onOpenBound reports whether key equals an exclusive bound of this view.
+// Such a key is not part of the view but is still a valid bound for a nested view.
+func (s *subMap[K, V]) onOpenBound(key K) bool {
+	if !s.fromStart && !s.loInclusive && s.m.comparator.Compare(key, s.lo) == 0 {
+		return true
+	}
+	return !s.toEnd && !s.hiInclusive && s.m.comparator.Compare(key, s.hi) == 0
+}
+
+// Navigation in ascending (absolute) order, restricted to the range of this view.
+
+func (s *subMap[K, V]) absLowest() *Node[K, V] {
+	var node *Node[K, V]
+	switch {
+	case s.fromStart:
+		node = s.m.getFirstNode(s.m.root)
+	case s.loInclusive:
+		node = s.m.getCeilingNode(s.lo)
+	default:
+		node = s.m.getHigherNode(s.lo)
+	}
+	if node == nil || s.tooHigh(node.key) {
+		return nil
+	}
+	return node
+}
+
+func (s *subMap[K, V]) absHighest() *Node[K, V] {
+	var node *Node[K, V]
+	switch {
+	case s.toEnd:
+		node = s.m.getLastNode(s.m.root)
+	case s.hiInclusive:
+		node = s.m.getFloorNode(s.hi)
+	default:
+		node = s.m.getLowerNode(s.hi)
+	}
+	if node == nil || s.tooLow(node.key) {
+		return nil
+	}
+	return node
+}
+
+func (s *subMap[K, V]) absCeiling(key K) *Node[K, V] {
+	if s.tooLow(key) {
+		return s.absLowest()
+	}
+	node := s.m.getCeilingNode(key)
+	if node == nil || s.tooHigh(node.key) {
+		return nil
+	}
+	return node
+}
+
+func (s *subMap[K, V]) absHigher(key K) *Node[K, V] {
+	if s.tooLow(key) {
+		return s.absLowest()
+	}
+	node := s.m.getHigherNode(key)
+	if node == nil || s.tooHigh(node.key) {
+		return nil
+	}
+	return node
+}
+
+func (s *subMap[K, V]) absFloor(key K) *Node[K, V] {
+	if s.tooHigh(key) {
+		return s.absHighest()
+	}
+	node := s.m.getFloorNode(key)
+	if node == nil || s.tooLow(node.key) {
+		return nil
+	}
+	return node
+}
+
+func (s *subMap[K, V]) absLower(key K) *Node[K, V] {
+	if s.tooHigh(key) {
+		return s.absHighest()
+	}
+	node := s.m.getLowerNode(key)
+	if node == nil || s.tooLow(node.key) {
+		return nil
+	}
+	return node
+}
+
+func (s *subMap[K, V]) absSuccessor(node *Node[K, V]) *Node[K, V] {
+	next := successor(node)
+	if next == nil || s.tooHigh(next.key) {
+		return nil
+	}
+	return next
+}
+
+func (s *subMap[K, V]) absPredecessor(node *Node[K, V]) *Node[K, V] {
+	prev := predecessor(node)
+	if prev == nil || s.tooLow(prev.key) {
+		return nil
+	}
+	return prev
+}
+
+// Navigation in the order of this view.
+
+func (s *subMap[K, V]) first() *Node[K, V] {
+	if s.descending {
+		return s.absHighest()
+	}
+	return s.absLowest()
+}
+
+func (s *subMap[K, V]) last() *Node[K, V] {
+	if s.descending {
+		return s.absLowest()
+	}
+	return s.absHighest()
+}
+
+func (s *subMap[K, V]) next(node *Node[K, V]) *Node[K, V] {
+	if s.descending {
+		return s.absPredecessor(node)
+	}
+	return s.absSuccessor(node)
+}
+
+func (s *subMap[K, V]) ceiling(key K) *Node[K, V] {
+	if s.descending {
+		return s.absFloor(key)
+	}
+	return s.absCeiling(key)
+}
+
+func (s *subMap[K, V]) floor(key K) *Node[K, V] {
+	if s.descending {
+		return s.absCeiling(key)
+	}
+	return s.absFloor(key)
+}
+
+func (s *subMap[K, V]) higher(key K) *Node[K, V] {
+	if s.descending {
+		return s.absLower(key)
+	}
+	return s.absHigher(key)
+}
+
+func (s *subMap[K, V]) lower(key K) *Node[K, V] {
+	if s.descending {
+		return s.absHigher(key)
+	}
+	return s.absLower(key)
+}
+
+// treeKeySet is a NavigableSet view of the keys of a TreeMap or one of its views.
+// Removing a key from the set removes the mapping from the map.
+// Adding keys is not supported because there is no value to associate with them.
+type treeKeySet[K comparable, V any] struct {
+	m *subMap[K, V]
+}
+
+// Add is not supported by a key set view and always returns false.
+func (ks *treeKeySet[K, V]) Add(element K) bool {
+	return false
+}
+
+// AddAll is not supported by a key set view and always returns false.
+func (ks *treeKeySet[K, V]) AddAll(collection collections.Collection[K]) bool {
+	return false
+}
+
+// Clear removes all mappings in the range of this set from the map.
+func (ks *treeKeySet[K, V]) Clear() {
+	ks.m.Clear()
+}
+
+// Contains returns true if the map contains a mapping for the specified key.
+func (ks *treeKeySet[K, V]) Contains(element K) bool {
+	return ks.m.HasKey(element)
+}
+
+// ContainsAll returns true if this set contains all elements from the specified collection.
+func (ks *treeKeySet[K, V]) ContainsAll(collection collections.Collection[K]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "TreeMap", "KeySet.ContainsAll")
+	}
+	for _, element := range collection.ToArray() {
+		if !ks.Contains(element) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Equals returns true if the specified collection contains exactly the same elements as this set.
+func (ks *treeKeySet[K, V]) Equals(collection collections.Collection[K]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	if len(elements) != ks.Size() {
+		return false
+	}
+	for _, element := range elements {
+		if !ks.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty returns true if this set contains no elements.
+func (ks *treeKeySet[K, V]) IsEmpty() bool {
+	return ks.m.IsEmpty()
+}
+
+// Iterator returns an iterator over the keys in the order of this set.
+// The iterator fails with ConcurrentModificationError if the map is structurally
+// modified after the iterator is created.
+func (ks *treeKeySet[K, V]) Iterator() collections.Iterator[K] {
+	return newTreeIterator(ks.m, keyOfNode[K, V])
+}
+
+// Remove removes the mapping for the specified key from the map if it is present.
+func (ks *treeKeySet[K, V]) Remove(element K) bool {
+	ks.m.m.mu.Lock()
+	defer ks.m.m.mu.Unlock()
+	if !ks.m.inRange(element) {
+		return false
+	}
+	node := ks.m.m.getNode(element)
+	if node == nil {
+		return false
+	}
+	ks.m.m.removeNode(node)
+	return true
+}
+
+// RemoveAll removes the mappings for all keys contained in the specified collection.
+func (ks *treeKeySet[K, V]) RemoveAll(collection collections.Collection[K]) bool {
+	if collection == nil {
+		return false
+	}
+	modified := false
+	for _, element := range collection.ToArray() {
+		if ks.Remove(element) {
+			modified = true
+		}
+	}
+	return modified
+}
+
+// Size returns the number of keys in this set.
+func (ks *treeKeySet[K, V]) Size() int {
+	return ks.m.Size()
+}
+
+// ToArray returns a slice containing all keys in the order of this set.
+func (ks *treeKeySet[K, V]) ToArray() []K {
+	return collectNodes(ks.m, keyOfNode[K, V])
+}
+
+// Comparator returns the comparator used to order the keys in this set.
+func (ks *treeKeySet[K, V]) Comparator() collections.Comparator[K] {
+	return ks.m.Comparator()
+}
+
+// First returns the first key in the order of this set.
+func (ks *treeKeySet[K, V]) First() (*K, error) {
+	return keyPointer(ks.m.FirstKey())
+}
+
+// Last returns the last key in the order of this set.
+func (ks *treeKeySet[K, V]) Last() (*K, error) {
+	return keyPointer(ks.m.LastKey())
+}
+
+// HeadSet returns a view of the keys that come strictly before toElement.
+func (ks *treeKeySet[K, V]) HeadSet(toElement K) (collections.SortedSet[K], error) {
+	view, err := ks.m.headMap(toElement, false)
+	if err != nil {
+		return nil, err
+	}
+	return &treeKeySet[K, V]{m: view}, nil
+}
+
+// TailSet returns a view of the keys that come at or after fromElement.
+func (ks *treeKeySet[K, V]) TailSet(fromElement K) (collections.SortedSet[K], error) {
+	view, err := ks.m.tailMap(fromElement, true)
+	if err != nil {
+		return nil, err
+	}
+	return &treeKeySet[K, V]{m: view}, nil
+}
+
+// SubSet returns a view of the keys ranging from fromElement, inclusive, to toElement, exclusive.
+func (ks *treeKeySet[K, V]) SubSet(fromElement K, toElement K) (collections.SortedSet[K], error) {
+	view, err := ks.m.SubMap(fromElement, toElement)
+	if err != nil {
+		return nil, err
+	}
+	return &treeKeySet[K, V]{m: view.(*subMap[K, V])}, nil
+}
+
+// Ceiling returns the least key at or after the given key in the order of this set.
+func (ks *treeKeySet[K, V]) Ceiling(e K) (*K, error) {
+	return keyPointer(ks.m.CeilingKey(e))
+}
+
+// Floor returns the greatest key at or before the given key in the order of this set.
+func (ks *treeKeySet[K, V]) Floor(e K) (*K, error) {
+	return keyPointer(ks.m.FloorKey(e))
+}
+
+// Higher returns the least key strictly after the given key in the order of this set.
+func (ks *treeKeySet[K, V]) Higher(e K) (*K, error) {
+	return keyPointer(ks.m.HigherKey(e))
+}
+
+// Lower returns the greatest key strictly before the given key in the order of this set.
+func (ks *treeKeySet[K, V]) Lower(e K) (*K, error) {
+	return keyPointer(ks.m.LowerKey(e))
+}
+
+// PollFirst removes the first key in the order of this set, along with its mapping.
+func (ks *treeKeySet[K, V]) PollFirst() (*K, error) {
+	entry, err := ks.m.PollFirstEntry()
+	if err != nil {
+		return nil, err
+	}
+	key := entry.GetKey()
+	return &key, nil
+}
+
+// PollLast removes the last key in the order of this set, along with its mapping.
+func (ks *treeKeySet[K, V]) PollLast() (*K, error) {
+	entry, err := ks.m.PollLastEntry()
+	if err != nil {
+		return nil, err
+	}
+	key := entry.GetKey()
+	return &key, nil
+}
+
+// DescendingSet returns a reverse order view of the keys in this set.
+func (ks *treeKeySet[K, V]) DescendingSet() collections.NavigableSet[K] {
+	return ks.m.DescendingKeySet()
+}
+
+// DescendingIterator returns an iterator over the keys in this set in reverse order.
+func (ks *treeKeySet[K, V]) DescendingIterator() collections.Iterator[K] {
+	return ks.DescendingSet().Iterator()
+}
+
+// All returns a sequence over the keys in the order of this set.
+func (ks *treeKeySet[K, V]) All() iter.Seq[K] {
+	return ks.m.Keys()
+}
+
+// Backward returns a sequence over the keys in the reverse order of this set.
+func (ks *treeKeySet[K, V]) Backward() iter.Seq[K] {
+	return keysOf(ks.m.Backward())
+}
+
+// keyPointer adapts a (K, error) result to the (*K, error) form used by sets.
+func keyPointer[K any](key K, err error) (*K, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// treeEntrySet is a Set view of the entries of a TreeMap or one of its views.
+// Entries are snapshots of the mappings at the time they are returned.
+// Removing an entry from the set removes the mapping from the map.
+// Adding entries is not supported.
+type treeEntrySet[K comparable, V any] struct {
+	m *subMap[K, V]
+}
+
+// Add is not supported by an entry set view and always returns false.
+func (es *treeEntrySet[K, V]) Add(element collections.MapEntry[K, V]) bool {
+	return false
+}
+
+// AddAll is not supported by an entry set view and always returns false.
+func (es *treeEntrySet[K, V]) AddAll(collection collections.Collection[collections.MapEntry[K, V]]) bool {
+	return false
+}
+
+// Clear removes all mappings in the range of this set from the map.
+func (es *treeEntrySet[K, V]) Clear() {
+	es.m.Clear()
+}
+
+// Contains returns true if the map contains a mapping equal to the specified entry.
+func (es *treeEntrySet[K, V]) Contains(element collections.MapEntry[K, V]) bool {
+	if element == nil {
+		return false
+	}
+	es.m.m.mu.RLock()
+	defer es.m.m.mu.RUnlock()
+	return es.getNode(element) != nil
+}
+
+// ContainsAll returns true if this set contains all entries from the specified collection.
+func (es *treeEntrySet[K, V]) ContainsAll(collection collections.Collection[collections.MapEntry[K, V]]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "TreeMap", "EntrySet.ContainsAll")
+	}
+	for _, element := range collection.ToArray() {
+		if !es.Contains(element) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Equals returns true if the specified collection contains exactly the same entries as this set.
+func (es *treeEntrySet[K, V]) Equals(collection collections.Collection[collections.MapEntry[K, V]]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	if len(elements) != es.Size() {
+		return false
+	}
+	for _, element := range elements {
+		if !es.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty returns true if this set contains no entries.
+func (es *treeEntrySet[K, V]) IsEmpty() bool {
+	return es.m.IsEmpty()
+}
+
+// Iterator returns an iterator over the entries in key order.
+// The iterator fails with ConcurrentModificationError if the map is structurally
+// modified after the iterator is created.
+func (es *treeEntrySet[K, V]) Iterator() collections.Iterator[collections.MapEntry[K, V]] {
+	return newTreeIterator(es.m, entryOfNode[K, V])
+}
+
+// All returns a sequence over the entries in key order.
+func (es *treeEntrySet[K, V]) All() iter.Seq[collections.MapEntry[K, V]] {
+	return func(yield func(collections.MapEntry[K, V]) bool) {
+		for key, value := range es.m.All() {
+			if !yield(collections.NewHashMapEntry(key, value)) {
+				return
+			}
+		}
+	}
+}
+
+// Remove removes the mapping matching the specified entry from the map if it is present.
+func (es *treeEntrySet[K, V]) Remove(element collections.MapEntry[K, V]) bool {
+	if element == nil {
+		return false
+	}
+	es.m.m.mu.Lock()
+	defer es.m.m.mu.Unlock()
+	node := es.getNode(element)
+	if node == nil {
+		return false
+	}
+	es.m.m.removeNode(node)
+	return true
+}
+
+// RemoveAll removes the mappings matching the entries contained in the specified collection.
+func (es *treeEntrySet[K, V]) RemoveAll(collection collections.Collection[collections.MapEntry[K, V]]) bool {
+	if collection == nil {
+		return false
+	}
+	modified := false
+	for _, element := range collection.ToArray() {
+		if es.Remove(element) {
+			modified = true
+		}
+	}
+	return modified
+}
+
+// Size returns the number of entries in this set.
+func (es *treeEntrySet[K, V]) Size() int {
+	return es.m.Size()
+}
+
+// ToArray returns a slice containing all entries in key order.
+func (es *treeEntrySet[K, V]) ToArray() []collections.MapEntry[K, V] {
+	return collectNodes(es.m, entryOfNode[K, V])
+}
+
+// getNode returns the node matching both the key and the value of the entry.
+// It assumes the lock is already held.
+func (es *treeEntrySet[K, V]) getNode(element collections.MapEntry[K, V]) *Node[K, V] {
+	if !es.m.inRange(element.GetKey()) {
+		return nil
+	}
+	node := es.m.m.getNode(element.GetKey())
+	if node == nil || !equalValues(es.m.m.values, node.value, element.GetValue()) {
+		return nil
+	}
+	return node
+}
+
+// treeValues is a Collection view of the values of a TreeMap or one of its views.
+// Values are ordered by their keys and duplicates are kept.
+// Removing a value removes the first mapping to it. Adding values is not supported.
+type treeValues[K comparable, V any] struct {
+	m *subMap[K, V]
+}
+
+// Add is not supported by a values view and always returns false.
+func (vs *treeValues[K, V]) Add(element V) bool {
+	return false
+}
+
+// AddAll is not supported by a values view and always returns false.
+func (vs *treeValues[K, V]) AddAll(collection collections.Collection[V]) bool {
+	return false
+}
+
+// Clear removes all mappings in the range of this collection from the map.
+func (vs *treeValues[K, V]) Clear() {
+	vs.m.Clear()
+}
+
+// Contains returns true if the map maps one or more keys to the specified value.
+func (vs *treeValues[K, V]) Contains(element V) bool {
+	return vs.m.HasValue(element)
+}
+
+// ContainsAll returns true if this collection contains all values from the specified collection.
+func (vs *treeValues[K, V]) ContainsAll(collection collections.Collection[V]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "TreeMap", "Values.ContainsAll")
+	}
+	for _, element := range collection.ToArray() {
+		if !vs.Contains(element) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Equals returns true if the specified collection contains the same values in the same order.
+func (vs *treeValues[K, V]) Equals(collection collections.Collection[V]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	values := vs.ToArray()
+	if len(elements) != len(values) {
+		return false
+	}
+	for i := range values {
+		if !equalValues(vs.m.m.values, values[i], elements[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty returns true if this collection contains no values.
+func (vs *treeValues[K, V]) IsEmpty() bool {
+	return vs.m.IsEmpty()
+}
+
+// Iterator returns an iterator over the values in key order.
+// The iterator fails with ConcurrentModificationError if the map is structurally
+// modified after the iterator is created.
+func (vs *treeValues[K, V]) Iterator() collections.Iterator[V] {
+	return newTreeIterator(vs.m, valueOfNode[K, V])
+}
+
+// All returns a sequence over the values in key order.
+func (vs *treeValues[K, V]) All() iter.Seq[V] {
+	return vs.m.AllValues()
+}
+
+// Remove removes the first mapping, in key order, to the specified value.
+func (vs *treeValues[K, V]) Remove(element V) bool {
+	vs.m.m.mu.Lock()
+	defer vs.m.m.mu.Unlock()
+	for node := vs.m.first(); node != nil; node = vs.m.next(node) {
+		if equalValues(vs.m.m.values, node.value, element) {
+			vs.m.m.removeNode(node)
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveAll removes all mappings to values contained in the specified collection.
+func (vs *treeValues[K, V]) RemoveAll(collection collections.Collection[V]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	remove := valueLookup(vs.m.m.values, elements)
+
+	vs.m.m.mu.Lock()
+	defer vs.m.m.mu.Unlock()
+
+	modified := false
+	for node := vs.m.first(); node != nil; {
+		if !remove(node.value) {
+			node = vs.m.next(node)
+			continue
+		}
+		// Removing a node with two children moves its successor's mapping into it,
+		// so look the next key up again rather than following the old links.
+		key := node.key
+		vs.m.m.removeNode(node)
+		node = vs.m.higher(key)
+		modified = true
+	}
+	return modified
+}
+
+// Size returns the number of values in this collection, including duplicates.
+func (vs *treeValues[K, V]) Size() int {
+	return vs.m.Size()
+}
+
+// ToArray returns a slice containing all values in key order.
+func (vs *treeValues[K, V]) ToArray() []V {
+	return collectNodes(vs.m, valueOfNode[K, V])
+}
+
+func keyOfNode[K comparable, V any](node *Node[K, V]) K {
+	return node.key
+}
+
+func valueOfNode[K comparable, V any](node *Node[K, V]) V {
+	return node.value
+}
+
+func entryOfNode[K comparable, V any](node *Node[K, V]) collections.MapEntry[K, V] {
+	return collections.NewHashMapEntry(node.key, node.value)
+}
+
+// collectNodes returns the result of extract for every node of the view, in the order of the view.
+func collectNodes[K comparable, V any, T any](view *subMap[K, V], extract func(*Node[K, V]) T) []T {
+	view.m.mu.RLock()
+	defer view.m.mu.RUnlock()
+	result := make([]T, 0)
+	for node := view.first(); node != nil; node = view.next(node) {
+		result = append(result, extract(node))
+	}
+	return result
+}
+
+// treeIterator iterates over the nodes of a TreeMap view, returning the result of extract for each.
+type treeIterator[K comparable, V any, T any] struct {
+	view             *subMap[K, V]
+	next             *Node[K, V]
+	expectedModCount int
+	extract          func(*Node[K, V]) T
+}
+
+func newTreeIterator[K comparable, V any, T any](view *subMap[K, V], extract func(*Node[K, V]) T) *treeIterator[K, V, T] {
+	view.m.mu.RLock()
+	defer view.m.mu.RUnlock()
+	return &treeIterator[K, V, T]{
+		view:             view,
+		next:             view.first(),
+		expectedModCount: view.m.modCount,
+		extract:          extract,
+	}
+}
+
+// HasNext returns true if the iteration has more elements.
+func (it *treeIterator[K, V, T]) HasNext() bool {
+	return it.next != nil
+}
+
+// Next returns the next element in the iteration.
+func (it *treeIterator[K, V, T]) Next() (*T, error) {
+	it.view.m.mu.RLock()
+	defer it.view.m.mu.RUnlock()
+
+	if it.view.m.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "TreeMap", "Iterator.Next")
+	}
+	if it.next == nil {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "TreeMap", "Iterator.Next")
+	}
+	value := it.extract(it.next)
+	it.next = it.view.next(it.next)
+	return &value, nil
+}
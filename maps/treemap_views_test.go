@@ -0,0 +1,449 @@
+package maps
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// newIntTreeMap returns a TreeMap holding key -> "v<key>" for each key.
+func newIntTreeMap(keys ...int) SortedMap[int, string] {
+	tm := NewTreeMap[int, string](&IntComparator{})
+	for _, k := range keys {
+		tm.Put(k, fmt.Sprintf("v%d", k))
+	}
+	return tm
+}
+
+// navigableKeys returns the keys of the map in iteration order.
+func navigableKeys(m collections.NavigableMap[int, string]) []int {
+	return m.NavigableKeySet().ToArray()
+}
+
+func TestTreeMap_HeadTailSubMap(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+
+	head, err := tm.HeadMap(30)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, head.Size())
+	assert.True(t, head.HasKey(20))
+	assert.False(t, head.HasKey(30), "HeadMap should exclude toKey")
+	assert.Nil(t, head.Get(40))
+
+	tail, err := tm.TailMap(30)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, tail.Size())
+	assert.True(t, tail.HasKey(30), "TailMap should include fromKey")
+	firstKey, err := tail.FirstKey()
+	assert.NoError(t, err)
+	assert.Equal(t, 30, firstKey)
+
+	sub, err := tm.SubMap(20, 40)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{20, 30}, navigableKeys(sub.(collections.NavigableMap[int, string])))
+	lastKey, err := sub.LastKey()
+	assert.NoError(t, err)
+	assert.Equal(t, 30, lastKey)
+
+	empty, err := tm.SubMap(25, 25)
+	assert.NoError(t, err)
+	assert.True(t, empty.IsEmpty())
+	_, err = empty.FirstKey()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+
+	_, err = tm.SubMap(40, 20)
+	assert.EqualError(t, err, string(errcodes.IllegalArgumentError))
+}
+
+func TestTreeMap_NestedViewBounds(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+	sub, err := tm.SubMap(20, 40)
+	assert.NoError(t, err)
+
+	// The exclusive upper bound is still a valid bound for a nested view
+	head, err := sub.HeadMap(40)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, head.Size())
+
+	_, err = sub.HeadMap(45)
+	assert.EqualError(t, err, string(errcodes.IllegalArgumentError))
+	_, err = sub.TailMap(10)
+	assert.EqualError(t, err, string(errcodes.IllegalArgumentError))
+	_, err = sub.SubMap(15, 30)
+	assert.EqualError(t, err, string(errcodes.IllegalArgumentError))
+
+	nested, err := sub.SubMap(25, 40)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, nested.Size())
+}
+
+func TestTreeMap_ViewsAreLive(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+	sub, err := tm.SubMap(20, 40)
+	assert.NoError(t, err)
+
+	// Changes to the map show up in the view
+	tm.Put(25, "v25")
+	tm.Put(45, "v45")
+	assert.Equal(t, 3, sub.Size())
+	assert.Equal(t, "v25", *sub.Get(25))
+
+	// Changes through the view show up in the map
+	sub.Put(35, "v35")
+	assert.Equal(t, "v35", *tm.Get(35))
+	assert.Equal(t, "v30", sub.Remove(30))
+	assert.False(t, tm.HasKey(30))
+	assert.Equal(t, "v20", sub.Replace(20, "twenty"))
+	assert.Equal(t, "twenty", *tm.Get(20))
+
+	// Writes outside the range are ignored
+	assert.Equal(t, "", sub.Put(60, "v60"))
+	assert.False(t, tm.HasKey(60))
+	assert.Equal(t, "", sub.Remove(10))
+	assert.True(t, tm.HasKey(10))
+	assert.Equal(t, "", sub.PutIfAbsent(5, "v5"))
+	assert.False(t, tm.HasKey(5))
+
+	// Clearing the view only removes its range
+	sub.Clear()
+	assert.True(t, sub.IsEmpty())
+	assert.Equal(t, []int{10, 40, 45, 50}, navigableKeys(tm))
+	assert.True(t, tm.(*TreeMap[int, string]).verifyRedBlackProperties())
+}
+
+func TestTreeMap_ViewPutAllAndConditionalOps(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30)
+	tail, err := tm.TailMap(20)
+	assert.NoError(t, err)
+
+	tail.PutAll(newIntTreeMap(5, 25, 35))
+	assert.Equal(t, []int{10, 20, 25, 30, 35}, navigableKeys(tm))
+
+	assert.Equal(t, "v20", tail.PutIfAbsent(20, "other"))
+	assert.False(t, tail.ReplaceKeyWithValue(10, "v10", "ten"))
+	assert.True(t, tail.ReplaceKeyWithValue(20, "v20", "twenty"))
+	assert.False(t, tail.RemoveKeyWithValue(10, "v10"))
+	assert.True(t, tail.RemoveKeyWithValue(25, "v25"))
+	assert.True(t, tail.HasValue("twenty"))
+	assert.False(t, tail.HasValue("v10"))
+
+	expected := newIntTreeMap(30, 35)
+	expected.Put(20, "twenty")
+	assert.True(t, tail.Equals(expected))
+	assert.False(t, tail.Equals(tm))
+	assert.Equal(t, 3, tail.EntrySet().Size())
+	assert.Equal(t, 3, tail.KeySet().Size())
+	assert.Equal(t, 3, tail.Values().Size())
+}
+
+func TestTreeMap_ViewComputeAndMerge(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30)
+	sub, err := tm.SubMap(15, 30)
+	assert.NoError(t, err)
+	appendX := func(k int, v *string) (string, bool) {
+		if v == nil {
+			return "new", true
+		}
+		return *v + "x", true
+	}
+
+	value, err := sub.Compute(20, appendX)
+	assert.NoError(t, err)
+	assert.Equal(t, "v20x", *value)
+	value, err = sub.Compute(25, appendX)
+	assert.NoError(t, err)
+	assert.Equal(t, "new", *value)
+	value, err = sub.Merge(25, "y", func(old, v string) (string, bool) { return old + v, true })
+	assert.NoError(t, err)
+	assert.Equal(t, "newy", *value)
+	value, err = sub.ComputeIfPresent(20, func(k int, v string) (string, bool) { return "", false })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.Equal(t, []int{10, 25, 30}, navigableKeys(tm))
+
+	// Keys outside the view are rejected, or absent for ComputeIfPresent
+	_, err = sub.Compute(30, appendX)
+	assert.ErrorIs(t, err, errcodes.ErrIllegalArgument)
+	_, err = sub.Merge(10, "y", func(old, v string) (string, bool) { return old + v, true })
+	assert.ErrorIs(t, err, errcodes.ErrIllegalArgument)
+	value, err = sub.ComputeIfPresent(10, func(k int, v string) (string, bool) { return "changed", true })
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	assert.Equal(t, "v10", *tm.Get(10))
+
+	_, err = sub.Compute(20, nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+	_, err = sub.ComputeIfPresent(20, nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+	_, err = sub.Merge(20, "y", nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+}
+
+func TestTreeMap_DescendingMap(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+	desc := tm.DescendingMap()
+
+	assert.Equal(t, []int{50, 40, 30, 20, 10}, navigableKeys(desc))
+	assert.Equal(t, 1, desc.Comparator().Compare(1, 2))
+
+	first, err := desc.FirstKey()
+	assert.NoError(t, err)
+	assert.Equal(t, 50, first)
+
+	// Navigation is mirrored in a descending view
+	key, err := desc.HigherKey(30)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, key)
+	key, err = desc.LowerKey(30)
+	assert.NoError(t, err)
+	assert.Equal(t, 40, key)
+	key, err = desc.CeilingKey(35)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, key)
+	key, err = desc.FloorKey(35)
+	assert.NoError(t, err)
+	assert.Equal(t, 40, key)
+
+	head, err := desc.HeadMap(30)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{50, 40}, navigableKeys(head.(collections.NavigableMap[int, string])))
+
+	entry, err := desc.PollFirstEntry()
+	assert.NoError(t, err)
+	assert.Equal(t, 50, entry.GetKey())
+	assert.False(t, tm.HasKey(50))
+
+	assert.Equal(t, []int{10, 20, 30, 40}, navigableKeys(desc.DescendingMap()))
+}
+
+func TestTreeMap_NavigableKeySet(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+	keys := tm.NavigableKeySet()
+
+	assert.Equal(t, 5, keys.Size())
+	assert.True(t, keys.Contains(30))
+	assert.False(t, keys.Add(60), "key set views do not support Add")
+
+	ceiling, err := keys.Ceiling(25)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, *ceiling)
+
+	_, err = keys.Higher(50)
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+
+	// Removing a key removes the mapping
+	assert.True(t, keys.Remove(30))
+	assert.False(t, tm.HasKey(30))
+
+	polled, err := keys.PollFirst()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, *polled)
+
+	head, err := keys.HeadSet(40)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{20}, head.ToArray())
+
+	assert.Equal(t, []int{50, 40, 20}, tm.DescendingKeySet().ToArray())
+	assert.Equal(t, []int{50, 40, 20}, keys.DescendingSet().ToArray())
+
+	var got []int
+	for it := keys.DescendingIterator(); it.HasNext(); {
+		k, err := it.Next()
+		assert.NoError(t, err)
+		got = append(got, *k)
+	}
+	assert.Equal(t, []int{50, 40, 20}, got)
+}
+
+func TestTreeMap_KeySetIteratorConcurrentModification(t *testing.T) {
+	tm := newIntTreeMap(1, 2, 3)
+	it := tm.NavigableKeySet().Iterator()
+
+	k, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *k)
+
+	tm.Put(4, "v4")
+	_, err = it.Next()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+}
+
+func TestTreeMap_KeySetEntrySetValuesAreOrdered(t *testing.T) {
+	tm := NewTreeMap[int, string](&IntComparator{})
+	for _, k := range []int{5, 1, 4, 2, 3} {
+		tm.Put(k, "same")
+	}
+	tm.Put(3, "three")
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, tm.KeySet().ToArray())
+
+	values := tm.Values()
+	assert.Equal(t, 5, values.Size(), "Values should keep duplicates")
+	assert.Equal(t, []string{"same", "same", "three", "same", "same"}, values.ToArray())
+
+	var keys []int
+	for _, entry := range tm.EntrySet().ToArray() {
+		keys = append(keys, entry.GetKey())
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, keys)
+
+	var iterated []string
+	for it := values.Iterator(); it.HasNext(); {
+		v, err := it.Next()
+		assert.NoError(t, err)
+		iterated = append(iterated, *v)
+	}
+	assert.Equal(t, values.ToArray(), iterated)
+}
+
+func TestTreeMap_KeySetEntrySetValuesAreLive(t *testing.T) {
+	tm := newIntTreeMap(1, 2, 3)
+	keys := tm.KeySet()
+	entries := tm.EntrySet()
+	values := tm.Values()
+
+	tm.Put(4, "v4")
+	tm.Remove(1)
+	assert.Equal(t, []int{2, 3, 4}, keys.ToArray())
+	assert.Equal(t, []string{"v2", "v3", "v4"}, values.ToArray())
+	assert.Equal(t, 3, entries.Size())
+	assert.True(t, values.Contains("v4"))
+	assert.False(t, values.Contains("v1"))
+
+	// Removing through a view removes the mapping
+	assert.True(t, entries.Remove(collections.NewHashMapEntry(2, "v2")))
+	assert.False(t, entries.Remove(collections.NewHashMapEntry(3, "other")))
+	assert.True(t, values.Remove("v3"))
+	assert.False(t, values.Remove("v3"))
+	assert.Equal(t, []int{4}, keys.ToArray())
+
+	// Adding through a view is not supported
+	assert.False(t, keys.Add(9))
+	assert.False(t, values.Add("v9"))
+	assert.False(t, entries.Add(collections.NewHashMapEntry(9, "v9")))
+	assert.Equal(t, 1, tm.Size())
+
+	values.Clear()
+	assert.True(t, tm.IsEmpty())
+	assert.True(t, keys.IsEmpty())
+	assert.True(t, entries.IsEmpty())
+}
+
+func TestTreeMap_EntrySetContains(t *testing.T) {
+	tm := newIntTreeMap(1, 2, 3)
+	entries := tm.EntrySet()
+
+	assert.True(t, entries.Contains(collections.NewHashMapEntry(2, "v2")))
+	assert.False(t, entries.Contains(collections.NewHashMapEntry(2, "other")))
+	assert.False(t, entries.Contains(nil))
+
+	other := newIntTreeMap(1, 2, 3).EntrySet()
+	ok, err := entries.ContainsAll(other)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, entries.Equals(other))
+
+	_, err = entries.ContainsAll(nil)
+	assert.EqualError(t, err, string(errcodes.NullPointerError))
+
+	assert.True(t, entries.RemoveAll(newIntTreeMap(1, 3).EntrySet()))
+	assert.Equal(t, []int{2}, tm.KeySet().ToArray())
+}
+
+func TestTreeMap_ValuesRemoveAllAndEquals(t *testing.T) {
+	tm := NewTreeMap[int, string](&IntComparator{})
+	for i := 1; i <= 20; i++ {
+		tm.Put(i, fmt.Sprintf("v%d", i%3))
+	}
+	values := tm.Values()
+
+	removed := NewTreeMap[int, string](&IntComparator{})
+	removed.Put(0, "v1")
+	assert.True(t, values.RemoveAll(removed.Values()))
+	assert.False(t, values.Contains("v1"))
+	assert.Equal(t, 13, values.Size())
+	assert.True(t, tm.(*TreeMap[int, string]).verifyRedBlackProperties())
+
+	ok, err := values.ContainsAll(newIntTreeMap().Values())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	expected := NewTreeMap[int, string](&IntComparator{})
+	for i, v := range values.ToArray() {
+		expected.Put(i, v)
+	}
+	assert.True(t, values.Equals(expected.Values()))
+	assert.False(t, values.Equals(newIntTreeMap(1).Values()))
+}
+
+func TestTreeMap_SubMapViewsFollowRange(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+	sub, err := tm.SubMap(20, 50)
+	assert.NoError(t, err)
+	desc := sub.(collections.NavigableMap[int, string]).DescendingMap()
+
+	assert.Equal(t, []int{20, 30, 40}, sub.KeySet().ToArray())
+	assert.Equal(t, []string{"v40", "v30", "v20"}, desc.Values().ToArray())
+
+	// Entries outside the range are not part of the view
+	assert.False(t, sub.EntrySet().Contains(collections.NewHashMapEntry(10, "v10")))
+	assert.False(t, sub.EntrySet().Remove(collections.NewHashMapEntry(10, "v10")))
+	assert.False(t, sub.Values().Remove("v50"))
+	assert.True(t, tm.HasKey(50))
+}
+
+func TestTreeMap_ValuesIteratorConcurrentModification(t *testing.T) {
+	tm := newIntTreeMap(1, 2, 3)
+	it := tm.Values().Iterator()
+
+	_, err := it.Next()
+	assert.NoError(t, err)
+
+	tm.Remove(3)
+	_, err = it.Next()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+
+	entryIt := tm.EntrySet().Iterator()
+	for entryIt.HasNext() {
+		_, err := entryIt.Next()
+		assert.NoError(t, err)
+	}
+	_, err = entryIt.Next()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+}
+
+func TestTreeMapViews_All(t *testing.T) {
+	tm := NewTreeMap[int, string](&IntComparator{})
+	for i := 1; i <= 6; i++ {
+		tm.Put(i, fmt.Sprint(i))
+	}
+
+	sub, err := tm.SubMap(2, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4}, slices.Collect(sub.Keys()))
+	assert.Equal(t, []string{"2", "3", "4"}, slices.Collect(sub.AllValues()))
+
+	desc := tm.DescendingMap()
+	assert.Equal(t, []int{6, 5, 4, 3, 2, 1}, slices.Collect(desc.Keys()))
+	var keys []int
+	for k := range desc.Backward() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, keys)
+
+	keySet := tm.NavigableKeySet()
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, slices.Collect(keySet.All()))
+	assert.Equal(t, []int{6, 5, 4, 3, 2, 1}, slices.Collect(keySet.Backward()))
+	assert.Equal(t, []int{6, 5, 4, 3, 2, 1}, slices.Collect(tm.DescendingKeySet().All()))
+
+	var entries []string
+	for entry := range sub.EntrySet().All() {
+		entries = append(entries, fmt.Sprintf("%d=%s", entry.GetKey(), entry.GetValue()))
+	}
+	assert.Equal(t, []string{"2=2", "3=3", "4=4"}, entries)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5", "6"}, slices.Collect(tm.Values().All()))
+}
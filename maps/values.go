@@ -0,0 +1,68 @@
+package maps
+
+import (
+	"slices"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	"github.com/chiranjeevipavurala/gocollections/internal/equality"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+)
+
+// The maps compare values in HasValue, RemoveKeyWithValue, ReplaceKeyWithValue, Equals and
+// the Contains methods of their value collections. Maps made by the constructors that require
+// comparable values use ==; the WithValueEquality constructors accept any value type and use
+// the Equaler they are given.
+
+// comparableValues compares values with ==.
+type comparableValues[V comparable] struct{}
+
+func (comparableValues[V]) Equal(a, b V) bool { return a == b }
+
+func (comparableValues[V]) lookup(elements []V) func(V) bool {
+	set := make(map[V]struct{}, len(elements))
+	for _, element := range elements {
+		set[element] = struct{}{}
+	}
+	return func(value V) bool {
+		_, ok := set[value]
+		return ok
+	}
+}
+
+// deepValues compares values with equality.Equal, which falls back to reflect.DeepEqual
+// for values that == cannot compare.
+type deepValues[V any] struct{}
+
+func (deepValues[V]) Equal(a, b V) bool { return equality.Equal(a, b) }
+
+// valueEqualer returns equaler, or deepValues if it is nil.
+func valueEqualer[V any](equaler collections.Equaler[V]) collections.Equaler[V] {
+	if equaler == nil {
+		return deepValues[V]{}
+	}
+	return equaler
+}
+
+// equalValues compares a and b with equaler, which is nil in a zero map.
+func equalValues[V any](equaler collections.Equaler[V], a, b V) bool {
+	return valueEqualer(equaler).Equal(a, b)
+}
+
+// valueLookup returns a function reporting whether a value equals one of elements. It uses
+// a Go map when the values are compared with ==, and otherwise compares with each element.
+func valueLookup[V any](equaler collections.Equaler[V], elements []V) func(V) bool {
+	if l, ok := equaler.(interface{ lookup([]V) func(V) bool }); ok {
+		return l.lookup(elements)
+	}
+	equaler = valueEqualer(equaler)
+	return func(value V) bool {
+		return slices.ContainsFunc(elements, func(element V) bool { return equaler.Equal(element, value) })
+	}
+}
+
+// valueList returns a list of values whose Contains, IndexOf and Remove use equaler.
+func valueList[V any](values []V, equaler collections.Equaler[V]) collections.Collection[V] {
+	list := lists.NewArrayListWithEquality(valueEqualer(equaler))
+	list.AddAllBatch(values)
+	return list
+}
@@ -0,0 +1,420 @@
+package queues
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// ArrayBlockingQueue is a bounded blocking queue backed by a ring buffer.
+// Elements are ordered first-in-first-out. Put waits for space when the queue
+// is full and Take waits for an element when it is empty. The queue is safe
+// for use by any number of producers and consumers.
+type ArrayBlockingQueue[E comparable] struct {
+	items    []E
+	head     int // Index of the head element
+	count    int // Number of elements in the queue
+	mu       sync.Mutex
+	notEmpty condition // Signalled when an element is added
+	notFull  condition // Signalled when an element is removed
+}
+
+// NewArrayBlockingQueue creates a new ArrayBlockingQueue with the given fixed capacity.
+// It returns nil if the capacity is less than 1.
+func NewArrayBlockingQueue[E comparable](capacity int) *ArrayBlockingQueue[E] {
+	if capacity < 1 {
+		return nil
+	}
+	return &ArrayBlockingQueue[E]{
+		items: make([]E, capacity),
+	}
+}
+
+// Add inserts the specified element at the tail of this queue if there is space.
+// It returns false if the queue is full.
+func (q *ArrayBlockingQueue[E]) Add(element E) bool {
+	return q.Offer(element)
+}
+
+// AddAll adds the elements of the specified collection until the queue is full.
+// It returns true if at least one element was added.
+func (q *ArrayBlockingQueue[E]) AddAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	// Get elements first to avoid holding the lock while reading the other collection
+	elements := collection.ToArray()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	modified := false
+	for _, element := range elements {
+		if q.count == len(q.items) {
+			break
+		}
+		q.enqueue(element)
+		modified = true
+	}
+	return modified
+}
+
+// Clear removes all elements from this queue
+func (q *ArrayBlockingQueue[E]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var zero E
+	for i := 0; i < q.count; i++ {
+		q.items[q.index(i)] = zero
+	}
+	q.head = 0
+	q.count = 0
+	q.notFull.broadcast()
+}
+
+// Contains returns true if this queue contains the specified element
+func (q *ArrayBlockingQueue[E]) Contains(element E) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.indexOf(element) >= 0
+}
+
+// ContainsAll returns true if this queue contains all elements from the specified collection
+func (q *ArrayBlockingQueue[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "ArrayBlockingQueue", "ContainsAll")
+	}
+
+	elements := collection.ToArray()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, element := range elements {
+		if q.indexOf(element) < 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Equals returns true if the specified collection contains the same elements in the same order
+func (q *ArrayBlockingQueue[E]) Equals(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	elements := collection.ToArray()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(elements) != q.count {
+		return false
+	}
+	for i, element := range elements {
+		if q.items[q.index(i)] != element {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty returns true if this queue contains no elements
+func (q *ArrayBlockingQueue[E]) IsEmpty() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.count == 0
+}
+
+// Iterator returns an iterator over a snapshot of the elements in this queue, from head to tail
+func (q *ArrayBlockingQueue[E]) Iterator() collections.Iterator[E] {
+	return &blockingQueueIterator[E]{elements: q.ToArray()}
+}
+
+// All returns a sequence over a snapshot of the elements in this queue, from head to tail.
+// The snapshot is taken when iteration starts, so the loop body may modify the queue.
+func (q *ArrayBlockingQueue[E]) All() iter.Seq[E] {
+	return snapshotSeq(q.ToArray)
+}
+
+// Remove removes a single instance of the specified element from this queue
+func (q *ArrayBlockingQueue[E]) Remove(element E) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	i := q.indexOf(element)
+	if i < 0 {
+		return false
+	}
+	q.removeAt(i)
+	return true
+}
+
+// RemoveAll removes all elements from this queue that are also contained in the specified collection
+func (q *ArrayBlockingQueue[E]) RemoveAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	elements := collection.ToArray()
+	remove := make(map[E]bool, len(elements))
+	for _, element := range elements {
+		remove[element] = true
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	modified := false
+	for i := 0; i < q.count; {
+		if remove[q.items[q.index(i)]] {
+			q.removeAt(i)
+			modified = true
+		} else {
+			i++
+		}
+	}
+	return modified
+}
+
+// Size returns the number of elements in this queue
+func (q *ArrayBlockingQueue[E]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.count
+}
+
+// ToArray returns a slice containing all elements in this queue, from head to tail
+func (q *ArrayBlockingQueue[E]) ToArray() []E {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	result := make([]E, q.count)
+	for i := range result {
+		result[i] = q.items[q.index(i)]
+	}
+	return result
+}
+
+// Element retrieves, but does not remove, the head of this queue
+func (q *ArrayBlockingQueue[E]) Element() (*E, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayBlockingQueue", "Element")
+	}
+	value := q.items[q.head]
+	return &value, nil
+}
+
+// Offer inserts the specified element at the tail of this queue if there is space.
+// It returns false without waiting if the queue is full.
+func (q *ArrayBlockingQueue[E]) Offer(element E) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count == len(q.items) {
+		return false
+	}
+	q.enqueue(element)
+	return true
+}
+
+// Peek retrieves, but does not remove, the head of this queue, or returns nil if this queue is empty
+func (q *ArrayBlockingQueue[E]) Peek() (*E, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count == 0 {
+		return nil, nil
+	}
+	value := q.items[q.head]
+	return &value, nil
+}
+
+// Poll retrieves and removes the head of this queue without waiting
+func (q *ArrayBlockingQueue[E]) Poll() (*E, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayBlockingQueue", "Poll")
+	}
+	value := q.dequeue()
+	return &value, nil
+}
+
+// RemoveHead retrieves and removes the head of this queue
+func (q *ArrayBlockingQueue[E]) RemoveHead() (*E, error) {
+	return q.Poll()
+}
+
+// Put inserts the specified element at the tail of this queue, waiting for space if the queue is full
+func (q *ArrayBlockingQueue[E]) Put(element E) error {
+	return q.PutContext(context.Background(), element)
+}
+
+// Take retrieves and removes the head of this queue, waiting for an element if the queue is empty
+func (q *ArrayBlockingQueue[E]) Take() (*E, error) {
+	return q.TakeContext(context.Background())
+}
+
+// OfferTimeout inserts the specified element at the tail of this queue, waiting up to the
+// timeout for space if the queue is full. It returns TimeoutError if no space became available.
+func (q *ArrayBlockingQueue[E]) OfferTimeout(element E, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.PutContext(ctx, element)
+}
+
+// PollTimeout retrieves and removes the head of this queue, waiting up to the timeout for
+// an element if the queue is empty. It returns TimeoutError if no element became available.
+func (q *ArrayBlockingQueue[E]) PollTimeout(timeout time.Duration) (*E, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.TakeContext(ctx)
+}
+
+// PutContext inserts the specified element at the tail of this queue, waiting for space
+// until the context is done. If space is available the element is inserted even if the
+// context is already done. Otherwise it returns TimeoutError if the context's deadline
+// passed, or InterruptedError if the context was cancelled.
+func (q *ArrayBlockingQueue[E]) PutContext(ctx context.Context, element E) error {
+	if ctx == nil {
+		return errcodes.New(errcodes.NullPointerError, "ArrayBlockingQueue", "PutContext")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.count == len(q.items) {
+		if !q.notFull.await(&q.mu, ctx.Done()) {
+			return contextError(ctx, "ArrayBlockingQueue", "PutContext")
+		}
+	}
+	q.enqueue(element)
+	return nil
+}
+
+// TakeContext retrieves and removes the head of this queue, waiting for an element until
+// the context is done. If an element is available it is returned even if the context is
+// already done. Otherwise it returns TimeoutError if the context's deadline passed, or
+// InterruptedError if the context was cancelled.
+func (q *ArrayBlockingQueue[E]) TakeContext(ctx context.Context) (*E, error) {
+	if ctx == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "ArrayBlockingQueue", "TakeContext")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.count == 0 {
+		if !q.notEmpty.await(&q.mu, ctx.Done()) {
+			return nil, contextError(ctx, "ArrayBlockingQueue", "TakeContext")
+		}
+	}
+	value := q.dequeue()
+	return &value, nil
+}
+
+// RemainingCapacity returns the number of elements this queue can accept without blocking
+func (q *ArrayBlockingQueue[E]) RemainingCapacity() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items) - q.count
+}
+
+// DrainTo removes all available elements from this queue and adds them to the given collection.
+// It returns the number of elements transferred.
+func (q *ArrayBlockingQueue[E]) DrainTo(c collections.Collection[E]) int {
+	if c == nil {
+		return 0
+	}
+
+	q.mu.Lock()
+	elements := make([]E, 0, q.count)
+	for q.count > 0 {
+		elements = append(elements, q.dequeue())
+	}
+	q.mu.Unlock()
+
+	// Add to the target without holding the lock, in case it is another blocking queue
+	for _, element := range elements {
+		c.Add(element)
+	}
+	return len(elements)
+}
+
+// Helper methods. All of them assume the lock is already held.
+
+// index maps a position relative to the head onto the ring buffer
+func (q *ArrayBlockingQueue[E]) index(i int) int {
+	return (q.head + i) % len(q.items)
+}
+
+func (q *ArrayBlockingQueue[E]) enqueue(element E) {
+	q.items[q.index(q.count)] = element
+	q.count++
+	q.notEmpty.broadcast()
+}
+
+func (q *ArrayBlockingQueue[E]) dequeue() E {
+	var zero E
+	value := q.items[q.head]
+	q.items[q.head] = zero
+	q.head = (q.head + 1) % len(q.items)
+	q.count--
+	q.notFull.broadcast()
+	return value
+}
+
+// indexOf returns the position of the element relative to the head, or -1 if it is absent
+func (q *ArrayBlockingQueue[E]) indexOf(element E) int {
+	for i := 0; i < q.count; i++ {
+		if q.items[q.index(i)] == element {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeAt removes the element at position i relative to the head by shifting the later elements forward
+func (q *ArrayBlockingQueue[E]) removeAt(i int) {
+	for ; i < q.count-1; i++ {
+		q.items[q.index(i)] = q.items[q.index(i+1)]
+	}
+	var zero E
+	q.items[q.index(q.count-1)] = zero
+	q.count--
+	q.notFull.broadcast()
+}
+
+// blockingQueueIterator iterates over a snapshot of a blocking queue
+type blockingQueueIterator[E comparable] struct {
+	elements []E
+	cursor   int
+}
+
+// HasNext returns true if the iteration has more elements
+func (it *blockingQueueIterator[E]) HasNext() bool {
+	return it.cursor < len(it.elements)
+}
+
+// Next returns the next element in the iteration
+func (it *blockingQueueIterator[E]) Next() (*E, error) {
+	if it.cursor >= len(it.elements) {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "BlockingQueue", "Iterator.Next")
+	}
+	value := it.elements[it.cursor]
+	it.cursor++
+	return &value, nil
+}
@@ -0,0 +1,566 @@
+package queues
+
+import (
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// DefaultDequeCapacity is the default initial capacity for ArrayDeque
+const DefaultDequeCapacity = 16
+
+// ArrayDeque is an unbounded Deque backed by a growable circular buffer.
+// Insertion and removal at either end take amortized constant time and
+// do not allocate unless the buffer has to grow.
+type ArrayDeque[E comparable] struct {
+	elements []E // Circular buffer; its length is always a power of two
+	head     int // Index of the first element
+	size     int
+	modCount int // Number of structural modifications, for fail-fast iteration
+	mu       sync.RWMutex
+}
+
+// NewArrayDeque creates a new empty ArrayDeque with the default capacity
+func NewArrayDeque[E comparable]() *ArrayDeque[E] {
+	return NewArrayDequeWithCapacity[E](DefaultDequeCapacity)
+}
+
+// NewArrayDequeWithCapacity creates a new empty ArrayDeque that can hold at least
+// the given number of elements before growing
+func NewArrayDequeWithCapacity[E comparable](capacity int) *ArrayDeque[E] {
+	if capacity < 1 {
+		capacity = DefaultDequeCapacity
+	}
+	return &ArrayDeque[E]{
+		elements: make([]E, roundUpToPowerOfTwo(capacity)),
+	}
+}
+
+// NewArrayDequeFromCollection creates a new ArrayDeque containing the elements of the given collection
+func NewArrayDequeFromCollection[E comparable](collection collections.Collection[E]) *ArrayDeque[E] {
+	if collection == nil {
+		return NewArrayDeque[E]()
+	}
+	elements := collection.ToArray()
+	deque := NewArrayDequeWithCapacity[E](len(elements) + 1)
+	copy(deque.elements, elements)
+	deque.size = len(elements)
+	return deque
+}
+
+// Add inserts the specified element at the end of this deque
+func (d *ArrayDeque[E]) Add(element E) bool {
+	d.AddLast(element)
+	return true
+}
+
+// AddAll adds all elements of the specified collection at the end of this deque
+func (d *ArrayDeque[E]) AddAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	// Get elements first to avoid holding the lock while reading the other collection
+	elements := collection.ToArray()
+	if len(elements) == 0 {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, element := range elements {
+		d.addLast(element)
+	}
+	return true
+}
+
+// Clear removes all elements from this deque
+func (d *ArrayDeque[E]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var zero E
+	for i := 0; i < d.size; i++ {
+		d.elements[d.index(i)] = zero
+	}
+	d.head = 0
+	d.size = 0
+	d.modCount++
+}
+
+// Contains returns true if this deque contains the specified element
+func (d *ArrayDeque[E]) Contains(element E) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.indexOf(element) >= 0
+}
+
+// ContainsAll returns true if this deque contains all elements from the specified collection
+func (d *ArrayDeque[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "ArrayDeque", "ContainsAll")
+	}
+
+	elements := collection.ToArray()
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for _, element := range elements {
+		if d.indexOf(element) < 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Equals returns true if the specified collection contains the same elements in the same order
+func (d *ArrayDeque[E]) Equals(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	elements := collection.ToArray()
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if len(elements) != d.size {
+		return false
+	}
+	for i, element := range elements {
+		if d.elements[d.index(i)] != element {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty returns true if this deque contains no elements
+func (d *ArrayDeque[E]) IsEmpty() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.size == 0
+}
+
+// Iterator returns an iterator over the elements of this deque from first to last.
+// The iterator fails with ConcurrentModificationError if the deque is structurally
+// modified after the iterator is created.
+func (d *ArrayDeque[E]) Iterator() collections.Iterator[E] {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return &arrayDequeIterator[E]{deque: d, expectedModCount: d.modCount}
+}
+
+// DescendingIterator returns an iterator over the elements of this deque from last to first.
+// The iterator fails with ConcurrentModificationError if the deque is structurally
+// modified after the iterator is created.
+func (d *ArrayDeque[E]) DescendingIterator() collections.Iterator[E] {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return &arrayDequeIterator[E]{deque: d, expectedModCount: d.modCount, descending: true}
+}
+
+// SnapshotIterator returns an iterator over a copy of the elements taken now, from first to last.
+// Unlike Iterator, it keeps working if the deque is modified during iteration.
+func (d *ArrayDeque[E]) SnapshotIterator() collections.Iterator[E] {
+	return collections.NewSnapshotIterator(d.ToArray())
+}
+
+// All returns a sequence over a snapshot of the elements of this deque, from first to last.
+// The snapshot is taken when iteration starts, so the loop body may modify the deque.
+func (d *ArrayDeque[E]) All() iter.Seq[E] {
+	return snapshotSeq(d.ToArray)
+}
+
+// Backward returns a sequence over a snapshot of the elements of this deque, from last to first.
+// The snapshot is taken when iteration starts, so the loop body may modify the deque.
+func (d *ArrayDeque[E]) Backward() iter.Seq[E] {
+	return snapshotSeq(func() []E {
+		values := d.ToArray()
+		slices.Reverse(values)
+		return values
+	})
+}
+
+// Remove removes the first occurrence of the specified element from this deque
+func (d *ArrayDeque[E]) Remove(element E) bool {
+	return d.RemoveFirstOccurrence(element)
+}
+
+// RemoveAll removes all elements from this deque that are also contained in the specified collection
+func (d *ArrayDeque[E]) RemoveAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	elements := collection.ToArray()
+	remove := make(map[E]bool, len(elements))
+	for _, element := range elements {
+		remove[element] = true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Compact the kept elements towards the head in a single pass
+	kept := 0
+	for i := 0; i < d.size; i++ {
+		element := d.elements[d.index(i)]
+		if !remove[element] {
+			d.elements[d.index(kept)] = element
+			kept++
+		}
+	}
+	if kept == d.size {
+		return false
+	}
+	var zero E
+	for i := kept; i < d.size; i++ {
+		d.elements[d.index(i)] = zero
+	}
+	d.size = kept
+	d.modCount++
+	return true
+}
+
+// Size returns the number of elements in this deque
+func (d *ArrayDeque[E]) Size() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.size
+}
+
+// ToArray returns a slice containing all elements of this deque from first to last
+func (d *ArrayDeque[E]) ToArray() []E {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	result := make([]E, d.size)
+	n := copy(result, d.elements[d.head:min(d.head+d.size, len(d.elements))])
+	copy(result[n:], d.elements[:d.size-n])
+	return result
+}
+
+// Element retrieves, but does not remove, the first element of this deque
+func (d *ArrayDeque[E]) Element() (*E, error) {
+	return d.GetFirst()
+}
+
+// Offer inserts the specified element at the end of this deque
+func (d *ArrayDeque[E]) Offer(element E) bool {
+	return d.OfferLast(element)
+}
+
+// Peek retrieves, but does not remove, the first element of this deque, or returns nil if this deque is empty
+func (d *ArrayDeque[E]) Peek() (*E, error) {
+	return d.PeekFirst()
+}
+
+// Poll retrieves and removes the first element of this deque, or returns nil if this deque is empty
+func (d *ArrayDeque[E]) Poll() (*E, error) {
+	return d.PollFirst()
+}
+
+// RemoveHead retrieves and removes the first element of this deque
+func (d *ArrayDeque[E]) RemoveHead() (*E, error) {
+	return d.RemoveFirst()
+}
+
+// AddFirst inserts the specified element at the front of this deque
+func (d *ArrayDeque[E]) AddFirst(element E) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.addFirst(element)
+}
+
+// AddLast inserts the specified element at the end of this deque
+func (d *ArrayDeque[E]) AddLast(element E) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.addLast(element)
+}
+
+// GetFirst retrieves, but does not remove, the first element of this deque
+func (d *ArrayDeque[E]) GetFirst() (*E, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.size == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayDeque", "GetFirst")
+	}
+	value := d.elements[d.head]
+	return &value, nil
+}
+
+// GetLast retrieves, but does not remove, the last element of this deque
+func (d *ArrayDeque[E]) GetLast() (*E, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.size == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayDeque", "GetLast")
+	}
+	value := d.elements[d.index(d.size-1)]
+	return &value, nil
+}
+
+// RemoveFirst retrieves and removes the first element of this deque
+func (d *ArrayDeque[E]) RemoveFirst() (*E, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.size == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayDeque", "RemoveFirst")
+	}
+	value := d.removeFirst()
+	return &value, nil
+}
+
+// RemoveLast retrieves and removes the last element of this deque
+func (d *ArrayDeque[E]) RemoveLast() (*E, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.size == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayDeque", "RemoveLast")
+	}
+	value := d.removeLast()
+	return &value, nil
+}
+
+// Reversed returns a reverse-order view of this deque. Operations on the view are
+// applied to the opposite end of this deque, and nothing is copied.
+func (d *ArrayDeque[E]) Reversed() collections.Collection[E] {
+	return newReversedDeque[E](d)
+}
+
+// OfferFirst inserts the specified element at the front of this deque
+func (d *ArrayDeque[E]) OfferFirst(element E) bool {
+	d.AddFirst(element)
+	return true
+}
+
+// OfferLast inserts the specified element at the end of this deque
+func (d *ArrayDeque[E]) OfferLast(element E) bool {
+	d.AddLast(element)
+	return true
+}
+
+// PeekFirst retrieves, but does not remove, the first element of this deque, or returns nil if this deque is empty
+func (d *ArrayDeque[E]) PeekFirst() (*E, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.size == 0 {
+		return nil, nil
+	}
+	value := d.elements[d.head]
+	return &value, nil
+}
+
+// PeekLast retrieves, but does not remove, the last element of this deque, or returns nil if this deque is empty
+func (d *ArrayDeque[E]) PeekLast() (*E, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.size == 0 {
+		return nil, nil
+	}
+	value := d.elements[d.index(d.size-1)]
+	return &value, nil
+}
+
+// PollFirst retrieves and removes the first element of this deque, or returns nil if this deque is empty
+func (d *ArrayDeque[E]) PollFirst() (*E, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.size == 0 {
+		return nil, nil
+	}
+	value := d.removeFirst()
+	return &value, nil
+}
+
+// PollLast retrieves and removes the last element of this deque, or returns nil if this deque is empty
+func (d *ArrayDeque[E]) PollLast() (*E, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.size == 0 {
+		return nil, nil
+	}
+	value := d.removeLast()
+	return &value, nil
+}
+
+// Pop removes and returns the first element of this deque
+func (d *ArrayDeque[E]) Pop() (*E, error) {
+	return d.RemoveFirst()
+}
+
+// Push inserts the specified element at the front of this deque
+func (d *ArrayDeque[E]) Push(element E) {
+	d.AddFirst(element)
+}
+
+// RemoveFirstOccurrence removes the first occurrence of the specified element from this deque
+func (d *ArrayDeque[E]) RemoveFirstOccurrence(element E) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := d.indexOf(element)
+	if i < 0 {
+		return false
+	}
+	d.removeAt(i)
+	return true
+}
+
+// RemoveLastOccurrence removes the last occurrence of the specified element from this deque
+func (d *ArrayDeque[E]) RemoveLastOccurrence(element E) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i := d.size - 1; i >= 0; i-- {
+		if d.elements[d.index(i)] == element {
+			d.removeAt(i)
+			return true
+		}
+	}
+	return false
+}
+
+// Helper methods. All of them assume the appropriate lock is already held.
+
+// index maps a position relative to the head onto the circular buffer
+func (d *ArrayDeque[E]) index(i int) int {
+	return (d.head + i) & (len(d.elements) - 1)
+}
+
+func (d *ArrayDeque[E]) addFirst(element E) {
+	if d.size == len(d.elements) {
+		d.grow()
+	}
+	d.head = (d.head - 1) & (len(d.elements) - 1)
+	d.elements[d.head] = element
+	d.size++
+	d.modCount++
+}
+
+func (d *ArrayDeque[E]) addLast(element E) {
+	if d.size == len(d.elements) {
+		d.grow()
+	}
+	d.elements[d.index(d.size)] = element
+	d.size++
+	d.modCount++
+}
+
+func (d *ArrayDeque[E]) removeFirst() E {
+	var zero E
+	value := d.elements[d.head]
+	d.elements[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	d.modCount++
+	return value
+}
+
+func (d *ArrayDeque[E]) removeLast() E {
+	var zero E
+	last := d.index(d.size - 1)
+	value := d.elements[last]
+	d.elements[last] = zero
+	d.size--
+	d.modCount++
+	return value
+}
+
+// removeAt removes the element at position i relative to the head,
+// shifting whichever side of the deque is shorter
+func (d *ArrayDeque[E]) removeAt(i int) {
+	var zero E
+	if i < d.size/2 {
+		for j := i; j > 0; j-- {
+			d.elements[d.index(j)] = d.elements[d.index(j-1)]
+		}
+		d.elements[d.head] = zero
+		d.head = d.index(1)
+	} else {
+		for j := i; j < d.size-1; j++ {
+			d.elements[d.index(j)] = d.elements[d.index(j+1)]
+		}
+		d.elements[d.index(d.size-1)] = zero
+	}
+	d.size--
+	d.modCount++
+}
+
+// indexOf returns the position of the element relative to the head, or -1 if it is absent
+func (d *ArrayDeque[E]) indexOf(element E) int {
+	for i := 0; i < d.size; i++ {
+		if d.elements[d.index(i)] == element {
+			return i
+		}
+	}
+	return -1
+}
+
+// grow doubles the capacity of the buffer and moves the elements to the start of it
+func (d *ArrayDeque[E]) grow() {
+	elements := make([]E, len(d.elements)*2)
+	n := copy(elements, d.elements[d.head:])
+	copy(elements[n:], d.elements[:d.head])
+	d.elements = elements
+	d.head = 0
+}
+
+// roundUpToPowerOfTwo returns the smallest power of two that is at least n
+func roundUpToPowerOfTwo(n int) int {
+	capacity := 1
+	for capacity < n {
+		capacity <<= 1
+	}
+	return capacity
+}
+
+// arrayDequeIterator iterates over an ArrayDeque in either direction
+type arrayDequeIterator[E comparable] struct {
+	deque            *ArrayDeque[E]
+	cursor           int // Number of elements returned so far
+	expectedModCount int
+	descending       bool
+}
+
+// HasNext returns true if the iteration has more elements
+func (it *arrayDequeIterator[E]) HasNext() bool {
+	it.deque.mu.RLock()
+	defer it.deque.mu.RUnlock()
+	return it.cursor < it.deque.size
+}
+
+// Next returns the next element in the iteration
+func (it *arrayDequeIterator[E]) Next() (*E, error) {
+	it.deque.mu.RLock()
+	defer it.deque.mu.RUnlock()
+
+	if it.deque.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "ArrayDeque", "Iterator.Next")
+	}
+	if it.cursor >= it.deque.size {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "ArrayDeque", "Iterator.Next")
+	}
+	position := it.cursor
+	if it.descending {
+		position = it.deque.size - 1 - it.cursor
+	}
+	value := it.deque.elements[it.deque.index(position)]
+	it.cursor++
+	return &value, nil
+}
@@ -0,0 +1,490 @@
+package queues
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+)
+
+func TestArrayDeque_ImplementsDeque(t *testing.T) {
+	var _ collections.Deque[int] = NewArrayDeque[int]()
+}
+
+func TestNewArrayDeque(t *testing.T) {
+	d := NewArrayDeque[int]()
+	if !d.IsEmpty() || d.Size() != 0 {
+		t.Errorf("Expected empty deque, got size %d", d.Size())
+	}
+	if len(d.elements) != DefaultDequeCapacity {
+		t.Errorf("Expected capacity %d, got %d", DefaultDequeCapacity, len(d.elements))
+	}
+
+	d = NewArrayDequeWithCapacity[int](5)
+	if len(d.elements) != 8 {
+		t.Errorf("Expected capacity rounded up to 8, got %d", len(d.elements))
+	}
+	d = NewArrayDequeWithCapacity[int](0)
+	if len(d.elements) != DefaultDequeCapacity {
+		t.Errorf("Expected default capacity for 0, got %d", len(d.elements))
+	}
+
+	source := lists.NewArrayListWithInitialCollection[int]([]int{1, 2, 3})
+	d = NewArrayDequeFromCollection[int](source)
+	if !reflect.DeepEqual(d.ToArray(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", d.ToArray())
+	}
+	if NewArrayDequeFromCollection[int](nil).Size() != 0 {
+		t.Error("Expected empty deque from nil collection")
+	}
+}
+
+func TestArrayDeque_BothEnds(t *testing.T) {
+	d := NewArrayDequeWithCapacity[int](4)
+	d.OfferLast(2)
+	d.OfferLast(3)
+	d.OfferFirst(1)
+	d.AddFirst(0)
+	d.AddLast(4) // Forces growth while the buffer wraps around
+
+	if !reflect.DeepEqual(d.ToArray(), []int{0, 1, 2, 3, 4}) {
+		t.Errorf("Expected [0 1 2 3 4], got %v", d.ToArray())
+	}
+
+	first, _ := d.PeekFirst()
+	last, _ := d.PeekLast()
+	if *first != 0 || *last != 4 {
+		t.Errorf("Expected first 0 and last 4, got %d and %d", *first, *last)
+	}
+
+	value, err := d.PollFirst()
+	if err != nil || *value != 0 {
+		t.Errorf("Expected PollFirst to return 0, got %v, %v", value, err)
+	}
+	value, err = d.PollLast()
+	if err != nil || *value != 4 {
+		t.Errorf("Expected PollLast to return 4, got %v, %v", value, err)
+	}
+	value, _ = d.GetFirst()
+	if *value != 1 {
+		t.Errorf("Expected GetFirst to return 1, got %d", *value)
+	}
+	value, _ = d.GetLast()
+	if *value != 3 {
+		t.Errorf("Expected GetLast to return 3, got %d", *value)
+	}
+	value, _ = d.RemoveFirst()
+	if *value != 1 {
+		t.Errorf("Expected RemoveFirst to return 1, got %d", *value)
+	}
+	value, _ = d.RemoveLast()
+	if *value != 3 {
+		t.Errorf("Expected RemoveLast to return 3, got %d", *value)
+	}
+	if !reflect.DeepEqual(d.ToArray(), []int{2}) {
+		t.Errorf("Expected [2], got %v", d.ToArray())
+	}
+}
+
+func TestArrayDeque_QueueAndStack(t *testing.T) {
+	d := NewArrayDeque[int]()
+	for i := 1; i <= 3; i++ {
+		d.Offer(i)
+	}
+	head, _ := d.Peek()
+	if *head != 1 {
+		t.Errorf("Expected Peek to return 1, got %d", *head)
+	}
+	head, _ = d.Element()
+	if *head != 1 {
+		t.Errorf("Expected Element to return 1, got %d", *head)
+	}
+	for i := 1; i <= 3; i++ {
+		value, err := d.Poll()
+		if err != nil || *value != i {
+			t.Errorf("Expected Poll to return %d, got %v, %v", i, value, err)
+		}
+	}
+
+	for i := 1; i <= 3; i++ {
+		d.Push(i)
+	}
+	for i := 3; i >= 1; i-- {
+		value, err := d.Pop()
+		if err != nil || *value != i {
+			t.Errorf("Expected Pop to return %d, got %v, %v", i, value, err)
+		}
+	}
+
+	d.Add(7)
+	value, err := d.RemoveHead()
+	if err != nil || *value != 7 {
+		t.Errorf("Expected RemoveHead to return 7, got %v, %v", value, err)
+	}
+}
+
+func TestArrayDeque_Empty(t *testing.T) {
+	d := NewArrayDeque[int]()
+
+	for name, op := range map[string]func() (*int, error){
+		"Peek":      d.Peek,
+		"PeekFirst": d.PeekFirst,
+		"PeekLast":  d.PeekLast,
+		"Poll":      d.Poll,
+		"PollFirst": d.PollFirst,
+		"PollLast":  d.PollLast,
+	} {
+		value, err := op()
+		if value != nil || err != nil {
+			t.Errorf("Expected %s on empty deque to return nil, nil, got %v, %v", name, value, err)
+		}
+	}
+
+	for name, op := range map[string]func() (*int, error){
+		"Element":     d.Element,
+		"GetFirst":    d.GetFirst,
+		"GetLast":     d.GetLast,
+		"RemoveFirst": d.RemoveFirst,
+		"RemoveLast":  d.RemoveLast,
+		"RemoveHead":  d.RemoveHead,
+		"Pop":         d.Pop,
+	} {
+		_, err := op()
+		if err == nil || err.Error() != string(errcodes.NoSuchElementError) {
+			t.Errorf("Expected %s on empty deque to return NoSuchElementError, got %v", name, err)
+		}
+	}
+}
+
+func TestArrayDeque_Growth(t *testing.T) {
+	d := NewArrayDequeWithCapacity[int](2)
+	expected := make([]int, 0, 100)
+	// Alternate ends so the head wraps around before each resize
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			d.AddLast(i)
+			expected = append(expected, i)
+		} else {
+			d.AddFirst(i)
+			expected = append([]int{i}, expected...)
+		}
+	}
+	if !reflect.DeepEqual(d.ToArray(), expected) {
+		t.Errorf("Expected %v, got %v", expected, d.ToArray())
+	}
+	if len(d.elements) != 128 {
+		t.Errorf("Expected capacity 128, got %d", len(d.elements))
+	}
+}
+
+func TestArrayDeque_RemoveOccurrences(t *testing.T) {
+	d := NewArrayDequeWithCapacity[int](8)
+	// Start in the middle of the buffer so removals cross the wrap-around point
+	for i := 0; i < 6; i++ {
+		d.AddLast(0)
+		d.RemoveFirst()
+	}
+	for _, v := range []int{1, 2, 3, 2, 1} {
+		d.AddLast(v)
+	}
+
+	if !d.RemoveFirstOccurrence(2) {
+		t.Error("Expected RemoveFirstOccurrence to remove 2")
+	}
+	if !reflect.DeepEqual(d.ToArray(), []int{1, 3, 2, 1}) {
+		t.Errorf("Expected [1 3 2 1], got %v", d.ToArray())
+	}
+	if !d.RemoveLastOccurrence(1) {
+		t.Error("Expected RemoveLastOccurrence to remove 1")
+	}
+	if !reflect.DeepEqual(d.ToArray(), []int{1, 3, 2}) {
+		t.Errorf("Expected [1 3 2], got %v", d.ToArray())
+	}
+	if !d.Remove(1) {
+		t.Error("Expected Remove to remove 1")
+	}
+	if d.Remove(9) || d.RemoveFirstOccurrence(9) || d.RemoveLastOccurrence(9) {
+		t.Error("Expected removing an absent element to return false")
+	}
+	if !reflect.DeepEqual(d.ToArray(), []int{3, 2}) {
+		t.Errorf("Expected [3 2], got %v", d.ToArray())
+	}
+}
+
+func TestArrayDeque_CollectionOperations(t *testing.T) {
+	d := NewArrayDeque[int]()
+	other := NewArrayDeque[int]()
+	for i := 1; i <= 5; i++ {
+		other.Add(i)
+	}
+
+	if !d.AddAll(other) {
+		t.Error("Expected AddAll to modify the deque")
+	}
+	if d.AddAll(nil) || d.AddAll(NewArrayDeque[int]()) {
+		t.Error("Expected AddAll with nil or empty collection to return false")
+	}
+	if !d.Contains(3) || d.Contains(9) {
+		t.Error("Contains returned the wrong result")
+	}
+	if ok, err := d.ContainsAll(other); !ok || err != nil {
+		t.Errorf("Expected ContainsAll to return true, got %v, %v", ok, err)
+	}
+	if _, err := d.ContainsAll(nil); err == nil || err.Error() != string(errcodes.NullPointerError) {
+		t.Errorf("Expected NullPointerError from ContainsAll(nil), got %v", err)
+	}
+	if !d.Equals(other) {
+		t.Error("Expected deques with the same elements in the same order to be equal")
+	}
+	if d.Equals(other.Reversed()) || d.Equals(nil) {
+		t.Error("Expected deques in a different order or nil not to be equal")
+	}
+
+	toRemove := NewArrayDeque[int]()
+	toRemove.Add(2)
+	toRemove.Add(4)
+	if !d.RemoveAll(toRemove) {
+		t.Error("Expected RemoveAll to modify the deque")
+	}
+	if d.RemoveAll(toRemove) || d.RemoveAll(nil) {
+		t.Error("Expected RemoveAll to return false when nothing is removed")
+	}
+	if !reflect.DeepEqual(d.ToArray(), []int{1, 3, 5}) {
+		t.Errorf("Expected [1 3 5], got %v", d.ToArray())
+	}
+
+	d.Clear()
+	if !d.IsEmpty() {
+		t.Error("Expected deque to be empty after Clear")
+	}
+	d.Add(1)
+	if !reflect.DeepEqual(d.ToArray(), []int{1}) {
+		t.Errorf("Expected [1] after reuse, got %v", d.ToArray())
+	}
+}
+
+func TestArrayDeque_Reversed(t *testing.T) {
+	d := NewArrayDeque[int]()
+	for i := 1; i <= 3; i++ {
+		d.Add(i)
+	}
+	reversed := d.Reversed()
+	if !reflect.DeepEqual(reversed.ToArray(), []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", reversed.ToArray())
+	}
+	if !reflect.DeepEqual(d.ToArray(), []int{1, 2, 3}) {
+		t.Errorf("Expected Reversed to leave the deque unchanged, got %v", d.ToArray())
+	}
+}
+
+func TestArrayDeque_ReversedView(t *testing.T) {
+	d := NewArrayDeque[int]()
+	for i := 1; i <= 3; i++ {
+		d.Add(i)
+	}
+	reversed := d.Reversed().(collections.Deque[int])
+
+	reversed.AddFirst(4)
+	reversed.Add(0)
+	reversed.Push(5)
+	if !reflect.DeepEqual(d.ToArray(), []int{0, 1, 2, 3, 4, 5}) {
+		t.Errorf("Expected writes through the view to reach the deque, got %v", d.ToArray())
+	}
+
+	head, _ := reversed.Peek()
+	if *head != 5 {
+		t.Errorf("Expected head of the view to be 5, got %d", *head)
+	}
+	popped, _ := reversed.Pop()
+	polled, _ := reversed.PollLast()
+	if *popped != 5 || *polled != 0 {
+		t.Errorf("Expected Pop to return 5 and PollLast to return 0, got %d and %d", *popped, *polled)
+	}
+
+	var seen []int
+	for it := reversed.Iterator(); it.HasNext(); {
+		value, err := it.Next()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		seen = append(seen, *value)
+	}
+	if !reflect.DeepEqual(seen, []int{4, 3, 2, 1}) {
+		t.Errorf("Expected view to iterate as [4 3 2 1], got %v", seen)
+	}
+	if got := slices.Collect(reversed.Backward()); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected view to iterate backward as [1 2 3 4], got %v", got)
+	}
+
+	d.Add(2)
+	if !reversed.Remove(2) || !reflect.DeepEqual(d.ToArray(), []int{1, 2, 3, 4}) {
+		t.Errorf("Expected Remove on the view to remove the last occurrence, got %v", d.ToArray())
+	}
+	if !reversed.Equals(NewArrayDequeFromCollection[int](d).Reversed()) {
+		t.Error("Expected views of equal deques to be equal")
+	}
+	if reversed.Reversed() != collections.Collection[int](d) {
+		t.Error("Expected Reversed of the view to return the deque")
+	}
+}
+
+func TestArrayDeque_Iterators(t *testing.T) {
+	d := NewArrayDeque[int]()
+	d.Add(2)
+	d.Add(3)
+	d.AddFirst(1)
+
+	var forward, backward []int
+	for it := d.Iterator(); it.HasNext(); {
+		value, err := it.Next()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		forward = append(forward, *value)
+	}
+	for it := d.DescendingIterator(); it.HasNext(); {
+		value, err := it.Next()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		backward = append(backward, *value)
+	}
+	if !reflect.DeepEqual(forward, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", forward)
+	}
+	if !reflect.DeepEqual(backward, []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", backward)
+	}
+
+	it := d.Iterator()
+	for it.HasNext() {
+		it.Next()
+	}
+	if _, err := it.Next(); err == nil || err.Error() != string(errcodes.NoSuchElementError) {
+		t.Errorf("Expected NoSuchElementError after the last element, got %v", err)
+	}
+
+	it = d.Iterator()
+	it.Next()
+	d.Add(4)
+	if _, err := it.Next(); err == nil || err.Error() != string(errcodes.ConcurrentModificationError) {
+		t.Errorf("Expected ConcurrentModificationError after modification, got %v", err)
+	}
+}
+
+func TestArrayDeque_SnapshotIterator(t *testing.T) {
+	d := NewArrayDequeFromCollection[int](lists.NewArrayListWithInitialCollection([]int{1, 2, 3}))
+	it := d.SnapshotIterator()
+	d.Clear()
+	d.Add(4)
+
+	var result []int
+	for it.HasNext() {
+		value, err := it.Next()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		result = append(result, *value)
+	}
+	if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", result)
+	}
+}
+
+func TestArrayDeque_NoAllocationsInSteadyState(t *testing.T) {
+	d := NewArrayDeque[int]()
+	allocs := testing.AllocsPerRun(100, func() {
+		d.OfferLast(1)
+		d.OfferFirst(2)
+		d.PollFirst()
+		d.PollLast()
+	})
+	// Only the returned element pointers may escape
+	if allocs > 2 {
+		t.Errorf("Expected at most 2 allocations per run, got %v", allocs)
+	}
+}
+
+func TestArrayDeque_All(t *testing.T) {
+	d := NewArrayDequeWithCapacity[int](4)
+	d.Add(2)
+	d.Add(3)
+	d.AddFirst(1) // Wraps the head around the end of the buffer
+
+	if got := slices.Collect(d.All()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+	if got := slices.Collect(d.Backward()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", got)
+	}
+
+	// The sequence iterates a snapshot, so the body may modify the deque
+	var seen []int
+	for v := range d.All() {
+		if v == 3 {
+			d.Add(4)
+		}
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+		t.Errorf("Expected to see [1 2 3], got %v", seen)
+	}
+	if got := d.ToArray(); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v", got)
+	}
+
+	seen = nil
+	for v := range d.Backward() {
+		seen = append(seen, v)
+		break
+	}
+	if !reflect.DeepEqual(seen, []int{4}) {
+		t.Errorf("Expected break to stop after [4], got %v", seen)
+	}
+}
+
+func TestArrayDeque_AllModifiedInLoop(t *testing.T) {
+	d := NewArrayDeque[int]()
+	for i := range 6 {
+		d.Add(i)
+	}
+
+	var seen []int
+	for v := range d.All() {
+		if v%2 == 0 {
+			d.Remove(v)
+		}
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []int{0, 1, 2, 3, 4, 5}) {
+		t.Errorf("Expected to see [0 1 2 3 4 5], got %v", seen)
+	}
+	if got := d.ToArray(); !reflect.DeepEqual(got, []int{1, 3, 5}) {
+		t.Errorf("Expected [1 3 5], got %v", got)
+	}
+
+	// Adding at the head does not yield the current element again
+	seen = nil
+	for v := range d.All() {
+		d.AddFirst(v * 10)
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 3, 5}) {
+		t.Errorf("Expected to see [1 3 5], got %v", seen)
+	}
+
+	seen = nil
+	for v := range d.Backward() {
+		d.RemoveLast()
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []int{5, 3, 1, 10, 30, 50}) {
+		t.Errorf("Expected to see [5 3 1 10 30 50], got %v", seen)
+	}
+	if !d.IsEmpty() {
+		t.Errorf("Expected the deque to be empty, got %v", d.ToArray())
+	}
+}
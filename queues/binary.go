@@ -0,0 +1,230 @@
+package queues
+
+import (
+	"io"
+	"slices"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/codec"
+)
+
+// Queues use the binary format of the internal codec package, in which the elements are
+// gob-encoded one after another. WriteTo streams the elements while holding the read
+// lock, so writers wait until it returns. ReadFrom, UnmarshalBinary and GobDecode replace
+// the current contents.
+
+// WriteTo writes the deque to w in the binary format, from first to last, and returns the
+// number of bytes written.
+func (d *ArrayDeque[E]) WriteTo(w io.Writer) (int64, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return codec.WriteSequence(w, codec.Sequence, d.size, func(yield func(E) bool) {
+		mask := len(d.elements) - 1
+		for i := 0; i < d.size; i++ {
+			if !yield(d.elements[(d.head+i)&mask]) {
+				return
+			}
+		}
+	})
+}
+
+// ReadFrom replaces the contents of the deque with a collection read from r in the binary
+// format and returns the number of bytes read.
+func (d *ArrayDeque[E]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSequence[E](r, codec.Sequence)
+	if err != nil {
+		return n, err
+	}
+
+	capacity := roundUpToPowerOfTwo(max(len(values)+1, DefaultDequeCapacity))
+	elements := slices.Grow(values, capacity-len(values))[:capacity]
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.elements = elements
+	d.head = 0
+	d.size = len(values)
+	d.modCount++
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d *ArrayDeque[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *ArrayDeque[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(d, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (d *ArrayDeque[E]) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (d *ArrayDeque[E]) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// WriteTo writes the queue to w in the binary format and returns the number of bytes
+// written. The elements are written in the order of the underlying heap rather than in
+// priority order, so that nothing has to be sorted or copied.
+func (pq *PriorityQueue[E]) WriteTo(w io.Writer) (int64, error) {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	return codec.WriteSequence(w, codec.Sequence, len(pq.elements), slices.Values(pq.elements))
+}
+
+// ReadFrom replaces the contents of the queue with a collection read from r in the binary
+// format and returns the number of bytes read. The queue keeps its comparator, so it must
+// have been created with NewPriorityQueue; reading into a zero PriorityQueue returns
+// NullPointerError. The elements may be in any order; the heap is rebuilt in linear time.
+func (pq *PriorityQueue[E]) ReadFrom(r io.Reader) (int64, error) {
+	if pq.comparator == nil {
+		return 0, errcodes.New(errcodes.NullPointerError, "PriorityQueue", "ReadFrom")
+	}
+	values, n, err := codec.ReadSequence[E](r, codec.Sequence)
+	if err != nil {
+		return n, err
+	}
+
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	pq.elements = values
+	pq.heapify()
+	pq.modCount++
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (pq *PriorityQueue[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(pq)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (pq *PriorityQueue[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(pq, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (pq *PriorityQueue[E]) GobEncode() ([]byte, error) {
+	return pq.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (pq *PriorityQueue[E]) GobDecode(data []byte) error {
+	return pq.UnmarshalBinary(data)
+}
+
+// WriteTo writes the queue to w in the binary format, from head to tail, and returns the
+// number of bytes written. Unlike the other queues, it writes a snapshot taken under the
+// lock, so producers and consumers do not wait for w.
+func (q *ArrayBlockingQueue[E]) WriteTo(w io.Writer) (int64, error) {
+	values := q.ToArray()
+	return codec.WriteSequence(w, codec.Sequence, len(values), slices.Values(values))
+}
+
+// ReadFrom replaces the contents of the queue with a collection read from r in the binary
+// format and returns the number of bytes read. The queue keeps its capacity, so it must
+// have been created with NewArrayBlockingQueue; reading into a zero ArrayBlockingQueue
+// returns NullPointerError, and reading more elements than fit returns IllegalStateError
+// and leaves the queue unchanged. Goroutines blocked in Put or Take re-check the queue.
+func (q *ArrayBlockingQueue[E]) ReadFrom(r io.Reader) (int64, error) {
+	if len(q.items) == 0 {
+		return 0, errcodes.New(errcodes.NullPointerError, "ArrayBlockingQueue", "ReadFrom")
+	}
+	values, n, err := codec.ReadSequence[E](r, codec.Sequence)
+	if err != nil {
+		return n, err
+	}
+	if len(values) > len(q.items) {
+		return n, errcodes.New(errcodes.IllegalStateError, "ArrayBlockingQueue", "ReadFrom")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	clear(q.items[copy(q.items, values):])
+	q.head = 0
+	q.count = len(values)
+	q.notEmpty.broadcast()
+	q.notFull.broadcast()
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (q *ArrayBlockingQueue[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(q)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (q *ArrayBlockingQueue[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(q, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (q *ArrayBlockingQueue[E]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (q *ArrayBlockingQueue[E]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// WriteTo writes the queue to w in the binary format, from head to tail, and returns the
+// number of bytes written. Like ArrayBlockingQueue.WriteTo, it writes a snapshot.
+func (q *LinkedBlockingQueue[E]) WriteTo(w io.Writer) (int64, error) {
+	values := q.ToArray()
+	return codec.WriteSequence(w, codec.Sequence, len(values), slices.Values(values))
+}
+
+// ReadFrom replaces the contents of the queue with a collection read from r in the binary
+// format and returns the number of bytes read. The queue keeps its capacity, so it must
+// have been created with NewLinkedBlockingQueue or NewLinkedBlockingQueueWithCapacity;
+// reading into a zero LinkedBlockingQueue returns NullPointerError, and reading more
+// elements than fit returns IllegalStateError and leaves the queue unchanged.
+func (q *LinkedBlockingQueue[E]) ReadFrom(r io.Reader) (int64, error) {
+	if q.capacity == 0 {
+		return 0, errcodes.New(errcodes.NullPointerError, "LinkedBlockingQueue", "ReadFrom")
+	}
+	values, n, err := codec.ReadSequence[E](r, codec.Sequence)
+	if err != nil {
+		return n, err
+	}
+	if len(values) > q.capacity {
+		return n, errcodes.New(errcodes.IllegalStateError, "LinkedBlockingQueue", "ReadFrom")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.head = nil
+	q.tail = nil
+	q.count = 0
+	for _, value := range values {
+		q.enqueue(value)
+	}
+	q.notFull.broadcast()
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (q *LinkedBlockingQueue[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(q)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (q *LinkedBlockingQueue[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(q, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (q *LinkedBlockingQueue[E]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (q *LinkedBlockingQueue[E]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
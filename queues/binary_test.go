@@ -0,0 +1,215 @@
+package queues
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+func TestArrayDeque_Binary(t *testing.T) {
+	d := NewArrayDeque[int]()
+	for i := 0; i < 20; i++ {
+		d.AddLast(i)
+	}
+	for i := 0; i < 10; i++ {
+		d.Poll()
+		d.AddFirst(-i)
+	}
+
+	var buf bytes.Buffer
+	written, err := d.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var decoded ArrayDeque[int]
+	read, err := decoded.ReadFrom(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if read != written {
+		t.Errorf("Expected %d bytes read, got %d", written, read)
+	}
+	if !reflect.DeepEqual(decoded.ToArray(), d.ToArray()) {
+		t.Errorf("Expected %v, got %v", d.ToArray(), decoded.ToArray())
+	}
+
+	// The decoded deque has room to grow at both ends
+	decoded.AddFirst(100)
+	decoded.AddLast(200)
+	first, _ := decoded.PeekFirst()
+	last, _ := decoded.PeekLast()
+	if *first != 100 || *last != 200 {
+		t.Errorf("Expected 100 and 200 at the ends, got %d and %d", *first, *last)
+	}
+
+	if err := decoded.UnmarshalBinary([]byte("GCOL\x09\x01\x00")); err == nil || err.Error() != string(errcodes.InvalidFormatError) {
+		t.Errorf("Expected InvalidFormatError for an unknown version, got %v", err)
+	}
+	if decoded.Size() != 22 {
+		t.Errorf("Expected a failed decode to leave the deque unchanged, got size %d", decoded.Size())
+	}
+}
+
+func TestPriorityQueue_Binary(t *testing.T) {
+	pq := NewPriorityQueue[int](comparators.Natural[int]()).(*PriorityQueue[int])
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		pq.Add(v)
+	}
+
+	type snapshot struct {
+		Queue *PriorityQueue[int]
+	}
+	decoded := snapshot{Queue: NewPriorityQueue[int](comparators.Reverse(comparators.Natural[int]())).(*PriorityQueue[int])}
+	decoded.Queue.Add(100)
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(snapshot{Queue: pq}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The elements are ordered by the comparator of the queue decoded into
+	var polled []int
+	for !decoded.Queue.IsEmpty() {
+		v, _ := decoded.Queue.Poll()
+		polled = append(polled, *v)
+	}
+	if !reflect.DeepEqual(polled, []int{5, 4, 3, 2, 1}) {
+		t.Errorf("Expected [5 4 3 2 1], got %v", polled)
+	}
+
+	data, err := pq.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var zero PriorityQueue[int]
+	if err := zero.UnmarshalBinary(data); err == nil || err.Error() != string(errcodes.NullPointerError) {
+		t.Errorf("Expected NullPointerError for a queue without a comparator, got %v", err)
+	}
+}
+
+func TestArrayBlockingQueue_Binary(t *testing.T) {
+	q := NewArrayBlockingQueue[int](4)
+	for i := 0; i < 4; i++ {
+		q.Offer(i)
+	}
+	q.Poll()
+	q.Offer(4) // Wraps the tail around the end of the buffer
+
+	var buf bytes.Buffer
+	written, err := q.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := NewArrayBlockingQueue[int](5)
+	decoded.Offer(100)
+	read, err := decoded.ReadFrom(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if read != written {
+		t.Errorf("Expected %d bytes read, got %d", written, read)
+	}
+	if got := decoded.ToArray(); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v", got)
+	}
+	if decoded.RemainingCapacity() != 1 {
+		t.Errorf("Expected the decoded queue to keep its capacity, got %d remaining", decoded.RemainingCapacity())
+	}
+
+	// A gob round trip goes through GobEncode and GobDecode
+	type snapshot struct {
+		Queue *ArrayBlockingQueue[int]
+	}
+	buf.Reset()
+	if err := gob.NewEncoder(&buf).Encode(snapshot{Queue: q}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out := snapshot{Queue: NewArrayBlockingQueue[int](4)}
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := out.Queue.ToArray(); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v", got)
+	}
+
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	small := NewArrayBlockingQueue[int](3)
+	small.Offer(7)
+	if err := small.UnmarshalBinary(data); err == nil || err.Error() != string(errcodes.IllegalStateError) {
+		t.Errorf("Expected IllegalStateError for too many elements, got %v", err)
+	}
+	if got := small.ToArray(); !reflect.DeepEqual(got, []int{7}) {
+		t.Errorf("Expected a failed decode to leave the queue unchanged, got %v", got)
+	}
+	var zero ArrayBlockingQueue[int]
+	if err := zero.UnmarshalBinary(data); err == nil || err.Error() != string(errcodes.NullPointerError) {
+		t.Errorf("Expected NullPointerError for a queue without capacity, got %v", err)
+	}
+}
+
+func TestLinkedBlockingQueue_Binary(t *testing.T) {
+	q := NewLinkedBlockingQueue[string]()
+	q.Offer("a")
+	q.Offer("b")
+	q.Offer("c")
+
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := NewLinkedBlockingQueue[string]()
+	decoded.Offer("z")
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := decoded.ToArray(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Expected [a b c], got %v", got)
+	}
+	decoded.Offer("d")
+	if v, _ := decoded.Poll(); v == nil || *v != "a" {
+		t.Errorf("Expected to poll a, got %v", v)
+	}
+	if decoded.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", decoded.Size())
+	}
+
+	bounded := NewLinkedBlockingQueueWithCapacity[string](2)
+	if err := bounded.UnmarshalBinary(data); err == nil || err.Error() != string(errcodes.IllegalStateError) {
+		t.Errorf("Expected IllegalStateError for too many elements, got %v", err)
+	}
+	if !bounded.IsEmpty() {
+		t.Errorf("Expected a failed decode to leave the queue unchanged, got %v", bounded.ToArray())
+	}
+	var zero LinkedBlockingQueue[string]
+	if err := zero.GobDecode(data); err == nil || err.Error() != string(errcodes.NullPointerError) {
+		t.Errorf("Expected NullPointerError for a queue without capacity, got %v", err)
+	}
+}
+
+func TestLinkedBlockingQueue_ReadFromWakesConsumers(t *testing.T) {
+	q := NewLinkedBlockingQueue[int]()
+	source := NewLinkedBlockingQueue[int]()
+	source.Offer(42)
+	data, _ := source.MarshalBinary()
+
+	taken := make(chan int)
+	go func() {
+		v, _ := q.Take()
+		taken <- *v
+	}()
+	if err := q.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v := <-taken; v != 42 {
+		t.Errorf("Expected Take to return 42, got %d", v)
+	}
+}
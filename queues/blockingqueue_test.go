@@ -0,0 +1,446 @@
+package queues
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+)
+
+// boundedQueues returns constructors for every bounded BlockingQueue implementation
+func boundedQueues() map[string]func(capacity int) collections.BlockingQueue[int] {
+	return map[string]func(capacity int) collections.BlockingQueue[int]{
+		"ArrayBlockingQueue": func(capacity int) collections.BlockingQueue[int] {
+			return NewArrayBlockingQueue[int](capacity)
+		},
+		"LinkedBlockingQueue": func(capacity int) collections.BlockingQueue[int] {
+			return NewLinkedBlockingQueueWithCapacity[int](capacity)
+		},
+	}
+}
+
+func TestNewBlockingQueues(t *testing.T) {
+	if NewArrayBlockingQueue[int](0) != nil {
+		t.Error("NewArrayBlockingQueue should return nil for capacity 0")
+	}
+	if NewLinkedBlockingQueueWithCapacity[int](-1) != nil {
+		t.Error("NewLinkedBlockingQueueWithCapacity should return nil for a negative capacity")
+	}
+
+	q := NewLinkedBlockingQueue[int]()
+	if q.RemainingCapacity() != math.MaxInt {
+		t.Errorf("Expected unbounded remaining capacity, got %d", q.RemainingCapacity())
+	}
+	for i := 0; i < 1000; i++ {
+		if !q.Offer(i) {
+			t.Fatalf("Offer should not fail on an unbounded queue")
+		}
+	}
+	if q.RemainingCapacity() != math.MaxInt-1000 {
+		t.Errorf("Expected remaining capacity %d, got %d", math.MaxInt-1000, q.RemainingCapacity())
+	}
+}
+
+func TestBlockingQueue_OfferPoll(t *testing.T) {
+	for name, newQueue := range boundedQueues() {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue(3)
+
+			if val, err := q.Peek(); val != nil || err != nil {
+				t.Errorf("Peek on empty queue should return nil, nil, got %v, %v", val, err)
+			}
+			if _, err := q.Element(); err == nil || err.Error() != string(errcodes.NoSuchElementError) {
+				t.Errorf("Element on empty queue should return NoSuchElementError, got %v", err)
+			}
+			if _, err := q.Poll(); err == nil || err.Error() != string(errcodes.NoSuchElementError) {
+				t.Errorf("Poll on empty queue should return NoSuchElementError, got %v", err)
+			}
+
+			for i := 1; i <= 3; i++ {
+				if !q.Offer(i) {
+					t.Errorf("Offer(%d) should succeed", i)
+				}
+			}
+			if q.Offer(4) || q.Add(4) {
+				t.Error("Offer and Add should fail on a full queue")
+			}
+			if q.RemainingCapacity() != 0 {
+				t.Errorf("Expected remaining capacity 0, got %d", q.RemainingCapacity())
+			}
+
+			if val, _ := q.Peek(); *val != 1 {
+				t.Errorf("Expected head 1, got %d", *val)
+			}
+			if val, _ := q.Element(); *val != 1 {
+				t.Errorf("Expected head 1, got %d", *val)
+			}
+
+			// Wrap around the ring buffer
+			for i := 4; i <= 7; i++ {
+				val, err := q.Poll()
+				if err != nil || *val != i-3 {
+					t.Errorf("Expected %d, got %v, %v", i-3, val, err)
+				}
+				q.Offer(i)
+			}
+			if !reflect.DeepEqual(q.ToArray(), []int{5, 6, 7}) {
+				t.Errorf("Expected [5 6 7], got %v", q.ToArray())
+			}
+			val, err := q.RemoveHead()
+			if err != nil || *val != 5 {
+				t.Errorf("Expected 5, got %v, %v", val, err)
+			}
+			if q.Size() != 2 || q.IsEmpty() {
+				t.Errorf("Expected size 2, got %d", q.Size())
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_CollectionOperations(t *testing.T) {
+	for name, newQueue := range boundedQueues() {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue(5)
+			if !q.AddAll(lists.NewArrayListWithInitialCollection([]int{1, 2, 3, 2, 4, 5, 6})) {
+				t.Error("AddAll should report a change")
+			}
+			if !reflect.DeepEqual(q.ToArray(), []int{1, 2, 3, 2, 4}) {
+				t.Errorf("AddAll should stop when full, got %v", q.ToArray())
+			}
+			if q.AddAll(nil) || q.AddAll(lists.NewArrayListWithInitialCollection([]int{7})) {
+				t.Error("AddAll should not change a full queue")
+			}
+
+			if !q.Contains(3) || q.Contains(9) {
+				t.Error("Contains returned the wrong result")
+			}
+			ok, err := q.ContainsAll(lists.NewArrayListWithInitialCollection([]int{1, 4}))
+			if !ok || err != nil {
+				t.Errorf("ContainsAll should be true, got %v, %v", ok, err)
+			}
+			if _, err := q.ContainsAll(nil); err == nil || err.Error() != string(errcodes.NullPointerError) {
+				t.Errorf("ContainsAll(nil) should return NullPointerError, got %v", err)
+			}
+			if !q.Equals(lists.NewArrayListWithInitialCollection([]int{1, 2, 3, 2, 4})) {
+				t.Error("Equals should match the same elements in the same order")
+			}
+			if q.Equals(lists.NewArrayListWithInitialCollection([]int{2, 1, 3, 2, 4})) || q.Equals(nil) {
+				t.Error("Equals should not match a different order or nil")
+			}
+
+			if !q.Remove(2) || q.Remove(9) {
+				t.Error("Remove returned the wrong result")
+			}
+			if !reflect.DeepEqual(q.ToArray(), []int{1, 3, 2, 4}) {
+				t.Errorf("Remove should only remove the first occurrence, got %v", q.ToArray())
+			}
+			if !q.RemoveAll(lists.NewArrayListWithInitialCollection([]int{2, 4})) || q.RemoveAll(nil) {
+				t.Error("RemoveAll returned the wrong result")
+			}
+			if !reflect.DeepEqual(q.ToArray(), []int{1, 3}) {
+				t.Errorf("Expected [1 3], got %v", q.ToArray())
+			}
+			if !q.Offer(8) {
+				t.Error("Offer should succeed after removals")
+			}
+
+			var iterated []int
+			for it := q.Iterator(); it.HasNext(); {
+				val, err := it.Next()
+				if err != nil {
+					t.Fatal(err)
+				}
+				iterated = append(iterated, *val)
+			}
+			if !reflect.DeepEqual(iterated, []int{1, 3, 8}) {
+				t.Errorf("Expected [1 3 8], got %v", iterated)
+			}
+
+			target := lists.NewArrayList[int]()
+			if n := q.DrainTo(target); n != 3 {
+				t.Errorf("Expected DrainTo to move 3 elements, got %d", n)
+			}
+			if !q.IsEmpty() || !reflect.DeepEqual(target.ToArray(), []int{1, 3, 8}) {
+				t.Errorf("Unexpected DrainTo result %v", target.ToArray())
+			}
+			if q.DrainTo(nil) != 0 {
+				t.Error("DrainTo(nil) should move nothing")
+			}
+
+			q.Offer(1)
+			q.Clear()
+			if q.Size() != 0 || q.RemainingCapacity() != 5 {
+				t.Errorf("Clear should empty the queue, size %d", q.Size())
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_PutTakeBlock(t *testing.T) {
+	for name, newQueue := range boundedQueues() {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue(1)
+			if err := q.Put(1); err != nil {
+				t.Fatal(err)
+			}
+
+			putDone := make(chan struct{})
+			go func() {
+				_ = q.Put(2)
+				close(putDone)
+			}()
+			select {
+			case <-putDone:
+				t.Fatal("Put should block while the queue is full")
+			case <-time.After(20 * time.Millisecond):
+			}
+
+			val, err := q.Take()
+			if err != nil || *val != 1 {
+				t.Fatalf("Expected 1, got %v, %v", val, err)
+			}
+			<-putDone
+
+			val, _ = q.Take()
+			if *val != 2 {
+				t.Fatalf("Expected 2, got %d", *val)
+			}
+
+			takeDone := make(chan int)
+			go func() {
+				val, _ := q.Take()
+				takeDone <- *val
+			}()
+			select {
+			case <-takeDone:
+				t.Fatal("Take should block while the queue is empty")
+			case <-time.After(20 * time.Millisecond):
+			}
+			_ = q.Put(3)
+			if got := <-takeDone; got != 3 {
+				t.Fatalf("Expected 3, got %d", got)
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_ProducersConsumers(t *testing.T) {
+	constructors := boundedQueues()
+	constructors["UnboundedLinkedBlockingQueue"] = func(int) collections.BlockingQueue[int] {
+		return NewLinkedBlockingQueue[int]()
+	}
+	for name, newQueue := range constructors {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue(4)
+			const producers = 4
+			const perProducer = 500
+
+			var producerGroup sync.WaitGroup
+			for p := 0; p < producers; p++ {
+				producerGroup.Add(1)
+				go func(p int) {
+					defer producerGroup.Done()
+					for i := 0; i < perProducer; i++ {
+						_ = q.Put(p*perProducer + i)
+					}
+				}(p)
+			}
+
+			results := make(chan int, producers*perProducer)
+			var consumerGroup sync.WaitGroup
+			for c := 0; c < 3; c++ {
+				consumerGroup.Add(1)
+				go func() {
+					defer consumerGroup.Done()
+					for {
+						val, _ := q.Take()
+						if *val < 0 {
+							return
+						}
+						results <- *val
+					}
+				}()
+			}
+
+			producerGroup.Wait()
+			for c := 0; c < 3; c++ {
+				_ = q.Put(-1)
+			}
+			consumerGroup.Wait()
+			close(results)
+
+			var got []int
+			for val := range results {
+				got = append(got, val)
+			}
+			sort.Ints(got)
+			if len(got) != producers*perProducer {
+				t.Fatalf("Expected %d elements, got %d", producers*perProducer, len(got))
+			}
+			for i, val := range got {
+				if val != i {
+					t.Fatalf("Expected element %d, got %d", i, val)
+				}
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_Timeouts(t *testing.T) {
+	for name, newQueue := range boundedQueues() {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue(1)
+
+			start := time.Now()
+			val, err := q.PollTimeout(20 * time.Millisecond)
+			if val != nil || err == nil || err.Error() != string(errcodes.TimeoutError) {
+				t.Errorf("PollTimeout on empty queue should return TimeoutError, got %v, %v", val, err)
+			}
+			if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+				t.Errorf("PollTimeout returned after %v, before the timeout", elapsed)
+			}
+
+			// A zero timeout behaves like Offer and Poll
+			if err := q.OfferTimeout(1, 0); err != nil {
+				t.Errorf("OfferTimeout with space should succeed, got %v", err)
+			}
+			if err := q.OfferTimeout(2, 0); err == nil || err.Error() != string(errcodes.TimeoutError) {
+				t.Errorf("OfferTimeout on full queue should return TimeoutError, got %v", err)
+			}
+			if err := q.OfferTimeout(2, 20*time.Millisecond); err == nil || err.Error() != string(errcodes.TimeoutError) {
+				t.Errorf("OfferTimeout on full queue should return TimeoutError, got %v", err)
+			}
+
+			// A waiter succeeds once the queue changes within the timeout
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				_, _ = q.Poll()
+			}()
+			if err := q.OfferTimeout(2, time.Second); err != nil {
+				t.Errorf("OfferTimeout should succeed once space is available, got %v", err)
+			}
+			val, err = q.PollTimeout(0)
+			if err != nil || *val != 2 {
+				t.Errorf("Expected 2, got %v, %v", val, err)
+			}
+
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				_ = q.Put(3)
+			}()
+			val, err = q.PollTimeout(time.Second)
+			if err != nil || *val != 3 {
+				t.Errorf("Expected 3, got %v, %v", val, err)
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_Context(t *testing.T) {
+	for name, newQueue := range boundedQueues() {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue(1)
+
+			if _, err := q.TakeContext(nil); err == nil || err.Error() != string(errcodes.NullPointerError) {
+				t.Errorf("TakeContext(nil) should return NullPointerError, got %v", err)
+			}
+			if err := q.PutContext(nil, 1); err == nil || err.Error() != string(errcodes.NullPointerError) {
+				t.Errorf("PutContext(nil) should return NullPointerError, got %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				cancel()
+			}()
+			val, err := q.TakeContext(ctx)
+			if val != nil || err == nil || err.Error() != string(errcodes.InterruptedError) {
+				t.Errorf("TakeContext should return InterruptedError when cancelled, got %v, %v", val, err)
+			}
+
+			deadline, cancelDeadline := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancelDeadline()
+			if _, err := q.TakeContext(deadline); err == nil || err.Error() != string(errcodes.TimeoutError) {
+				t.Errorf("TakeContext should return TimeoutError when the deadline passes, got %v", err)
+			}
+
+			if err := q.PutContext(context.Background(), 1); err != nil {
+				t.Fatal(err)
+			}
+			cancelled, cancelNow := context.WithCancel(context.Background())
+			cancelNow()
+			if err := q.PutContext(cancelled, 2); err == nil || err.Error() != string(errcodes.InterruptedError) {
+				t.Errorf("PutContext on a full queue should return InterruptedError when cancelled, got %v", err)
+			}
+			// An available element is returned even if the context is already done
+			val, err = q.TakeContext(cancelled)
+			if err != nil || *val != 1 {
+				t.Errorf("Expected 1, got %v, %v", val, err)
+			}
+			if q.Size() != 0 {
+				t.Errorf("Cancelled PutContext should not insert, size %d", q.Size())
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_ContextErrorDetails(t *testing.T) {
+	for name, newQueue := range boundedQueues() {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue(1)
+
+			_, err := q.PollTimeout(time.Millisecond)
+			if !errors.Is(err, errcodes.ErrTimeout) || !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("Expected a timeout wrapping context.DeadlineExceeded, got %v", err)
+			}
+			var e *errcodes.Error
+			if !errors.As(err, &e) || e.Type != name || e.Op != "TakeContext" {
+				t.Errorf("Expected the queue type and operation in the error, got %+v", e)
+			}
+
+			q.Offer(1)
+			cancelled, cancel := context.WithCancel(context.Background())
+			cancel()
+			err = q.PutContext(cancelled, 2)
+			if !errors.Is(err, errcodes.ErrInterrupted) || !errors.Is(err, context.Canceled) {
+				t.Errorf("Expected an interruption wrapping context.Canceled, got %v", err)
+			}
+			if !errors.As(err, &e) || e.Op != "PutContext" {
+				t.Errorf("Expected the operation in the error, got %+v", e)
+			}
+		})
+	}
+}
+
+func TestBlockingQueueAll(t *testing.T) {
+	for name, newQueue := range boundedQueues() {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue(3)
+			for i := 1; i <= 3; i++ {
+				q.Offer(i)
+			}
+			if got := slices.Collect(q.All()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+				t.Errorf("Expected [1 2 3], got %v", got)
+			}
+
+			// The sequence iterates over a snapshot, so the loop body may take from the queue
+			var seen []int
+			for v := range q.All() {
+				q.Poll()
+				seen = append(seen, v)
+			}
+			if !reflect.DeepEqual(seen, []int{1, 2, 3}) || !q.IsEmpty() {
+				t.Errorf("Expected to see [1 2 3] and empty the queue, got %v with %d left", seen, q.Size())
+			}
+		})
+	}
+}
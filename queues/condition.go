@@ -0,0 +1,55 @@
+package queues
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// condition is a condition variable for the blocking queues.
+// Unlike sync.Cond, a wait is a channel receive, so it can be abandoned
+// when a timeout or context fires.
+// All methods must be called with the queue's lock held.
+type condition struct {
+	ch chan struct{}
+}
+
+// await releases mu, waits for the next broadcast or for done to be closed,
+// and re-acquires mu. It returns false if done was closed first.
+// A nil done channel waits for the broadcast only.
+// Callers must re-check their predicate after await returns.
+func (c *condition) await(mu *sync.Mutex, done <-chan struct{}) bool {
+	if c.ch == nil {
+		c.ch = make(chan struct{})
+	}
+	ch := c.ch
+
+	mu.Unlock()
+	defer mu.Lock()
+
+	select {
+	case <-ch:
+		return true
+	case <-done:
+		return false
+	}
+}
+
+// broadcast wakes every goroutine waiting on the condition.
+func (c *condition) broadcast() {
+	if c.ch != nil {
+		close(c.ch)
+		c.ch = nil
+	}
+}
+
+// contextError reports why a wait on ctx ended: TimeoutError if its deadline
+// passed and InterruptedError if it was cancelled. The error wraps ctx.Err().
+func contextError(ctx context.Context, typ, op string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return errcodes.Wrap(errcodes.TimeoutError, typ, op, ctx.Err())
+	}
+	return errcodes.Wrap(errcodes.InterruptedError, typ, op, ctx.Err())
+}
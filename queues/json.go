@@ -0,0 +1,102 @@
+package queues
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// Queues are encoded as JSON arrays. Decoding replaces the current contents, and, as
+// encoding/json recommends, decoding a JSON null is a no-op.
+
+// MarshalJSON encodes the deque as a JSON array from first to last.
+func (d *ArrayDeque[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(d.ToArray())
+}
+
+// UnmarshalJSON replaces the contents of the deque with the elements of a JSON array.
+func (d *ArrayDeque[E]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[E](data)
+	if err != nil || values == nil {
+		return err
+	}
+
+	elements := make([]E, roundUpToPowerOfTwo(max(len(values)+1, DefaultDequeCapacity)))
+	copy(elements, values)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.elements = elements
+	d.head = 0
+	d.size = len(values)
+	d.modCount++
+	return nil
+}
+
+// MarshalJSON encodes the queue as a JSON array in priority order, head first.
+func (pq *PriorityQueue[E]) MarshalJSON() ([]byte, error) {
+	values := pq.ToArray()
+	slices.SortStableFunc(values, pq.comparator.Compare)
+	return marshalJSONArray(values)
+}
+
+// UnmarshalJSON replaces the contents of the queue with the elements of a JSON array.
+// The queue keeps its comparator, so it must have been created with NewPriorityQueue;
+// decoding into a zero PriorityQueue returns NullPointerError. Use
+// NewPriorityQueueFromJSON to decode into a new queue with a given comparator.
+func (pq *PriorityQueue[E]) UnmarshalJSON(data []byte) error {
+	if pq.comparator == nil {
+		return errcodes.New(errcodes.NullPointerError, "PriorityQueue", "UnmarshalJSON")
+	}
+	values, err := unmarshalJSONArray[E](data)
+	if err != nil || values == nil {
+		return err
+	}
+
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	pq.elements = values
+	pq.heapify()
+	pq.modCount++
+	return nil
+}
+
+// NewPriorityQueueFromJSON creates a new priority queue ordered by the given comparator
+// containing the elements of a JSON array.
+// Returns NullPointerError if the comparator is nil.
+func NewPriorityQueueFromJSON[E comparable](data []byte, comparator collections.Comparator[E]) (collections.Queue[E], error) {
+	if comparator == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "PriorityQueue", "NewPriorityQueueFromJSON")
+	}
+	pq := &PriorityQueue[E]{
+		elements:   make([]E, 0, DefaultCapacity),
+		comparator: comparator,
+	}
+	if err := pq.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return pq, nil
+}
+
+// marshalJSONArray encodes values as a JSON array, writing an empty array rather than null for no values.
+func marshalJSONArray[E any](values []E) ([]byte, error) {
+	if values == nil {
+		values = []E{}
+	}
+	return json.Marshal(values)
+}
+
+// unmarshalJSONArray decodes a JSON array. It returns nil without an error for a JSON null.
+func unmarshalJSONArray[E any](data []byte) ([]E, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, nil
+	}
+	values := []E{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
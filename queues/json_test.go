@@ -0,0 +1,95 @@
+package queues
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+func TestArrayDeque_JSON(t *testing.T) {
+	d := NewArrayDeque[int]()
+	d.Add(2)
+	d.Add(3)
+	d.AddFirst(1)
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `[1,2,3]` {
+		t.Errorf("Expected [1,2,3], got %s", data)
+	}
+
+	var decoded ArrayDeque[int]
+	if err := json.Unmarshal([]byte(`[4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20]`), &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Size() != 17 {
+		t.Errorf("Expected size 17, got %d", decoded.Size())
+	}
+	decoded.AddFirst(3)
+	decoded.AddLast(21)
+	first, _ := decoded.PeekFirst()
+	last, _ := decoded.PeekLast()
+	if *first != 3 || *last != 21 {
+		t.Errorf("Expected 3 and 21 at the ends, got %d and %d", *first, *last)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &decoded); err != nil || decoded.Size() != 19 {
+		t.Errorf("Expected null to leave the deque unchanged, got size %d and error %v", decoded.Size(), err)
+	}
+	if err := json.Unmarshal([]byte(`{}`), &decoded); err == nil {
+		t.Error("Expected an error when decoding an object")
+	}
+}
+
+func TestPriorityQueue_JSON(t *testing.T) {
+	pq := NewPriorityQueue[int](comparators.Natural[int]())
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		pq.Add(v)
+	}
+	data, err := json.Marshal(pq)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `[1,2,3,4,5]` {
+		t.Errorf("Expected elements in priority order, got %s", data)
+	}
+
+	// The comparator is supplied on decode
+	decoded, err := NewPriorityQueueFromJSON([]byte(`[3,9,1,7]`), comparators.Reverse(comparators.Natural[int]()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var polled []int
+	for !decoded.IsEmpty() {
+		v, _ := decoded.Poll()
+		polled = append(polled, *v)
+	}
+	if !reflect.DeepEqual(polled, []int{9, 7, 3, 1}) {
+		t.Errorf("Expected [9 7 3 1], got %v", polled)
+	}
+
+	existing := NewPriorityQueue[int](comparators.Natural[int]())
+	existing.Add(100)
+	if err := json.Unmarshal([]byte(`[8,6]`), existing); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	head, _ := existing.Peek()
+	if existing.Size() != 2 || *head != 6 {
+		t.Errorf("Expected decoded contents with head 6, got size %d and head %d", existing.Size(), *head)
+	}
+
+	if _, err := NewPriorityQueueFromJSON[int](data, nil); err == nil || err.Error() != string(errcodes.NullPointerError) {
+		t.Errorf("Expected NullPointerError for a nil comparator, got %v", err)
+	}
+	var zero PriorityQueue[int]
+	if err := json.Unmarshal(data, &zero); err == nil || err.Error() != string(errcodes.NullPointerError) {
+		t.Errorf("Expected NullPointerError for a zero queue, got %v", err)
+	}
+	if _, err := NewPriorityQueueFromJSON([]byte(`[1,`), comparators.Natural[int]()); err == nil {
+		t.Error("Expected an error for malformed JSON")
+	}
+}
@@ -0,0 +1,422 @@
+package queues
+
+import (
+	"context"
+	"iter"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// linkedQueueNode is an element of a LinkedBlockingQueue
+type linkedQueueNode[E comparable] struct {
+	value E
+	next  *linkedQueueNode[E]
+}
+
+// LinkedBlockingQueue is an optionally bounded blocking queue backed by linked nodes.
+// Elements are ordered first-in-first-out. Put waits for space when the queue
+// is full and Take waits for an element when it is empty. The queue is safe
+// for use by any number of producers and consumers.
+type LinkedBlockingQueue[E comparable] struct {
+	head     *linkedQueueNode[E]
+	tail     *linkedQueueNode[E]
+	count    int
+	capacity int
+	mu       sync.Mutex
+	notEmpty condition // Signalled when an element is added
+	notFull  condition // Signalled when an element is removed
+}
+
+// NewLinkedBlockingQueue creates a new LinkedBlockingQueue with no capacity bound
+func NewLinkedBlockingQueue[E comparable]() *LinkedBlockingQueue[E] {
+	return &LinkedBlockingQueue[E]{
+		capacity: math.MaxInt,
+	}
+}
+
+// NewLinkedBlockingQueueWithCapacity creates a new LinkedBlockingQueue that holds at most capacity elements.
+// It returns nil if the capacity is less than 1.
+func NewLinkedBlockingQueueWithCapacity[E comparable](capacity int) *LinkedBlockingQueue[E] {
+	if capacity < 1 {
+		return nil
+	}
+	return &LinkedBlockingQueue[E]{
+		capacity: capacity,
+	}
+}
+
+// Add inserts the specified element at the tail of this queue if there is space.
+// It returns false if the queue is full.
+func (q *LinkedBlockingQueue[E]) Add(element E) bool {
+	return q.Offer(element)
+}
+
+// AddAll adds the elements of the specified collection until the queue is full.
+// It returns true if at least one element was added.
+func (q *LinkedBlockingQueue[E]) AddAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	// Get elements first to avoid holding the lock while reading the other collection
+	elements := collection.ToArray()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	modified := false
+	for _, element := range elements {
+		if q.count == q.capacity {
+			break
+		}
+		q.enqueue(element)
+		modified = true
+	}
+	return modified
+}
+
+// Clear removes all elements from this queue
+func (q *LinkedBlockingQueue[E]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.head = nil
+	q.tail = nil
+	q.count = 0
+	q.notFull.broadcast()
+}
+
+// Contains returns true if this queue contains the specified element
+func (q *LinkedBlockingQueue[E]) Contains(element E) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.contains(element)
+}
+
+// ContainsAll returns true if this queue contains all elements from the specified collection
+func (q *LinkedBlockingQueue[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "LinkedBlockingQueue", "ContainsAll")
+	}
+
+	elements := collection.ToArray()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, element := range elements {
+		if !q.contains(element) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Equals returns true if the specified collection contains the same elements in the same order
+func (q *LinkedBlockingQueue[E]) Equals(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	elements := collection.ToArray()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(elements) != q.count {
+		return false
+	}
+	current := q.head
+	for _, element := range elements {
+		if current.value != element {
+			return false
+		}
+		current = current.next
+	}
+	return true
+}
+
+// IsEmpty returns true if this queue contains no elements
+func (q *LinkedBlockingQueue[E]) IsEmpty() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.count == 0
+}
+
+// Iterator returns an iterator over a snapshot of the elements in this queue, from head to tail
+func (q *LinkedBlockingQueue[E]) Iterator() collections.Iterator[E] {
+	return &blockingQueueIterator[E]{elements: q.ToArray()}
+}
+
+// All returns a sequence over a snapshot of the elements in this queue, from head to tail.
+// The snapshot is taken when iteration starts, so the loop body may modify the queue.
+func (q *LinkedBlockingQueue[E]) All() iter.Seq[E] {
+	return snapshotSeq(q.ToArray)
+}
+
+// Remove removes a single instance of the specified element from this queue
+func (q *LinkedBlockingQueue[E]) Remove(element E) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var prev *linkedQueueNode[E]
+	for current := q.head; current != nil; prev, current = current, current.next {
+		if current.value == element {
+			q.unlink(prev, current)
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveAll removes all elements from this queue that are also contained in the specified collection
+func (q *LinkedBlockingQueue[E]) RemoveAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	elements := collection.ToArray()
+	remove := make(map[E]bool, len(elements))
+	for _, element := range elements {
+		remove[element] = true
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	modified := false
+	var prev *linkedQueueNode[E]
+	for current := q.head; current != nil; current = current.next {
+		if remove[current.value] {
+			q.unlink(prev, current)
+			modified = true
+		} else {
+			prev = current
+		}
+	}
+	return modified
+}
+
+// Size returns the number of elements in this queue
+func (q *LinkedBlockingQueue[E]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.count
+}
+
+// ToArray returns a slice containing all elements in this queue, from head to tail
+func (q *LinkedBlockingQueue[E]) ToArray() []E {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	result := make([]E, 0, q.count)
+	for current := q.head; current != nil; current = current.next {
+		result = append(result, current.value)
+	}
+	return result
+}
+
+// Element retrieves, but does not remove, the head of this queue
+func (q *LinkedBlockingQueue[E]) Element() (*E, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedBlockingQueue", "Element")
+	}
+	value := q.head.value
+	return &value, nil
+}
+
+// Offer inserts the specified element at the tail of this queue if there is space.
+// It returns false without waiting if the queue is full.
+func (q *LinkedBlockingQueue[E]) Offer(element E) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count == q.capacity {
+		return false
+	}
+	q.enqueue(element)
+	return true
+}
+
+// Peek retrieves, but does not remove, the head of this queue, or returns nil if this queue is empty
+func (q *LinkedBlockingQueue[E]) Peek() (*E, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count == 0 {
+		return nil, nil
+	}
+	value := q.head.value
+	return &value, nil
+}
+
+// Poll retrieves and removes the head of this queue without waiting
+func (q *LinkedBlockingQueue[E]) Poll() (*E, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count == 0 {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedBlockingQueue", "Poll")
+	}
+	value := q.dequeue()
+	return &value, nil
+}
+
+// RemoveHead retrieves and removes the head of this queue
+func (q *LinkedBlockingQueue[E]) RemoveHead() (*E, error) {
+	return q.Poll()
+}
+
+// Put inserts the specified element at the tail of this queue, waiting for space if the queue is full
+func (q *LinkedBlockingQueue[E]) Put(element E) error {
+	return q.PutContext(context.Background(), element)
+}
+
+// Take retrieves and removes the head of this queue, waiting for an element if the queue is empty
+func (q *LinkedBlockingQueue[E]) Take() (*E, error) {
+	return q.TakeContext(context.Background())
+}
+
+// OfferTimeout inserts the specified element at the tail of this queue, waiting up to the
+// timeout for space if the queue is full. It returns TimeoutError if no space became available.
+func (q *LinkedBlockingQueue[E]) OfferTimeout(element E, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.PutContext(ctx, element)
+}
+
+// PollTimeout retrieves and removes the head of this queue, waiting up to the timeout for
+// an element if the queue is empty. It returns TimeoutError if no element became available.
+func (q *LinkedBlockingQueue[E]) PollTimeout(timeout time.Duration) (*E, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.TakeContext(ctx)
+}
+
+// PutContext inserts the specified element at the tail of this queue, waiting for space
+// until the context is done. If space is available the element is inserted even if the
+// context is already done. Otherwise it returns TimeoutError if the context's deadline
+// passed, or InterruptedError if the context was cancelled.
+func (q *LinkedBlockingQueue[E]) PutContext(ctx context.Context, element E) error {
+	if ctx == nil {
+		return errcodes.New(errcodes.NullPointerError, "LinkedBlockingQueue", "PutContext")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.count == q.capacity {
+		if !q.notFull.await(&q.mu, ctx.Done()) {
+			return contextError(ctx, "LinkedBlockingQueue", "PutContext")
+		}
+	}
+	q.enqueue(element)
+	return nil
+}
+
+// TakeContext retrieves and removes the head of this queue, waiting for an element until
+// the context is done. If an element is available it is returned even if the context is
+// already done. Otherwise it returns TimeoutError if the context's deadline passed, or
+// InterruptedError if the context was cancelled.
+func (q *LinkedBlockingQueue[E]) TakeContext(ctx context.Context) (*E, error) {
+	if ctx == nil {
+		return nil, errcodes.New(errcodes.NullPointerError, "LinkedBlockingQueue", "TakeContext")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.count == 0 {
+		if !q.notEmpty.await(&q.mu, ctx.Done()) {
+			return nil, contextError(ctx, "LinkedBlockingQueue", "TakeContext")
+		}
+	}
+	value := q.dequeue()
+	return &value, nil
+}
+
+// RemainingCapacity returns the number of elements this queue can accept without blocking.
+// An unbounded queue reports math.MaxInt minus its size.
+func (q *LinkedBlockingQueue[E]) RemainingCapacity() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.capacity - q.count
+}
+
+// DrainTo removes all available elements from this queue and adds them to the given collection.
+// It returns the number of elements transferred.
+func (q *LinkedBlockingQueue[E]) DrainTo(c collections.Collection[E]) int {
+	if c == nil {
+		return 0
+	}
+
+	q.mu.Lock()
+	elements := make([]E, 0, q.count)
+	for q.count > 0 {
+		elements = append(elements, q.dequeue())
+	}
+	q.mu.Unlock()
+
+	// Add to the target without holding the lock, in case it is another blocking queue
+	for _, element := range elements {
+		c.Add(element)
+	}
+	return len(elements)
+}
+
+// Helper methods. All of them assume the lock is already held.
+
+func (q *LinkedBlockingQueue[E]) enqueue(element E) {
+	newNode := &linkedQueueNode[E]{value: element}
+	if q.tail == nil {
+		q.head = newNode
+	} else {
+		q.tail.next = newNode
+	}
+	q.tail = newNode
+	q.count++
+	q.notEmpty.broadcast()
+}
+
+func (q *LinkedBlockingQueue[E]) dequeue() E {
+	first := q.head
+	q.head = first.next
+	if q.head == nil {
+		q.tail = nil
+	}
+	first.next = nil
+	q.count--
+	q.notFull.broadcast()
+	return first.value
+}
+
+func (q *LinkedBlockingQueue[E]) contains(element E) bool {
+	for current := q.head; current != nil; current = current.next {
+		if current.value == element {
+			return true
+		}
+	}
+	return false
+}
+
+// unlink removes node, whose predecessor is prev (nil for the head)
+func (q *LinkedBlockingQueue[E]) unlink(prev, node *linkedQueueNode[E]) {
+	if prev == nil {
+		q.head = node.next
+	} else {
+		prev.next = node.next
+	}
+	if q.tail == node {
+		q.tail = prev
+	}
+	q.count--
+	q.notFull.broadcast()
+}
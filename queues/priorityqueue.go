@@ -2,11 +2,12 @@ package queues
 
 import (
 	"cmp"
-	"errors"
+	"iter"
 	"reflect"
 	"sync"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
+	"github.com/chiranjeevipavurala/gocollections/comparators"
 	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 )
 
@@ -20,6 +21,7 @@ const GrowthFactor = 1.5
 type PriorityQueue[E comparable] struct {
 	elements   []E
 	comparator collections.Comparator[E]
+	modCount   int          // Structural modification count for fail-fast iterators
 	mu         sync.RWMutex // For thread safety
 }
 
@@ -59,6 +61,7 @@ func (pq *PriorityQueue[E]) Add(element E) bool {
 	pq.ensureCapacity(len(pq.elements) + 1)
 	pq.elements = append(pq.elements, element)
 	pq.siftUp(len(pq.elements) - 1)
+	pq.modCount++
 	return true
 }
 
@@ -68,7 +71,7 @@ func (pq *PriorityQueue[E]) Poll() (*E, error) {
 	defer pq.mu.Unlock()
 
 	if len(pq.elements) == 0 {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "PriorityQueue", "Poll")
 	}
 
 	result := pq.elements[0]
@@ -82,6 +85,7 @@ func (pq *PriorityQueue[E]) Poll() (*E, error) {
 	} else {
 		pq.elements = pq.elements[:0]
 	}
+	pq.modCount++
 
 	return &result, nil
 }
@@ -117,6 +121,7 @@ func (pq *PriorityQueue[E]) Clear() {
 	pq.mu.Lock()
 	defer pq.mu.Unlock()
 	pq.elements = make([]E, 0, DefaultCapacity)
+	pq.modCount++
 }
 
 // Contains returns true if this collection contains the specified element
@@ -132,21 +137,32 @@ func (pq *PriorityQueue[E]) Contains(element E) bool {
 	return false
 }
 
-// Iterator returns an iterator over the elements in this collection
+// All returns a sequence over a snapshot of the elements in this queue, in no particular order.
+// The snapshot is taken when iteration starts, so the loop body may modify the queue.
+func (pq *PriorityQueue[E]) All() iter.Seq[E] {
+	return snapshotSeq(pq.ToArray)
+}
+
+// Iterator returns an iterator over the elements in this collection in heap order, not priority order.
+// The iterator reads the live heap and fails with ConcurrentModificationError
+// if the queue is structurally modified after the iterator is created.
 func (pq *PriorityQueue[E]) Iterator() collections.Iterator[E] {
 	pq.mu.RLock()
 	defer pq.mu.RUnlock()
 
-	// Make a copy of the elements to avoid concurrent modification issues
-	elements := make([]E, len(pq.elements))
-	copy(elements, pq.elements)
-
 	return &priorityQueueIterator[E]{
-		elements: elements,
-		position: 0,
+		queue:            pq,
+		position:         0,
+		expectedModCount: pq.modCount,
 	}
 }
 
+// SnapshotIterator returns an iterator over a copy of the elements taken now.
+// Unlike Iterator, it keeps working if the queue is modified during iteration.
+func (pq *PriorityQueue[E]) SnapshotIterator() collections.Iterator[E] {
+	return collections.NewSnapshotIterator(pq.ToArray())
+}
+
 // ToArray returns an array containing all of the elements in this collection
 func (pq *PriorityQueue[E]) ToArray() []E {
 	pq.mu.RLock()
@@ -184,6 +200,7 @@ func (pq *PriorityQueue[E]) Remove(element E) bool {
 	}
 	// Trim the slice to remove unused capacity
 	pq.elements = pq.elements[:lastIndex]
+	pq.modCount++
 
 	return true
 }
@@ -209,6 +226,7 @@ func (pq *PriorityQueue[E]) AddAll(collection collections.Collection[E]) bool {
 
 	// Heapify the entire array at once (more efficient than sifting up each element)
 	pq.heapify()
+	pq.modCount++
 
 	return true
 }
@@ -238,7 +256,7 @@ func (pq *PriorityQueue[E]) RemoveAll(collection collections.Collection[E]) bool
 // ContainsAll returns true if this collection contains all of the elements in the specified collection
 func (pq *PriorityQueue[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
 	if collection == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "PriorityQueue", "ContainsAll")
 	}
 
 	// Get elements first to minimize lock time
@@ -372,6 +390,7 @@ func (pq *PriorityQueue[E]) removeElement(element E) bool {
 				}
 			}
 			pq.elements = pq.elements[:lastIndex]
+			pq.modCount++
 			return true
 		}
 	}
@@ -390,22 +409,31 @@ func (pq *PriorityQueue[E]) containsElement(element E) bool {
 
 // priorityQueueIterator provides iteration over the elements in a PriorityQueue
 type priorityQueueIterator[E comparable] struct {
-	elements []E
-	position int
+	queue            *PriorityQueue[E]
+	position         int
+	expectedModCount int
 }
 
 // HasNext returns true if the iteration has more elements
 func (it *priorityQueueIterator[E]) HasNext() bool {
-	return it.position < len(it.elements)
+	it.queue.mu.RLock()
+	defer it.queue.mu.RUnlock()
+	return it.position < len(it.queue.elements)
 }
 
 // Next returns the next element in the iteration
 func (it *priorityQueueIterator[E]) Next() (*E, error) {
-	if !it.HasNext() {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+	it.queue.mu.RLock()
+	defer it.queue.mu.RUnlock()
+
+	if it.queue.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "PriorityQueue", "Iterator.Next")
+	}
+	if it.position >= len(it.queue.elements) {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "PriorityQueue", "Iterator.Next")
 	}
 
-	value := it.elements[it.position]
+	value := it.queue.elements[it.position]
 	it.position++
 
 	return &value, nil
@@ -465,6 +493,7 @@ func (pq *PriorityQueue[E]) RemoveIf(predicate func(E) bool) bool {
 	for i := newSize/2 - 1; i >= 0; i-- {
 		pq.siftDown(i)
 	}
+	pq.modCount++
 
 	return true
 }
@@ -490,7 +519,7 @@ func (pq *PriorityQueue[E]) ToArrayWithType(arrayType reflect.Type) (interface{}
 	defer pq.mu.RUnlock()
 
 	if arrayType.Kind() != reflect.Array && arrayType.Kind() != reflect.Slice {
-		return nil, errors.New("type must be an array or slice")
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "PriorityQueue", "ToArrayWithType")
 	}
 
 	// Create a new array of the specified type
@@ -528,7 +557,9 @@ func NewPriorityQueueFromCollection[E comparable](collection collections.Collect
 	return pq
 }
 
-// IntComparator implements Comparator for any ordered type
+// IntComparator implements Comparator for any ordered type.
+//
+// Deprecated: Use comparators.Natural, which does the same and is not limited to the queues package.
 type IntComparator[E cmp.Ordered] struct{}
 
 func (c *IntComparator[E]) Compare(a, b E) int {
@@ -538,12 +569,12 @@ func (c *IntComparator[E]) Compare(a, b E) int {
 // NewPriorityQueueFromSortedSet creates a new priority queue from a sorted set
 func NewPriorityQueueFromSortedSet[E cmp.Ordered](sortedSet collections.SortedSet[E]) collections.Queue[E] {
 	if sortedSet == nil || sortedSet.IsEmpty() {
-		return NewPriorityQueue[E](&IntComparator[E]{})
+		return NewPriorityQueue[E](comparators.Natural[E]())
 	}
 
 	// Get elements from the sorted set
 	elements := sortedSet.ToArray()
-	priorityQueue := NewPriorityQueueWithCapacity[E](len(elements), &IntComparator[E]{})
+	priorityQueue := NewPriorityQueueWithCapacity[E](len(elements), comparators.Natural[E]())
 
 	// Add all elements to the priority queue
 	for _, element := range elements {
@@ -557,7 +588,7 @@ func NewPriorityQueueFromSortedSet[E cmp.Ordered](sortedSet collections.SortedSe
 func (pq *PriorityQueue[E]) Element() (*E, error) {
 	result, _ := pq.Peek()
 	if result == nil {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+		return nil, errcodes.New(errcodes.NoSuchElementError, "PriorityQueue", "Element")
 	}
 	return result, nil
 }
@@ -2,6 +2,8 @@ package queues
 
 import (
 	"reflect"
+	"slices"
+	"sort"
 	"testing"
 
 	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
@@ -1530,3 +1532,73 @@ func TestPriorityQueue_RemoveElementWithSiftUp_MaxHeap_Trigger(t *testing.T) {
 		}
 	}
 }
+
+func TestPriorityQueueAll(t *testing.T) {
+	pq := NewPriorityQueue[int](&IntComparator[int]{})
+	for _, v := range []int{3, 1, 2} {
+		pq.Add(v)
+	}
+
+	got := slices.Collect(pq.All())
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+
+	// The sequence iterates over a snapshot, so the loop body may poll the queue
+	count := 0
+	for range pq.All() {
+		pq.Poll()
+		count++
+	}
+	if count != 3 || !pq.IsEmpty() {
+		t.Errorf("Expected to visit 3 elements and empty the queue, visited %d and left %d", count, pq.Size())
+	}
+}
+
+func TestPriorityQueue_IteratorConcurrentModification(t *testing.T) {
+	pq := NewPriorityQueue[int](&IntComparator[int]{})
+	pq.Add(5)
+	pq.Add(3)
+	pq.Add(7)
+
+	iterator := pq.Iterator()
+	if _, err := iterator.Next(); err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+
+	// Removing a missing element is not a structural modification
+	pq.Remove(42)
+	if _, err := iterator.Next(); err != nil {
+		t.Errorf("Next returned error after no-op removal: %v", err)
+	}
+
+	pq.Poll()
+	val, err := iterator.Next()
+	if val != nil || err == nil || err.Error() != string(errcodes.ConcurrentModificationError) {
+		t.Errorf("Expected ConcurrentModificationError, got %v, %v", val, err)
+	}
+}
+
+func TestPriorityQueue_SnapshotIterator(t *testing.T) {
+	pq := NewPriorityQueue[int](&IntComparator[int]{}).(*PriorityQueue[int])
+	pq.Add(5)
+	pq.Add(3)
+	pq.Add(7)
+
+	iterator := pq.SnapshotIterator()
+	pq.Clear()
+
+	var result []int
+	for iterator.HasNext() {
+		val, err := iterator.Next()
+		if err != nil {
+			t.Fatalf("Next returned error: %v", err)
+		}
+		result = append(result, *val)
+	}
+	sort.Ints(result)
+	if !reflect.DeepEqual(result, []int{3, 5, 7}) {
+		t.Errorf("Expected [3 5 7], got %v", result)
+	}
+}
@@ -0,0 +1,189 @@
+package queues
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+)
+
+// reversedDeque is a reverse-order view of a deque. The head of the view is the tail of
+// the underlying deque, and every operation is mapped onto the opposite end, so nothing
+// is copied and the underlying order is never changed.
+type reversedDeque[E comparable] struct {
+	deque collections.Deque[E]
+}
+
+// newReversedDeque returns a reverse-order view of deque
+func newReversedDeque[E comparable](deque collections.Deque[E]) *reversedDeque[E] {
+	return &reversedDeque[E]{deque: deque}
+}
+
+// Add inserts the specified element at the end of this view, which is the front of the deque
+func (r *reversedDeque[E]) Add(element E) bool {
+	r.deque.AddFirst(element)
+	return true
+}
+
+// AddAll adds all elements of the specified collection at the end of this view
+func (r *reversedDeque[E]) AddAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	for _, element := range elements {
+		r.deque.AddFirst(element)
+	}
+	return len(elements) > 0
+}
+
+func (r *reversedDeque[E]) Clear() {
+	r.deque.Clear()
+}
+
+func (r *reversedDeque[E]) Contains(element E) bool {
+	return r.deque.Contains(element)
+}
+
+func (r *reversedDeque[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
+	return r.deque.ContainsAll(collection)
+}
+
+// Equals returns true if the specified collection contains the same elements in the same order as this view
+func (r *reversedDeque[E]) Equals(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	return slices.Equal(r.ToArray(), collection.ToArray())
+}
+
+func (r *reversedDeque[E]) IsEmpty() bool {
+	return r.deque.IsEmpty()
+}
+
+// Iterator returns an iterator over this view, which walks the deque from last to first
+func (r *reversedDeque[E]) Iterator() collections.Iterator[E] {
+	return r.deque.DescendingIterator()
+}
+
+// DescendingIterator returns an iterator over this view from last to first, which is the deque's own order
+func (r *reversedDeque[E]) DescendingIterator() collections.Iterator[E] {
+	return r.deque.Iterator()
+}
+
+func (r *reversedDeque[E]) All() iter.Seq[E] {
+	return r.deque.Backward()
+}
+
+func (r *reversedDeque[E]) Backward() iter.Seq[E] {
+	return r.deque.All()
+}
+
+// Remove removes the first occurrence of the element in this view, which is the last one in the deque
+func (r *reversedDeque[E]) Remove(element E) bool {
+	return r.deque.RemoveLastOccurrence(element)
+}
+
+func (r *reversedDeque[E]) RemoveAll(collection collections.Collection[E]) bool {
+	return r.deque.RemoveAll(collection)
+}
+
+func (r *reversedDeque[E]) Size() int {
+	return r.deque.Size()
+}
+
+// ToArray returns a slice containing the elements of this view, from the last element of the deque to the first
+func (r *reversedDeque[E]) ToArray() []E {
+	elements := r.deque.ToArray()
+	slices.Reverse(elements)
+	return elements
+}
+
+func (r *reversedDeque[E]) Element() (*E, error) {
+	return r.deque.GetLast()
+}
+
+func (r *reversedDeque[E]) Offer(element E) bool {
+	return r.deque.OfferFirst(element)
+}
+
+func (r *reversedDeque[E]) Peek() (*E, error) {
+	return r.deque.PeekLast()
+}
+
+func (r *reversedDeque[E]) Poll() (*E, error) {
+	return r.deque.PollLast()
+}
+
+func (r *reversedDeque[E]) RemoveHead() (*E, error) {
+	return r.deque.RemoveLast()
+}
+
+func (r *reversedDeque[E]) AddFirst(element E) {
+	r.deque.AddLast(element)
+}
+
+func (r *reversedDeque[E]) AddLast(element E) {
+	r.deque.AddFirst(element)
+}
+
+func (r *reversedDeque[E]) GetFirst() (*E, error) {
+	return r.deque.GetLast()
+}
+
+func (r *reversedDeque[E]) GetLast() (*E, error) {
+	return r.deque.GetFirst()
+}
+
+func (r *reversedDeque[E]) RemoveFirst() (*E, error) {
+	return r.deque.RemoveLast()
+}
+
+func (r *reversedDeque[E]) RemoveLast() (*E, error) {
+	return r.deque.RemoveFirst()
+}
+
+// Reversed returns the underlying deque
+func (r *reversedDeque[E]) Reversed() collections.Collection[E] {
+	return r.deque
+}
+
+func (r *reversedDeque[E]) OfferFirst(element E) bool {
+	return r.deque.OfferLast(element)
+}
+
+func (r *reversedDeque[E]) OfferLast(element E) bool {
+	return r.deque.OfferFirst(element)
+}
+
+func (r *reversedDeque[E]) PeekFirst() (*E, error) {
+	return r.deque.PeekLast()
+}
+
+func (r *reversedDeque[E]) PeekLast() (*E, error) {
+	return r.deque.PeekFirst()
+}
+
+func (r *reversedDeque[E]) PollFirst() (*E, error) {
+	return r.deque.PollLast()
+}
+
+func (r *reversedDeque[E]) PollLast() (*E, error) {
+	return r.deque.PollFirst()
+}
+
+func (r *reversedDeque[E]) Pop() (*E, error) {
+	return r.deque.RemoveLast()
+}
+
+func (r *reversedDeque[E]) Push(element E) {
+	r.deque.AddLast(element)
+}
+
+func (r *reversedDeque[E]) RemoveFirstOccurrence(element E) bool {
+	return r.deque.RemoveLastOccurrence(element)
+}
+
+func (r *reversedDeque[E]) RemoveLastOccurrence(element E) bool {
+	return r.deque.RemoveFirstOccurrence(element)
+}
@@ -0,0 +1,15 @@
+package queues
+
+import "iter"
+
+// snapshotSeq returns a sequence that yields the elements returned by snapshot, which is called
+// once each time iteration starts. No lock is held while the loop body runs.
+func snapshotSeq[E any](snapshot func() []E) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, element := range snapshot() {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
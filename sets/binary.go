@@ -0,0 +1,202 @@
+package sets
+
+import (
+	"io"
+	"slices"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/codec"
+)
+
+// Sets use the binary format of the internal codec package, in which the elements are
+// gob-encoded in iteration order. WriteTo streams the elements while holding the read
+// lock, so writers wait until it returns. ReadFrom, UnmarshalBinary and GobDecode replace
+// the current contents and drop duplicate elements.
+
+// WriteTo writes the set to w in the binary format and returns the number of bytes written.
+func (h *HashSet[E]) WriteTo(w io.Writer) (int64, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return codec.WriteSequence(w, codec.Sequence, len(h.elements), slices.Values(h.elements))
+}
+
+// ReadFrom replaces the contents of the set with a collection read from r in the binary
+// format and returns the number of bytes read.
+func (h *HashSet[E]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSequence[E](r, codec.Sequence)
+	if err != nil {
+		return n, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.elements = make([]E, 0, len(values))
+	h.index = make(map[E]int, len(values))
+	h.modCount++
+	for _, value := range values {
+		h.add(value)
+	}
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (h *HashSet[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(h)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (h *HashSet[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(h, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (h *HashSet[E]) GobEncode() ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (h *HashSet[E]) GobDecode(data []byte) error {
+	return h.UnmarshalBinary(data)
+}
+
+// WriteTo writes the set to w in the binary format, in insertion order, and returns the
+// number of bytes written.
+func (lhs *LinkedHashSet[E]) WriteTo(w io.Writer) (int64, error) {
+	lhs.mu.RLock()
+	defer lhs.mu.RUnlock()
+	return codec.WriteSequence(w, codec.Sequence, len(lhs.items), func(yield func(E) bool) {
+		for current := lhs.head; current != nil; current = current.next {
+			if !yield(current.value) {
+				return
+			}
+		}
+	})
+}
+
+// ReadFrom replaces the contents of the set with a collection read from r in the binary
+// format, keeping the order of first occurrence, and returns the number of bytes read.
+func (lhs *LinkedHashSet[E]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSequence[E](r, codec.Sequence)
+	if err != nil {
+		return n, err
+	}
+
+	lhs.mu.Lock()
+	defer lhs.mu.Unlock()
+	lhs.head = nil
+	lhs.tail = nil
+	lhs.items = make(map[E]*node[E], len(values))
+	lhs.modCount++
+	for _, value := range values {
+		lhs.add(value)
+	}
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (lhs *LinkedHashSet[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(lhs)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (lhs *LinkedHashSet[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(lhs, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (lhs *LinkedHashSet[E]) GobEncode() ([]byte, error) {
+	return lhs.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (lhs *LinkedHashSet[E]) GobDecode(data []byte) error {
+	return lhs.UnmarshalBinary(data)
+}
+
+// WriteTo writes the set to w in the binary format, in the order of this set or view,
+// and returns the number of bytes written.
+func (ts *TreeSet[E]) WriteTo(w io.Writer) (int64, error) {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	count := ts.tree.size
+	if !ts.fromStart || !ts.toEnd {
+		count = 0
+		for node := ts.first(); node != nil; node = ts.next(node) {
+			count++
+		}
+	}
+	return codec.WriteSequence(w, codec.Sequence, count, func(yield func(E) bool) {
+		for node := ts.first(); node != nil; node = ts.next(node) {
+			if !yield(node.value) {
+				return
+			}
+		}
+	})
+}
+
+// ReadFrom replaces the contents of the set with a collection read from r in the binary
+// format and returns the number of bytes read. The set keeps its comparator, so it must
+// have been created with NewTreeSet; reading into a zero TreeSet returns NullPointerError.
+// When a whole set reads elements that are already in its order, such as those written by
+// a TreeSet with the same comparator, the tree is rebuilt in linear time. On a view, only
+// the elements within the range of the view are replaced, and an element outside that
+// range makes the call fail with IllegalArgumentError without changing the set.
+func (ts *TreeSet[E]) ReadFrom(r io.Reader) (int64, error) {
+	if ts.tree == nil {
+		return 0, errcodes.New(errcodes.NullPointerError, "TreeSet", "ReadFrom")
+	}
+	values, n, err := codec.ReadSequence[E](r, codec.Sequence)
+	if err != nil {
+		return n, err
+	}
+
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+	for _, value := range values {
+		if !ts.inRange(value) {
+			return n, errcodes.New(errcodes.IllegalArgumentError, "TreeSet", "ReadFrom")
+		}
+	}
+	if ts.fromStart && ts.toEnd {
+		sorted := true
+		for i := 1; i < len(values) && sorted; i++ {
+			sorted = ts.tree.comparator.Compare(values[i-1], values[i]) < 0
+		}
+		if sorted {
+			ts.tree.buildFromSorted(values)
+			return n, nil
+		}
+		ts.tree.root = nil
+		ts.tree.size = 0
+		ts.tree.modCount++
+	} else {
+		for node := ts.absLowest(); node != nil; node = ts.absLowest() {
+			ts.tree.delete(node)
+		}
+	}
+	for _, value := range values {
+		ts.tree.insert(value)
+	}
+	return n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (ts *TreeSet[E]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(ts)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (ts *TreeSet[E]) UnmarshalBinary(data []byte) error {
+	return codec.Unmarshal(ts, data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (ts *TreeSet[E]) GobEncode() ([]byte, error) {
+	return ts.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (ts *TreeSet[E]) GobDecode(data []byte) error {
+	return ts.UnmarshalBinary(data)
+}
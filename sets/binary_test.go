@@ -0,0 +1,126 @@
+package sets
+
+import (
+	"bytes"
+	"encoding/gob"
+	"slices"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/codec"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashSet_Binary(t *testing.T) {
+	set := NewHashSet[string]().(*HashSet[string])
+	set.AddAll(lists.NewArrayListWithInitialCollection([]string{"b", "a", "c"}))
+	data, err := set.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := NewHashSet[string]().(*HashSet[string])
+	decoded.Add("old")
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, decoded.ToArray())
+	assert.False(t, decoded.Contains("old"))
+
+	var zero HashSet[string]
+	assert.NoError(t, zero.UnmarshalBinary(data))
+	assert.True(t, zero.Contains("a"))
+	assert.EqualError(t, zero.UnmarshalBinary([]byte("GCOL")), string(errcodes.InvalidFormatError))
+	assert.Equal(t, 3, zero.Size())
+}
+
+func TestLinkedHashSet_Binary(t *testing.T) {
+	set := NewLinkedHashSet[int]()
+	for _, v := range []int{5, 1, 4} {
+		set.Add(v)
+	}
+
+	type snapshot struct {
+		Set *LinkedHashSet[int]
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(snapshot{Set: set}))
+	var decoded snapshot
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, []int{5, 1, 4}, decoded.Set.ToArray())
+
+	// Duplicates in the input keep their first position
+	set = NewLinkedHashSet[int]()
+	assert.NoError(t, set.UnmarshalBinary(data(t, []int{2, 1, 2, 3})))
+	assert.Equal(t, []int{2, 1, 3}, set.ToArray())
+}
+
+func TestTreeSet_Binary(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 4, 7, 8, 100, 5000} {
+		set := NewTreeSet[int](comparators.Natural[int]())
+		for i := n - 1; i >= 0; i-- {
+			set.Add(i * 2)
+		}
+		var buf bytes.Buffer
+		written, err := set.WriteTo(&buf)
+		assert.NoError(t, err)
+
+		decoded := NewTreeSet[int](comparators.Natural[int]())
+		decoded.Add(-1)
+		read, err := decoded.ReadFrom(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, written, read)
+		assert.Equal(t, set.ToArray(), decoded.ToArray())
+		verifyTree(t, decoded.tree)
+		decoded.Add(1)
+		verifyTree(t, decoded.tree)
+	}
+
+	// Unsorted input and duplicates fall back to inserting one element at a time
+	set := NewTreeSet[int](comparators.Natural[int]())
+	assert.NoError(t, set.UnmarshalBinary(data(t, []int{9, 7, 8, 7})))
+	assert.Equal(t, []int{7, 8, 9}, set.ToArray())
+	verifyTree(t, set.tree)
+	assert.NoError(t, set.UnmarshalBinary(data(t, []int{1, 1, 2})))
+	assert.Equal(t, []int{1, 2}, set.ToArray())
+	verifyTree(t, set.tree)
+
+	// Elements are read in the order of the target set's comparator
+	descending := NewTreeSet[int](comparators.Reverse(comparators.Natural[int]()))
+	assert.NoError(t, descending.UnmarshalBinary(data(t, []int{1, 2, 3})))
+	assert.Equal(t, []int{3, 2, 1}, descending.ToArray())
+	verifyTree(t, descending.tree)
+
+	var zero TreeSet[int]
+	assert.EqualError(t, zero.UnmarshalBinary(data(t, []int{1})), string(errcodes.NullPointerError))
+}
+
+func TestTreeSet_BinaryView(t *testing.T) {
+	set := NewTreeSet[int](comparators.Natural[int]())
+	for i := 1; i <= 6; i++ {
+		set.Add(i)
+	}
+	view, err := set.SubSet(2, 5)
+	assert.NoError(t, err)
+
+	// A view writes only its own range
+	encoded, err := view.(*TreeSet[int]).MarshalBinary()
+	assert.NoError(t, err)
+	decoded := NewTreeSet[int](comparators.Natural[int]())
+	assert.NoError(t, decoded.UnmarshalBinary(encoded))
+	assert.Equal(t, []int{2, 3, 4}, decoded.ToArray())
+
+	// Reading into a view replaces only the view's range
+	assert.NoError(t, view.(*TreeSet[int]).UnmarshalBinary(data(t, []int{3})))
+	assert.Equal(t, []int{1, 3, 5, 6}, set.ToArray())
+	assert.EqualError(t, view.(*TreeSet[int]).UnmarshalBinary(data(t, []int{3, 10})), string(errcodes.IllegalArgumentError))
+	assert.Equal(t, []int{1, 3, 5, 6}, set.ToArray())
+	verifyTree(t, set.tree)
+}
+
+// data returns the binary encoding of values in the given order, keeping any duplicates.
+func data(t *testing.T, values []int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	_, err := codec.WriteSequence(&buf, codec.Sequence, len(values), slices.Values(values))
+	assert.NoError(t, err)
+	return buf.Bytes()
+}
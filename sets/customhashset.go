@@ -0,0 +1,276 @@
+package sets
+
+import (
+	"iter"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/hashing"
+	"github.com/chiranjeevipavurala/gocollections/internal/locking"
+)
+
+// CustomHashSet is a hash set that hashes and compares its elements with a Hasher instead
+// of ==, so it can hold elements that are not comparable, such as slices, or compare them
+// by a notion of equality of their own. Like HashSet it stores its elements densely and
+// removes by swapping, so iteration order is unspecified.
+type CustomHashSet[E any] struct {
+	table    *hashing.Table[E, struct{}]
+	modCount int
+	mu       locking.RWMutex
+}
+
+// NewHashSetWithHasher returns an empty set that hashes and compares elements with hasher.
+// It returns nil if hasher is nil.
+func NewHashSetWithHasher[E any](hasher collections.Hasher[E]) collections.Set[E] {
+	if hasher == nil {
+		return nil
+	}
+	return &CustomHashSet[E]{
+		table: hashing.New[E, struct{}](hasher, 0),
+	}
+}
+
+func (h *CustomHashSet[E]) Add(element E) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.add(element)
+}
+
+func (h *CustomHashSet[E]) AddAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	modified := false
+	for _, val := range elements {
+		if h.add(val) {
+			modified = true
+		}
+	}
+	return modified
+}
+
+func (h *CustomHashSet[E]) Clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.table.Clear()
+	h.modCount++
+}
+
+func (h *CustomHashSet[E]) Contains(element E) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.table.Index(element) >= 0
+}
+
+func (h *CustomHashSet[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "CustomHashSet", "ContainsAll")
+	}
+	elements := collection.ToArray()
+	if len(elements) == 0 {
+		return false, nil
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for _, val := range elements {
+		if h.table.Index(val) < 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Equals reports whether collection has the same size as this set and each of its elements
+// is in this set, as decided by this set's hasher.
+func (h *CustomHashSet[E]) Equals(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.table.Len() != len(elements) {
+		return false
+	}
+	for _, val := range elements {
+		if h.table.Index(val) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (h *CustomHashSet[E]) IsEmpty() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.table.Len() == 0
+}
+
+// Iterator returns a live iterator over the elements of this set.
+// Next fails with ConcurrentModificationError if the set is structurally
+// modified after the iterator is created.
+func (h *CustomHashSet[E]) Iterator() collections.Iterator[E] {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return &customHashSetIterator[E]{
+		set:              h,
+		expectedModCount: h.modCount,
+	}
+}
+
+// SnapshotIterator returns an iterator over a copy of the elements taken now.
+// Unlike Iterator, it keeps working if the set is modified during iteration.
+func (h *CustomHashSet[E]) SnapshotIterator() collections.Iterator[E] {
+	return collections.NewSnapshotIterator(h.ToArray())
+}
+
+// All returns a sequence over a snapshot of the elements in this set, in no particular order.
+// The snapshot is taken when iteration starts, so the loop body may modify the set.
+func (h *CustomHashSet[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, val := range h.ToArray() {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+func (h *CustomHashSet[E]) Remove(element E) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.remove(element)
+}
+
+func (h *CustomHashSet[E]) RemoveAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	elements := collection.ToArray()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	modified := false
+	for _, val := range elements {
+		if h.remove(val) {
+			modified = true
+		}
+	}
+	return modified
+}
+
+// RetainAll removes the elements that are not in collection, as decided by this set's hasher.
+func (h *CustomHashSet[E]) RetainAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+	retain := hashing.New[E, struct{}](h.table.Hasher(), collection.Size())
+	for _, val := range collection.ToArray() {
+		retain.Put(val, struct{}{})
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.removeMatching(func(val E) bool { return retain.Index(val) < 0 })
+}
+
+func (h *CustomHashSet[E]) Size() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.table.Len()
+}
+
+func (h *CustomHashSet[E]) ToArray() []E {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.table.Keys()
+}
+
+func (h *CustomHashSet[E]) RemoveIf(predicate func(E) bool) bool {
+	if predicate == nil {
+		return false
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.removeMatching(predicate)
+}
+
+func (h *CustomHashSet[E]) ForEach(action func(E)) {
+	if action == nil {
+		return
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for i := range h.table.Len() {
+		action(h.table.Key(i))
+	}
+}
+
+// Clone returns a copy of the set that uses the same hasher.
+func (h *CustomHashSet[E]) Clone() collections.Set[E] {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return &CustomHashSet[E]{
+		table: h.table.Clone(),
+	}
+}
+
+// Helper methods. All of them assume the write lock is already held.
+
+func (h *CustomHashSet[E]) add(element E) bool {
+	if _, exists := h.table.Put(element, struct{}{}); exists {
+		return false
+	}
+	h.modCount++
+	return true
+}
+
+func (h *CustomHashSet[E]) remove(element E) bool {
+	if _, exists := h.table.Remove(element); !exists {
+		return false
+	}
+	h.modCount++
+	return true
+}
+
+// removeMatching removes every element for which match returns true.
+// It walks backwards so that the element swapped into a vacated slot has already been checked.
+func (h *CustomHashSet[E]) removeMatching(match func(E) bool) bool {
+	modified := false
+	for i := h.table.Len() - 1; i >= 0; i-- {
+		if match(h.table.Key(i)) {
+			h.table.RemoveAt(i)
+			h.modCount++
+			modified = true
+		}
+	}
+	return modified
+}
+
+// customHashSetIterator iterates over the live elements of a CustomHashSet.
+type customHashSetIterator[E any] struct {
+	set              *CustomHashSet[E]
+	cursor           int
+	expectedModCount int
+}
+
+func (it *customHashSetIterator[E]) HasNext() bool {
+	it.set.mu.RLock()
+	defer it.set.mu.RUnlock()
+	return it.cursor < it.set.table.Len()
+}
+
+func (it *customHashSetIterator[E]) Next() (*E, error) {
+	it.set.mu.RLock()
+	defer it.set.mu.RUnlock()
+	if it.set.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "CustomHashSet", "Iterator.Next")
+	}
+	if it.cursor >= it.set.table.Len() {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "CustomHashSet", "Iterator.Next")
+	}
+	val := it.set.table.Key(it.cursor)
+	it.cursor++
+	return &val, nil
+}
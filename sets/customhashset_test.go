@@ -0,0 +1,134 @@
+package sets
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+var seed = maphash.MakeSeed()
+
+// caseInsensitive treats strings that differ only in case as equal.
+var caseInsensitive = collections.NewHasher(
+	func(s string) uint64 { return maphash.String(seed, strings.ToLower(s)) },
+	strings.EqualFold,
+)
+
+// intSlices compares slices of ints element by element.
+var intSlices = collections.NewHasher(
+	func(s []int) uint64 {
+		var buf []byte
+		for _, v := range s {
+			buf = binary.AppendVarint(buf, int64(v))
+		}
+		return maphash.Bytes(seed, buf)
+	},
+	slices.Equal[[]int],
+)
+
+func TestNewHashSetWithHasher(t *testing.T) {
+	set := NewHashSetWithHasher(caseInsensitive)
+	assert.True(t, set.IsEmpty())
+
+	assert.True(t, set.Add("Go"))
+	assert.False(t, set.Add("GO"))
+	assert.True(t, set.Add("Rust"))
+	assert.Equal(t, 2, set.Size())
+	assert.True(t, set.Contains("go"))
+	assert.ElementsMatch(t, []string{"Go", "Rust"}, set.ToArray())
+
+	assert.True(t, set.Remove("rust"))
+	assert.False(t, set.Remove("rust"))
+	assert.Equal(t, []string{"Go"}, slices.Collect(set.All()))
+
+	assert.Nil(t, NewHashSetWithHasher[string](nil), "set should be nil when hasher is nil")
+}
+
+func TestCustomHashSet_NonComparableElements(t *testing.T) {
+	set := NewHashSetWithHasher(intSlices)
+	set.Add([]int{1, 2})
+	set.Add([]int{2, 1})
+	set.Add([]int{1, 2})
+
+	assert.Equal(t, 2, set.Size())
+	assert.True(t, set.Contains([]int{2, 1}))
+	assert.False(t, set.Contains([]int{1}))
+}
+
+func TestCustomHashSet_BulkOperations(t *testing.T) {
+	set := NewHashSetWithHasher(caseInsensitive)
+	assert.True(t, set.AddAll(lists.NewArrayListWithInitialCollection([]string{"a", "b", "c", "d"})))
+	assert.False(t, set.AddAll(nil))
+
+	contains, err := set.ContainsAll(lists.NewArrayListWithInitialCollection([]string{"A", "B"}))
+	assert.NoError(t, err)
+	assert.True(t, contains)
+	_, err = set.ContainsAll(nil)
+	assert.ErrorIs(t, err, errcodes.ErrNullPointer)
+
+	assert.True(t, set.RemoveAll(lists.NewArrayListWithInitialCollection([]string{"D"})))
+	custom := set.(*CustomHashSet[string])
+	assert.True(t, custom.RetainAll(lists.NewArrayListWithInitialCollection([]string{"A", "C"})))
+	assert.ElementsMatch(t, []string{"a", "c"}, set.ToArray())
+	assert.True(t, custom.RemoveIf(func(s string) bool { return s == "a" }))
+
+	assert.True(t, set.Equals(lists.NewArrayListWithInitialCollection([]string{"C"})))
+	assert.False(t, set.Equals(lists.NewArrayListWithInitialCollection([]string{"a"})))
+	assert.False(t, set.Equals(nil))
+
+	clone := custom.Clone()
+	set.Clear()
+	assert.True(t, set.IsEmpty())
+	assert.True(t, clone.Contains("C"))
+}
+
+func TestCustomHashSet_Iterator(t *testing.T) {
+	set := NewHashSetWithHasher(caseInsensitive)
+	set.Add("a")
+	set.Add("b")
+
+	var seen []string
+	for it := set.Iterator(); it.HasNext(); {
+		value, err := it.Next()
+		assert.NoError(t, err)
+		seen = append(seen, *value)
+	}
+	assert.ElementsMatch(t, []string{"a", "b"}, seen)
+
+	it := set.Iterator()
+	set.Add("c")
+	_, err := it.Next()
+	assert.ErrorIs(t, err, errcodes.ErrConcurrentModification)
+
+	snapshot := set.(*CustomHashSet[string]).SnapshotIterator()
+	set.Clear()
+	assert.True(t, snapshot.HasNext())
+}
+
+func TestCustomHashSet_Concurrent(t *testing.T) {
+	set := NewHashSetWithHasher(caseInsensitive)
+	var wg sync.WaitGroup
+	for g := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				set.Add(string(rune('a' + i%26)))
+				if g%2 == 0 {
+					set.Remove(string(rune('A' + i%26)))
+				}
+				_ = set.Contains("a")
+			}
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, set.Size(), 26)
+}
@@ -1,22 +1,25 @@
 package sets
 
 import (
-	"errors"
-	"sync"
+	"iter"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
 	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/internal/locking"
 )
 
+// HashSet stores its elements densely in a slice, with a map from each element to its
+// position. Removal swaps the last element into the vacated slot, so iteration
+// order is unspecified but iterators can walk the live slice without copying it.
 type HashSet[E comparable] struct {
-	values map[E]bool
-	mu     sync.RWMutex
+	elements []E
+	index    map[E]int
+	modCount int
+	mu       locking.RWMutex
 }
 
 func NewHashSet[E comparable]() collections.Set[E] {
-	return &HashSet[E]{
-		values: make(map[E]bool),
-	}
+	return NewHashSetWithCapacity[E](0)
 }
 
 func NewHashSetWithCapacity[E comparable](initialCapacity int) collections.Set[E] {
@@ -24,10 +27,22 @@ func NewHashSetWithCapacity[E comparable](initialCapacity int) collections.Set[E
 		initialCapacity = 0
 	}
 	return &HashSet[E]{
-		values: make(map[E]bool, initialCapacity),
+		elements: make([]E, 0, initialCapacity),
+		index:    make(map[E]int, initialCapacity),
 	}
 }
 
+// NewUnsynchronizedHashSet returns an empty HashSet that takes no locks. It is faster when
+// the set is only used by one goroutine, and must not be used by several at once.
+func NewUnsynchronizedHashSet[E comparable]() collections.Set[E] {
+	set := &HashSet[E]{
+		elements: make([]E, 0),
+		index:    make(map[E]int),
+	}
+	set.mu.Disable()
+	return set
+}
+
 func NewHashSetFromCollection[E comparable](collection collections.Collection[E]) collections.Set[E] {
 	if collection == nil {
 		return NewHashSet[E]()
@@ -40,11 +55,7 @@ func NewHashSetFromCollection[E comparable](collection collections.Collection[E]
 func (h *HashSet[E]) Add(element E) bool {
 	h.mu.Lock()
 	defer h.mu.Unlock()
-	if _, exists := h.values[element]; exists {
-		return false
-	}
-	h.values[element] = true
-	return true
+	return h.add(element)
 }
 
 func (h *HashSet[E]) AddAll(collection collections.Collection[E]) bool {
@@ -62,8 +73,7 @@ func (h *HashSet[E]) AddAllBatch(elements []E) bool {
 	defer h.mu.Unlock()
 	modified := false
 	for _, val := range elements {
-		if _, exists := h.values[val]; !exists {
-			h.values[val] = true
+		if h.add(val) {
 			modified = true
 		}
 	}
@@ -73,19 +83,21 @@ func (h *HashSet[E]) AddAllBatch(elements []E) bool {
 func (h *HashSet[E]) Clear() {
 	h.mu.Lock()
 	defer h.mu.Unlock()
-	h.values = make(map[E]bool)
+	h.elements = make([]E, 0)
+	h.index = make(map[E]int)
+	h.modCount++
 }
 
 func (h *HashSet[E]) Contains(element E) bool {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
-	_, exists := h.values[element]
+	_, exists := h.index[element]
 	return exists
 }
 
 func (h *HashSet[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
 	if collection == nil {
-		return false, errors.New(string(errcodes.NullPointerError))
+		return false, errcodes.New(errcodes.NullPointerError, "HashSet", "ContainsAll")
 	}
 	h.mu.RLock()
 	defer h.mu.RUnlock()
@@ -94,7 +106,7 @@ func (h *HashSet[E]) ContainsAll(collection collections.Collection[E]) (bool, er
 		return false, nil
 	}
 	for _, val := range elements {
-		if _, exists := h.values[val]; !exists {
+		if _, exists := h.index[val]; !exists {
 			return false, nil
 		}
 	}
@@ -108,11 +120,11 @@ func (h *HashSet[E]) Equals(collection collections.Collection[E]) bool {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 	elements := collection.ToArray()
-	if len(h.values) != len(elements) {
+	if len(h.elements) != len(elements) {
 		return false
 	}
 	for _, val := range elements {
-		if _, exists := h.values[val]; !exists {
+		if _, exists := h.index[val]; !exists {
 			return false
 		}
 	}
@@ -122,30 +134,43 @@ func (h *HashSet[E]) Equals(collection collections.Collection[E]) bool {
 func (h *HashSet[E]) IsEmpty() bool {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
-	return len(h.values) == 0
+	return len(h.elements) == 0
 }
 
+// Iterator returns a live iterator over the elements of this set.
+// Next fails with ConcurrentModificationError if the set is structurally
+// modified after the iterator is created.
 func (h *HashSet[E]) Iterator() collections.Iterator[E] {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
-	values := make([]E, 0, len(h.values))
-	for val := range h.values {
-		values = append(values, val)
-	}
 	return &HashSetIterator[E]{
-		values: values,
-		index:  0,
+		set:              h,
+		expectedModCount: h.modCount,
+	}
+}
+
+// SnapshotIterator returns an iterator over a copy of the elements taken now.
+// Unlike Iterator, it keeps working if the set is modified during iteration.
+func (h *HashSet[E]) SnapshotIterator() collections.Iterator[E] {
+	return collections.NewSnapshotIterator(h.ToArray())
+}
+
+// All returns a sequence over a snapshot of the elements in this set, in no particular order.
+// The snapshot is taken when iteration starts, so the loop body may modify the set.
+func (h *HashSet[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, val := range h.ToArray() {
+			if !yield(val) {
+				return
+			}
+		}
 	}
 }
 
 func (h *HashSet[E]) Remove(element E) bool {
 	h.mu.Lock()
 	defer h.mu.Unlock()
-	if _, exists := h.values[element]; exists {
-		delete(h.values, element)
-		return true
-	}
-	return false
+	return h.remove(element)
 }
 
 func (h *HashSet[E]) RemoveAll(collection collections.Collection[E]) bool {
@@ -163,8 +188,7 @@ func (h *HashSet[E]) RemoveAllBatch(elements []E) bool {
 	defer h.mu.Unlock()
 	modified := false
 	for _, val := range elements {
-		if _, exists := h.values[val]; exists {
-			delete(h.values, val)
+		if h.remove(val) {
 			modified = true
 		}
 	}
@@ -179,8 +203,10 @@ func (h *HashSet[E]) RetainAll(collection collections.Collection[E]) bool {
 	defer h.mu.Unlock()
 	elements := collection.ToArray()
 	if len(elements) == 0 {
-		if len(h.values) > 0 {
-			h.values = make(map[E]bool)
+		if len(h.elements) > 0 {
+			h.elements = make([]E, 0)
+			h.index = make(map[E]int)
+			h.modCount++
 			return true
 		}
 		return false
@@ -192,30 +218,20 @@ func (h *HashSet[E]) RetainAll(collection collections.Collection[E]) bool {
 		retainSet[val] = true
 	}
 
-	// Check if any elements need to be removed
-	modified := false
-	for val := range h.values {
-		if !retainSet[val] {
-			delete(h.values, val)
-			modified = true
-		}
-	}
-	return modified
+	return h.removeMatching(func(val E) bool { return !retainSet[val] })
 }
 
 func (h *HashSet[E]) Size() int {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
-	return len(h.values)
+	return len(h.elements)
 }
 
 func (h *HashSet[E]) ToArray() []E {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
-	result := make([]E, 0, len(h.values))
-	for val := range h.values {
-		result = append(result, val)
-	}
+	result := make([]E, len(h.elements))
+	copy(result, h.elements)
 	return result
 }
 
@@ -225,14 +241,7 @@ func (h *HashSet[E]) RemoveIf(predicate func(E) bool) bool {
 	}
 	h.mu.Lock()
 	defer h.mu.Unlock()
-	modified := false
-	for val := range h.values {
-		if predicate(val) {
-			delete(h.values, val)
-			modified = true
-		}
-	}
-	return modified
+	return h.removeMatching(predicate)
 }
 
 func (h *HashSet[E]) ForEach(action func(E)) {
@@ -241,37 +250,100 @@ func (h *HashSet[E]) ForEach(action func(E)) {
 	}
 	h.mu.RLock()
 	defer h.mu.RUnlock()
-	for val := range h.values {
+	for _, val := range h.elements {
 		action(val)
 	}
 }
 
+// Clone returns a copy of the set. The copy is unsynchronized if the set is.
 func (h *HashSet[E]) Clone() collections.Set[E] {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 	newSet := &HashSet[E]{
-		values: make(map[E]bool, len(h.values)),
+		elements: make([]E, len(h.elements)),
+		index:    make(map[E]int, len(h.index)),
+	}
+	if h.mu.Disabled() {
+		newSet.mu.Disable()
 	}
-	for val := range h.values {
-		newSet.values[val] = true
+	copy(newSet.elements, h.elements)
+	for val, i := range h.index {
+		newSet.index[val] = i
 	}
 	return newSet
 }
 
+// Helper methods. All of them assume the write lock is already held.
+
+func (h *HashSet[E]) add(element E) bool {
+	if _, exists := h.index[element]; exists {
+		return false
+	}
+	h.index[element] = len(h.elements)
+	h.elements = append(h.elements, element)
+	h.modCount++
+	return true
+}
+
+func (h *HashSet[E]) remove(element E) bool {
+	i, exists := h.index[element]
+	if !exists {
+		return false
+	}
+	h.removeAt(i)
+	return true
+}
+
+// removeAt moves the last element into slot i and shrinks the slice by one.
+func (h *HashSet[E]) removeAt(i int) {
+	last := len(h.elements) - 1
+	delete(h.index, h.elements[i])
+	if i != last {
+		h.elements[i] = h.elements[last]
+		h.index[h.elements[i]] = i
+	}
+	var zero E
+	h.elements[last] = zero
+	h.elements = h.elements[:last]
+	h.modCount++
+}
+
+// removeMatching removes every element for which match returns true.
+// It walks backwards so that the element swapped into a vacated slot has already been checked.
+func (h *HashSet[E]) removeMatching(match func(E) bool) bool {
+	modified := false
+	for i := len(h.elements) - 1; i >= 0; i-- {
+		if match(h.elements[i]) {
+			h.removeAt(i)
+			modified = true
+		}
+	}
+	return modified
+}
+
+// HashSetIterator iterates over the live element slice of a HashSet.
 type HashSetIterator[E comparable] struct {
-	values []E
-	index  int
+	set              *HashSet[E]
+	cursor           int
+	expectedModCount int
 }
 
 func (h *HashSetIterator[E]) HasNext() bool {
-	return h.index < len(h.values)
+	h.set.mu.RLock()
+	defer h.set.mu.RUnlock()
+	return h.cursor < len(h.set.elements)
 }
 
 func (h *HashSetIterator[E]) Next() (*E, error) {
-	if h.index >= len(h.values) {
-		return nil, errors.New(string(errcodes.NoSuchElementError))
+	h.set.mu.RLock()
+	defer h.set.mu.RUnlock()
+	if h.set.modCount != h.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "HashSet", "Iterator.Next")
+	}
+	if h.cursor >= len(h.set.elements) {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "HashSet", "Iterator.Next")
 	}
-	val := h.values[h.index]
-	h.index++
+	val := h.set.elements[h.cursor]
+	h.cursor++
 	return &val, nil
 }
@@ -1,8 +1,11 @@
 package sets
 
 import (
+	"slices"
+	"sort"
 	"testing"
 
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 	"github.com/chiranjeevipavurala/gocollections/lists"
 )
 
@@ -35,6 +38,34 @@ func TestNewHashSet(t *testing.T) {
 	}
 }
 
+func TestNewUnsynchronizedHashSet(t *testing.T) {
+	set := NewUnsynchronizedHashSet[int]()
+	if !set.(*HashSet[int]).mu.Disabled() {
+		t.Error("NewUnsynchronizedHashSet should disable locking")
+	}
+	if !set.IsEmpty() {
+		t.Error("New HashSet should be empty")
+	}
+
+	set.Add(1)
+	set.Add(2)
+	set.Add(1)
+	if set.Size() != 2 {
+		t.Errorf("Expected size 2, got %d", set.Size())
+	}
+	if !set.Remove(1) || set.Contains(1) {
+		t.Error("Remove should remove the element")
+	}
+
+	clone := set.(*HashSet[int]).Clone()
+	if !clone.(*HashSet[int]).mu.Disabled() {
+		t.Error("Clone of an unsynchronized HashSet should be unsynchronized")
+	}
+	if !clone.Equals(set) {
+		t.Error("Clone should equal the original set")
+	}
+}
+
 func TestHashSet_Add(t *testing.T) {
 	set := NewHashSet[int]()
 
@@ -668,3 +699,96 @@ func TestNewHashSetFromCollection(t *testing.T) {
 		t.Error("Set should contain all elements from collection")
 	}
 }
+
+func TestHashSetAll(t *testing.T) {
+	set := NewHashSet[int]()
+	for i := 1; i <= 3; i++ {
+		set.Add(i)
+	}
+
+	got := slices.Collect(set.All())
+	sort.Ints(got)
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+
+	// The sequence iterates over a snapshot, so the loop body may remove elements
+	count := 0
+	for v := range set.All() {
+		set.Remove(v)
+		count++
+	}
+	if count != 3 || !set.IsEmpty() {
+		t.Errorf("Expected to visit and remove 3 elements, visited %d and left %d", count, set.Size())
+	}
+}
+
+func TestHashSet_IteratorConcurrentModification(t *testing.T) {
+	set := NewHashSet[int]()
+	set.Add(1)
+	set.Add(2)
+	set.Add(3)
+
+	iterator := set.Iterator()
+	if _, err := iterator.Next(); err != nil {
+		t.Fatalf("Next() returned error: %v", err)
+	}
+
+	// Adding a duplicate or removing a missing element is not a structural modification
+	set.Add(1)
+	set.Remove(99)
+	if _, err := iterator.Next(); err != nil {
+		t.Errorf("Next() returned error after no-op changes: %v", err)
+	}
+
+	set.Remove(1)
+	val, err := iterator.Next()
+	if val != nil || err == nil || err.Error() != string(errcodes.ConcurrentModificationError) {
+		t.Errorf("Next() = %v, %v; want ConcurrentModificationError", val, err)
+	}
+}
+
+func TestHashSet_SnapshotIterator(t *testing.T) {
+	set := NewHashSet[int]()
+	set.Add(1)
+	set.Add(2)
+	set.Add(3)
+
+	iterator := set.(*HashSet[int]).SnapshotIterator()
+	set.Clear()
+
+	var result []int
+	for iterator.HasNext() {
+		val, err := iterator.Next()
+		if err != nil {
+			t.Fatalf("Next() returned error: %v", err)
+		}
+		result = append(result, *val)
+	}
+	sort.Ints(result)
+	if !slices.Equal(result, []int{1, 2, 3}) {
+		t.Errorf("SnapshotIterator returned %v; want [1 2 3]", result)
+	}
+}
+
+func TestHashSet_RemoveKeepsIndexConsistent(t *testing.T) {
+	set := NewHashSet[int]().(*HashSet[int])
+	for i := 0; i < 10; i++ {
+		set.Add(i)
+	}
+	set.Remove(0)
+	set.RemoveIf(func(v int) bool { return v%3 == 0 })
+	set.RetainAll(lists.NewArrayListWithInitialCollection([]int{1, 2, 4, 5, 7, 8, 100}))
+
+	for _, v := range []int{1, 2, 4, 5, 7, 8} {
+		if !set.Contains(v) {
+			t.Errorf("Contains(%d) = false; want true", v)
+		}
+		if !set.Remove(v) {
+			t.Errorf("Remove(%d) = false; want true", v)
+		}
+	}
+	if !set.IsEmpty() {
+		t.Errorf("Set should be empty, got %v", set.ToArray())
+	}
+}
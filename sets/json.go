@@ -0,0 +1,121 @@
+package sets
+
+import (
+	"bytes"
+	"encoding/json"
+
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// Sets are encoded as JSON arrays in iteration order. Decoding replaces the current
+// contents and drops duplicate elements, and, as encoding/json recommends, decoding a
+// JSON null is a no-op.
+
+// MarshalJSON encodes the set as a JSON array.
+func (h *HashSet[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(h.ToArray())
+}
+
+// UnmarshalJSON replaces the contents of the set with the elements of a JSON array.
+func (h *HashSet[E]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[E](data)
+	if err != nil || values == nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.elements = make([]E, 0, len(values))
+	h.index = make(map[E]int, len(values))
+	h.modCount++
+	for _, value := range values {
+		h.add(value)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array in insertion order.
+func (lhs *LinkedHashSet[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(lhs.ToArray())
+}
+
+// UnmarshalJSON replaces the contents of the set with the elements of a JSON array,
+// keeping the order of their first occurrence.
+func (lhs *LinkedHashSet[E]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[E](data)
+	if err != nil || values == nil {
+		return err
+	}
+
+	lhs.mu.Lock()
+	defer lhs.mu.Unlock()
+	lhs.head = nil
+	lhs.tail = nil
+	lhs.items = make(map[E]*node[E], len(values))
+	lhs.modCount++
+	for _, value := range values {
+		lhs.add(value)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array in the order of its comparator.
+func (ts *TreeSet[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(ts.ToArray())
+}
+
+// UnmarshalJSON replaces the contents of the set with the elements of a JSON array.
+// The set keeps its comparator, so it must have been created with NewTreeSet; decoding
+// into a zero TreeSet returns NullPointerError. On a view, only the elements within the
+// range of the view are replaced, and an element outside that range makes the call fail
+// with IllegalArgumentError without changing the set.
+func (ts *TreeSet[E]) UnmarshalJSON(data []byte) error {
+	if ts.tree == nil {
+		return errcodes.New(errcodes.NullPointerError, "TreeSet", "UnmarshalJSON")
+	}
+	values, err := unmarshalJSONArray[E](data)
+	if err != nil || values == nil {
+		return err
+	}
+
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+	for _, value := range values {
+		if !ts.inRange(value) {
+			return errcodes.New(errcodes.IllegalArgumentError, "TreeSet", "UnmarshalJSON")
+		}
+	}
+	if ts.fromStart && ts.toEnd {
+		ts.tree.root = nil
+		ts.tree.size = 0
+		ts.tree.modCount++
+	} else {
+		for node := ts.absLowest(); node != nil; node = ts.absLowest() {
+			ts.tree.delete(node)
+		}
+	}
+	for _, value := range values {
+		ts.tree.insert(value)
+	}
+	return nil
+}
+
+// marshalJSONArray encodes values as a JSON array, writing an empty array rather than null for no values.
+func marshalJSONArray[E any](values []E) ([]byte, error) {
+	if values == nil {
+		values = []E{}
+	}
+	return json.Marshal(values)
+}
+
+// unmarshalJSONArray decodes a JSON array. It returns nil without an error for a JSON null.
+func unmarshalJSONArray[E any](data []byte) ([]E, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, nil
+	}
+	values := []E{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
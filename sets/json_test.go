@@ -0,0 +1,76 @@
+package sets
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashSet_JSON(t *testing.T) {
+	set := NewHashSet[int]()
+	set.Add(2)
+	set.Add(1)
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[2,1]`, string(data))
+
+	var decoded HashSet[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[3,3,4]`), &decoded))
+	assert.Equal(t, 2, decoded.Size())
+	assert.True(t, decoded.Contains(3))
+	assert.True(t, decoded.Contains(4))
+	assert.True(t, decoded.Remove(3))
+	assert.Equal(t, []int{4}, decoded.ToArray())
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &decoded))
+	assert.Equal(t, 1, decoded.Size())
+	assert.Error(t, json.Unmarshal([]byte(`"x"`), &decoded))
+}
+
+func TestLinkedHashSet_JSON(t *testing.T) {
+	set := NewLinkedHashSet[string]()
+	set.Add("c")
+	set.Add("a")
+	set.Add("b")
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+	assert.Equal(t, `["c","a","b"]`, string(data))
+
+	decoded := NewLinkedHashSet[string]()
+	decoded.Add("old")
+	assert.NoError(t, json.Unmarshal([]byte(`["z","y","z","x"]`), decoded))
+	assert.Equal(t, []string{"z", "y", "x"}, decoded.ToArray())
+	assert.False(t, decoded.Contains("old"))
+}
+
+func TestTreeSet_JSON(t *testing.T) {
+	set := NewTreeSet[int](comparators.Natural[int]())
+	set.Add(3)
+	set.Add(1)
+	set.Add(2)
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2,3]`, string(data))
+
+	decoded := NewTreeSet[int](comparators.Natural[int]())
+	assert.NoError(t, json.Unmarshal([]byte(`[9,7,8,7]`), decoded))
+	assert.Equal(t, []int{7, 8, 9}, decoded.ToArray())
+
+	var zero TreeSet[int]
+	assert.EqualError(t, json.Unmarshal(data, &zero), string(errcodes.NullPointerError))
+
+	// Decoding into a view replaces only the view's range
+	set = NewTreeSet[int](comparators.Natural[int]())
+	for i := 1; i <= 6; i++ {
+		set.Add(i)
+	}
+	view, err := set.SubSet(2, 5)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal([]byte(`[3]`), view))
+	assert.Equal(t, []int{1, 3, 5, 6}, set.ToArray())
+	assert.EqualError(t, json.Unmarshal([]byte(`[3, 10]`), view), string(errcodes.IllegalArgumentError))
+	assert.Equal(t, []int{1, 3, 5, 6}, set.ToArray())
+}
@@ -1,9 +1,12 @@
 package sets
 
 import (
+	"iter"
+	"slices"
 	"sync"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 )
 
 // node represents an element in the linked list.
@@ -18,7 +21,9 @@ type LinkedHashSet[E comparable] struct {
 	head  *node[E]
 	tail  *node[E]
 	items map[E]*node[E]
-	mu    sync.RWMutex
+	// modCount counts structural modifications so that iterators can fail fast
+	modCount int
+	mu       sync.RWMutex
 }
 
 // NewLinkedHashSet creates a new LinkedHashSet.
@@ -32,7 +37,12 @@ func NewLinkedHashSet[E comparable]() *LinkedHashSet[E] {
 func (lhs *LinkedHashSet[E]) Add(element E) bool {
 	lhs.mu.Lock()
 	defer lhs.mu.Unlock()
+	return lhs.add(element)
+}
 
+// add appends the element if it is not already present.
+// It assumes the write lock is already held.
+func (lhs *LinkedHashSet[E]) add(element E) bool {
 	if _, exists := lhs.items[element]; exists {
 		return false
 	}
@@ -46,6 +56,7 @@ func (lhs *LinkedHashSet[E]) Add(element E) bool {
 		lhs.tail.next = newNode
 		lhs.tail = newNode
 	}
+	lhs.modCount++
 	return true
 }
 
@@ -67,6 +78,7 @@ func (lhs *LinkedHashSet[E]) AddAll(collection collections.Collection[E]) bool {
 				lhs.tail.next = newNode
 				lhs.tail = newNode
 			}
+			lhs.modCount++
 			modified = true
 		}
 	}
@@ -81,6 +93,7 @@ func (lhs *LinkedHashSet[E]) Clear() {
 	lhs.head = nil
 	lhs.tail = nil
 	lhs.items = make(map[E]*node[E])
+	lhs.modCount++
 }
 
 // Contains returns true if this set contains the specified element.
@@ -136,12 +149,68 @@ func (lhs *LinkedHashSet[E]) IsEmpty() bool {
 	return len(lhs.items) == 0
 }
 
-// Iterator returns an iterator over the elements in this set.
+// Iterator returns an iterator over the elements in this set in insertion order.
+// The iterator fails with ConcurrentModificationError if the set is structurally
+// modified after the iterator is created.
 func (lhs *LinkedHashSet[E]) Iterator() collections.Iterator[E] {
 	lhs.mu.RLock()
 	defer lhs.mu.RUnlock()
 
-	return &linkedHashSetIterator[E]{current: lhs.head}
+	return &linkedHashSetIterator[E]{
+		set:              lhs,
+		current:          lhs.head,
+		expectedModCount: lhs.modCount,
+	}
+}
+
+// SnapshotIterator returns an iterator over a copy of the elements taken now.
+// Unlike Iterator, it keeps working if the set is modified during iteration.
+func (lhs *LinkedHashSet[E]) SnapshotIterator() collections.Iterator[E] {
+	return collections.NewSnapshotIterator(lhs.ToArray())
+}
+
+// All returns a sequence over the elements in this set in insertion order.
+// The elements are fixed when iteration starts and the read lock is released while the loop
+// body runs, so the body may modify the set. An element removed before it is reached is
+// skipped, and an element added during the loop is not yielded.
+func (lhs *LinkedHashSet[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		lhs.walk(lhs.nodes(), yield)
+	}
+}
+
+// Backward returns a sequence over the elements in this set in reverse insertion order.
+// Like All, it may be used while the loop body modifies the set.
+func (lhs *LinkedHashSet[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		nodes := lhs.nodes()
+		slices.Reverse(nodes)
+		lhs.walk(nodes, yield)
+	}
+}
+
+// nodes returns the nodes of this set in insertion order under the read lock.
+func (lhs *LinkedHashSet[E]) nodes() []*node[E] {
+	lhs.mu.RLock()
+	defer lhs.mu.RUnlock()
+
+	nodes := make([]*node[E], 0, len(lhs.items))
+	for current := lhs.head; current != nil; current = current.next {
+		nodes = append(nodes, current)
+	}
+	return nodes
+}
+
+// walk yields the values of nodes that are still in the set when they are reached.
+func (lhs *LinkedHashSet[E]) walk(nodes []*node[E], yield func(E) bool) {
+	for _, n := range nodes {
+		lhs.mu.RLock()
+		linked := lhs.items[n.value] == n
+		lhs.mu.RUnlock()
+		if linked && !yield(n.value) {
+			return
+		}
+	}
 }
 
 // Remove removes the specified element from this set if it is present.
@@ -164,6 +233,7 @@ func (lhs *LinkedHashSet[E]) Remove(element E) bool {
 		lhs.tail = node.prev
 	}
 	delete(lhs.items, element)
+	lhs.modCount++
 	return true
 }
 
@@ -190,6 +260,7 @@ func (lhs *LinkedHashSet[E]) RemoveAll(collection collections.Collection[E]) boo
 				lhs.tail = node.prev
 			}
 			delete(lhs.items, elem)
+			lhs.modCount++
 			modified = true
 		}
 	}
@@ -218,7 +289,9 @@ func (lhs *LinkedHashSet[E]) ToArray() []E {
 
 // linkedHashSetIterator is an iterator for LinkedHashSet.
 type linkedHashSetIterator[E comparable] struct {
-	current *node[E]
+	set              *LinkedHashSet[E]
+	current          *node[E]
+	expectedModCount int
 }
 
 // HasNext returns true if the iteration has more elements.
@@ -228,8 +301,14 @@ func (it *linkedHashSetIterator[E]) HasNext() bool {
 
 // Next returns the next element in the iteration.
 func (it *linkedHashSetIterator[E]) Next() (*E, error) {
+	it.set.mu.RLock()
+	defer it.set.mu.RUnlock()
+
+	if it.set.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "LinkedHashSet", "Iterator.Next")
+	}
 	if it.current == nil {
-		return nil, collections.ErrNoSuchElement
+		return nil, errcodes.New(errcodes.NoSuchElementError, "LinkedHashSet", "Iterator.Next")
 	}
 	value := it.current.value
 	it.current = it.current.next
@@ -1,10 +1,12 @@
 package sets
 
 import (
+	"slices"
 	"sync"
 	"testing"
 
 	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -275,3 +277,105 @@ func TestLinkedHashSet_Concurrent(t *testing.T) {
 	wg.Wait()
 	assert.True(t, lhs.IsEmpty(), "Set should be empty after concurrent operations")
 }
+
+func TestLinkedHashSet_All(t *testing.T) {
+	set := NewLinkedHashSet[int]()
+	for _, v := range []int{3, 1, 2} {
+		set.Add(v)
+	}
+	assert.Equal(t, []int{3, 1, 2}, slices.Collect(set.All()))
+	assert.Equal(t, []int{2, 1, 3}, slices.Collect(set.Backward()))
+	assert.Empty(t, slices.Collect(NewLinkedHashSet[int]().All()))
+
+	// Removing the current element from the loop body does not end the iteration
+	var seen []int
+	for v := range set.All() {
+		set.Remove(v)
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []int{3, 1, 2}, seen)
+	assert.True(t, set.IsEmpty())
+}
+
+func TestLinkedHashSet_AllModifiedInLoop(t *testing.T) {
+	set := NewLinkedHashSet[string]()
+	for _, v := range []string{"A", "B", "C", "D"} {
+		set.Add(v)
+	}
+
+	// Elements removed before they are reached are skipped, new ones are not yielded
+	var seen []string
+	for v := range set.All() {
+		if v == "A" {
+			set.Remove("B")
+			set.Add("E")
+		}
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []string{"A", "C", "D"}, seen)
+
+	// An element removed and added back is a new element
+	seen = nil
+	for v := range set.Backward() {
+		if v == "E" {
+			set.Remove("C")
+			set.Add("C")
+		}
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []string{"E", "D", "A"}, seen)
+
+	// Nothing is yielded after the set is cleared
+	seen = nil
+	for v := range set.All() {
+		set.Clear()
+		set.Add("F")
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []string{"A"}, seen)
+}
+
+func TestLinkedHashSet_IteratorConcurrentModification(t *testing.T) {
+	lhs := NewLinkedHashSet[int]()
+	lhs.Add(1)
+	lhs.Add(2)
+	lhs.Add(3)
+
+	it := lhs.Iterator()
+	val, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *val)
+
+	// Adding a duplicate is not a structural modification
+	lhs.Add(2)
+	val, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *val)
+
+	lhs.Remove(3)
+	_, err = it.Next()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+
+	it = lhs.Iterator()
+	lhs.Clear()
+	_, err = it.Next()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+}
+
+func TestLinkedHashSet_SnapshotIterator(t *testing.T) {
+	lhs := NewLinkedHashSet[int]()
+	lhs.Add(1)
+	lhs.Add(2)
+
+	it := lhs.SnapshotIterator()
+	lhs.Remove(1)
+	lhs.Add(3)
+
+	var result []int
+	for it.HasNext() {
+		val, err := it.Next()
+		assert.NoError(t, err)
+		result = append(result, *val)
+	}
+	assert.Equal(t, []int{1, 2}, result)
+}
@@ -0,0 +1,1192 @@
+package sets
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// treeColor represents the color of a node in the Red-Black tree.
+type treeColor bool
+
+const (
+	red   treeColor = true
+	black treeColor = false
+)
+
+// treeNode represents an element in the Red-Black tree backing a TreeSet.
+type treeNode[E comparable] struct {
+	value  E
+	color  treeColor
+	left   *treeNode[E]
+	right  *treeNode[E]
+	parent *treeNode[E]
+}
+
+// tree is the Red-Black tree shared by a TreeSet and all of its views.
+type tree[E comparable] struct {
+	root       *treeNode[E]
+	size       int
+	modCount   int
+	comparator collections.Comparator[E]
+	mu         sync.RWMutex
+}
+
+// TreeSet is a NavigableSet backed by a Red-Black tree.
+// Elements are ordered by the comparator supplied at construction time.
+// The sets returned by HeadSet, TailSet, SubSet and DescendingSet are views
+// that share the tree with the set that created them, so changes made through
+// either one are visible in the other.
+type TreeSet[E comparable] struct {
+	tree *tree[E]
+
+	// Range bounds of this view. A set created by NewTreeSet is unbounded.
+	fromStart   bool
+	lo          E
+	loInclusive bool
+	toEnd       bool
+	hi          E
+	hiInclusive bool
+
+	// descending reports whether this view iterates in reverse order.
+	descending bool
+}
+
+// NewTreeSet creates a new TreeSet ordered by the given comparator.
+// It returns nil if the comparator is nil.
+func NewTreeSet[E comparable](comparator collections.Comparator[E]) *TreeSet[E] {
+	if comparator == nil {
+		return nil
+	}
+	return &TreeSet[E]{
+		tree:      &tree[E]{comparator: comparator},
+		fromStart: true,
+		toEnd:     true,
+	}
+}
+
+// NewTreeSetFromCollection creates a new TreeSet ordered by the given comparator
+// containing the elements of the specified collection.
+// It returns nil if the comparator is nil.
+func NewTreeSetFromCollection[E comparable](collection collections.Collection[E], comparator collections.Comparator[E]) *TreeSet[E] {
+	set := NewTreeSet[E](comparator)
+	if set == nil || collection == nil {
+		return set
+	}
+	set.AddAll(collection)
+	return set
+}
+
+// Add adds the specified element to this set if it is not already present.
+// It returns false if the element is already present or lies outside the range of this view.
+func (ts *TreeSet[E]) Add(element E) bool {
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+
+	if !ts.inRange(element) {
+		return false
+	}
+	return ts.tree.insert(element)
+}
+
+// AddAll adds all elements from the specified collection to this set.
+func (ts *TreeSet[E]) AddAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	// Get elements first to avoid holding the lock while reading the other collection
+	elements := collection.ToArray()
+
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+
+	modified := false
+	for _, element := range elements {
+		if ts.inRange(element) && ts.tree.insert(element) {
+			modified = true
+		}
+	}
+	return modified
+}
+
+// Clear removes all elements from this set.
+// On a view, only the elements within the range of the view are removed.
+func (ts *TreeSet[E]) Clear() {
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+
+	if ts.fromStart && ts.toEnd {
+		ts.tree.root = nil
+		ts.tree.size = 0
+		ts.tree.modCount++
+		return
+	}
+	for node := ts.absLowest(); node != nil; node = ts.absLowest() {
+		ts.tree.delete(node)
+	}
+}
+
+// Contains returns true if this set contains the specified element.
+func (ts *TreeSet[E]) Contains(element E) bool {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	return ts.inRange(element) && ts.tree.getNode(element) != nil
+}
+
+// ContainsAll returns true if this set contains all elements from the specified collection.
+func (ts *TreeSet[E]) ContainsAll(collection collections.Collection[E]) (bool, error) {
+	if collection == nil {
+		return false, errcodes.New(errcodes.NullPointerError, "TreeSet", "ContainsAll")
+	}
+
+	elements := collection.ToArray()
+
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	for _, element := range elements {
+		if !ts.inRange(element) || ts.tree.getNode(element) == nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Equals returns true if the specified collection contains exactly the same elements as this set.
+func (ts *TreeSet[E]) Equals(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	elements := collection.ToArray()
+
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	if ts.size() != len(elements) {
+		return false
+	}
+	for _, element := range elements {
+		if !ts.inRange(element) || ts.tree.getNode(element) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty returns true if this set contains no elements.
+func (ts *TreeSet[E]) IsEmpty() bool {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	return ts.first() == nil
+}
+
+// Iterator returns an iterator over the elements in this set in the order of this view.
+// The iterator fails with ConcurrentModificationError if the set is structurally
+// modified after the iterator is created.
+func (ts *TreeSet[E]) Iterator() collections.Iterator[E] {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	return &treeSetIterator[E]{
+		set:              ts,
+		next:             ts.first(),
+		expectedModCount: ts.tree.modCount,
+	}
+}
+
+// SnapshotIterator returns an iterator over a copy of the elements in this view taken now.
+// Unlike Iterator, it keeps working if the set is modified during iteration.
+func (ts *TreeSet[E]) SnapshotIterator() collections.Iterator[E] {
+	return collections.NewSnapshotIterator(ts.ToArray())
+}
+
+// Remove removes the specified element from this set if it is present.
+func (ts *TreeSet[E]) Remove(element E) bool {
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+
+	if !ts.inRange(element) {
+		return false
+	}
+	node := ts.tree.getNode(element)
+	if node == nil {
+		return false
+	}
+	ts.tree.delete(node)
+	return true
+}
+
+// RemoveAll removes all elements from this set that are also contained in the specified collection.
+func (ts *TreeSet[E]) RemoveAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	elements := collection.ToArray()
+
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+
+	modified := false
+	for _, element := range elements {
+		if !ts.inRange(element) {
+			continue
+		}
+		if node := ts.tree.getNode(element); node != nil {
+			ts.tree.delete(node)
+			modified = true
+		}
+	}
+	return modified
+}
+
+// RetainAll retains only the elements in this set that are contained in the specified collection.
+func (ts *TreeSet[E]) RetainAll(collection collections.Collection[E]) bool {
+	if collection == nil {
+		return false
+	}
+
+	retain := NewTreeSetFromCollection(collection, ts.tree.comparator)
+
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+
+	modified := false
+	for node := ts.absLowest(); node != nil; {
+		value := node.value
+		if retain.tree.getNode(value) == nil {
+			ts.tree.delete(node)
+			modified = true
+			// Deleting may move values between nodes, so search again from the removed value
+			node = ts.absCeiling(value)
+			continue
+		}
+		node = ts.absSuccessor(node)
+	}
+	return modified
+}
+
+// RemoveIf removes all elements of this set that satisfy the given predicate.
+func (ts *TreeSet[E]) RemoveIf(predicate func(E) bool) bool {
+	if predicate == nil {
+		return false
+	}
+
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+
+	modified := false
+	for node := ts.absLowest(); node != nil; {
+		value := node.value
+		if predicate(value) {
+			ts.tree.delete(node)
+			modified = true
+			node = ts.absCeiling(value)
+			continue
+		}
+		node = ts.absSuccessor(node)
+	}
+	return modified
+}
+
+// ForEach performs the given action for each element of this set in the order of this view.
+func (ts *TreeSet[E]) ForEach(action func(E)) {
+	if action == nil {
+		return
+	}
+
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	for node := ts.first(); node != nil; node = ts.next(node) {
+		action(node.value)
+	}
+}
+
+// Size returns the number of elements in this set.
+// The size of a range view is computed by walking the range.
+func (ts *TreeSet[E]) Size() int {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	return ts.size()
+}
+
+// ToArray returns a slice containing all elements in this set in the order of this view.
+func (ts *TreeSet[E]) ToArray() []E {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	result := make([]E, 0, ts.tree.size)
+	for node := ts.first(); node != nil; node = ts.next(node) {
+		result = append(result, node.value)
+	}
+	return result
+}
+
+// Clone returns a new TreeSet with the same comparator containing the elements of this set.
+// The clone is independent of this set and is not bounded by the range of this view.
+func (ts *TreeSet[E]) Clone() *TreeSet[E] {
+	elements := ts.ToArray()
+	clone := NewTreeSet[E](ts.tree.comparator)
+	for _, element := range elements {
+		clone.tree.insert(element)
+	}
+	return clone
+}
+
+// Comparator returns the comparator used to order the elements in this set.
+func (ts *TreeSet[E]) Comparator() collections.Comparator[E] {
+	if ts.descending {
+		return comparators.Reverse(ts.tree.comparator)
+	}
+	return ts.tree.comparator
+}
+
+// First returns the first (lowest) element currently in this set.
+func (ts *TreeSet[E]) First() (*E, error) {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	return valueOf("First", ts.first())
+}
+
+// Last returns the last (highest) element currently in this set.
+func (ts *TreeSet[E]) Last() (*E, error) {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	return valueOf("Last", ts.last())
+}
+
+// Ceiling returns the least element in this set greater than or equal to the given element.
+func (ts *TreeSet[E]) Ceiling(e E) (*E, error) {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	if ts.descending {
+		return valueOf("Ceiling", ts.absFloor(e))
+	}
+	return valueOf("Ceiling", ts.absCeiling(e))
+}
+
+// Floor returns the greatest element in this set less than or equal to the given element.
+func (ts *TreeSet[E]) Floor(e E) (*E, error) {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	if ts.descending {
+		return valueOf("Floor", ts.absCeiling(e))
+	}
+	return valueOf("Floor", ts.absFloor(e))
+}
+
+// Higher returns the least element in this set strictly greater than the given element.
+func (ts *TreeSet[E]) Higher(e E) (*E, error) {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	if ts.descending {
+		return valueOf("Higher", ts.absLower(e))
+	}
+	return valueOf("Higher", ts.absHigher(e))
+}
+
+// Lower returns the greatest element in this set strictly less than the given element.
+func (ts *TreeSet[E]) Lower(e E) (*E, error) {
+	ts.tree.mu.RLock()
+	defer ts.tree.mu.RUnlock()
+
+	if ts.descending {
+		return valueOf("Lower", ts.absHigher(e))
+	}
+	return valueOf("Lower", ts.absLower(e))
+}
+
+// PollFirst retrieves and removes the first (lowest) element.
+func (ts *TreeSet[E]) PollFirst() (*E, error) {
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+
+	return ts.poll("PollFirst", ts.first())
+}
+
+// PollLast retrieves and removes the last (highest) element.
+func (ts *TreeSet[E]) PollLast() (*E, error) {
+	ts.tree.mu.Lock()
+	defer ts.tree.mu.Unlock()
+
+	return ts.poll("PollLast", ts.last())
+}
+
+// HeadSet returns a view of the portion of this set whose elements are strictly less than toElement.
+func (ts *TreeSet[E]) HeadSet(toElement E) (collections.SortedSet[E], error) {
+	view, err := ts.headSet(toElement, false)
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// TailSet returns a view of the portion of this set whose elements are greater than or equal to fromElement.
+func (ts *TreeSet[E]) TailSet(fromElement E) (collections.SortedSet[E], error) {
+	view, err := ts.tailSet(fromElement, true)
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// SubSet returns a view of the portion of this set whose elements range from fromElement,
+// inclusive, to toElement, exclusive.
+func (ts *TreeSet[E]) SubSet(fromElement E, toElement E) (collections.SortedSet[E], error) {
+	if ts.compare(fromElement, toElement) > 0 {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "TreeSet", "SubSet")
+	}
+	tail, err := ts.tailSet(fromElement, true)
+	if err != nil {
+		return nil, err
+	}
+	view, err := tail.headSet(toElement, false)
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// DescendingSet returns a reverse order view of the elements contained in this set.
+func (ts *TreeSet[E]) DescendingSet() collections.NavigableSet[E] {
+	view := *ts
+	view.descending = !ts.descending
+	return &view
+}
+
+// DescendingIterator returns an iterator over the elements in this set in descending order.
+func (ts *TreeSet[E]) DescendingIterator() collections.Iterator[E] {
+	return ts.DescendingSet().Iterator()
+}
+
+// All returns a sequence over the elements in the order of this set.
+// The read lock is released while the loop body runs. If the set is modified
+// meanwhile, iteration continues with the element that follows the last one yielded.
+func (ts *TreeSet[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		ts.tree.mu.RLock()
+		node := ts.first()
+		for node != nil {
+			value := node.value
+			modCount := ts.tree.modCount
+			ts.tree.mu.RUnlock()
+			if !yield(value) {
+				return
+			}
+			ts.tree.mu.RLock()
+			if ts.tree.modCount != modCount {
+				// The node may have been removed or reused, so find the position again by value
+				node = ts.after(value)
+			} else {
+				node = ts.next(node)
+			}
+		}
+		ts.tree.mu.RUnlock()
+	}
+}
+
+// Backward returns a sequence over the elements in the reverse order of this set.
+func (ts *TreeSet[E]) Backward() iter.Seq[E] {
+	return ts.DescendingSet().All()
+}
+
+// headSet returns a view of the elements that come before toElement in the order of this view.
+func (ts *TreeSet[E]) headSet(toElement E, inclusive bool) (*TreeSet[E], error) {
+	if !ts.inRange(toElement) && !ts.onOpenBound(toElement) {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "TreeSet", "HeadSet")
+	}
+	view := *ts
+	if ts.descending {
+		view.fromStart = false
+		view.lo = toElement
+		view.loInclusive = inclusive
+	} else {
+		view.toEnd = false
+		view.hi = toElement
+		view.hiInclusive = inclusive
+	}
+	return &view, nil
+}
+
+// tailSet returns a view of the elements that come after fromElement in the order of this view.
+func (ts *TreeSet[E]) tailSet(fromElement E, inclusive bool) (*TreeSet[E], error) {
+	if !ts.inRange(fromElement) && !ts.onOpenBound(fromElement) {
+		return nil, errcodes.New(errcodes.IllegalArgumentError, "TreeSet", "TailSet")
+	}
+	view := *ts
+	if ts.descending {
+		view.toEnd = false
+		view.hi = fromElement
+		view.hiInclusive = inclusive
+	} else {
+		view.fromStart = false
+		view.lo = fromElement
+		view.loInclusive = inclusive
+	}
+	return &view, nil
+}
+
+// compare compares two elements according to the order of this view.
+func (ts *TreeSet[E]) compare(a, b E) int {
+	if ts.descending {
+		return ts.tree.comparator.Compare(b, a)
+	}
+	return ts.tree.comparator.Compare(a, b)
+}
+
+// poll removes the given node and returns its value.
+// It assumes the write lock is already held.
+func (ts *TreeSet[E]) poll(op string, node *treeNode[E]) (*E, error) {
+	if node == nil {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "TreeSet", op)
+	}
+	value := node.value
+	ts.tree.delete(node)
+	return &value, nil
+}
+
+// size counts the elements in the range of this view.
+// It assumes the lock is already held.
+func (ts *TreeSet[E]) size() int {
+	if ts.fromStart && ts.toEnd {
+		return ts.tree.size
+	}
+	count := 0
+	for node := ts.absLowest(); node != nil; node = ts.absSuccessor(node) {
+		count++
+	}
+	return count
+}
+
+// Range checks. All of the helpers below assume the lock is already held.
+
+func (ts *TreeSet[E]) tooLow(e E) bool {
+	if ts.fromStart {
+		return false
+	}
+	c := ts.tree.comparator.Compare(e, ts.lo)
+	return c < 0 || (c == 0 && !ts.loInclusive)
+}
+
+func (ts *TreeSet[E]) tooHigh(e E) bool {
+	if ts.toEnd {
+		return false
+	}
+	c := ts.tree.comparator.Compare(e, ts.hi)
+	return c > 0 || (c == 0 && !ts.hiInclusive)
+}
+
+func (ts *TreeSet[E]) inRange(e E) bool {
+	return !ts.tooLow(e) && !ts.tooHigh(e)
+}
+
+// onOpenBound reports whether e equals an exclusive bound of this view.
+// Such an element is not part of the view but is still a valid bound for a nested view.
+func (ts *TreeSet[E]) onOpenBound(e E) bool {
+	if !ts.fromStart && !ts.loInclusive && ts.tree.comparator.Compare(e, ts.lo) == 0 {
+		return true
+	}
+	return !ts.toEnd && !ts.hiInclusive && ts.tree.comparator.Compare(e, ts.hi) == 0
+}
+
+// Navigation in ascending (absolute) order, restricted to the range of this view.
+
+func (ts *TreeSet[E]) absLowest() *treeNode[E] {
+	var node *treeNode[E]
+	switch {
+	case ts.fromStart:
+		node = ts.tree.firstNode()
+	case ts.loInclusive:
+		node = ts.tree.ceilingNode(ts.lo)
+	default:
+		node = ts.tree.higherNode(ts.lo)
+	}
+	if node == nil || ts.tooHigh(node.value) {
+		return nil
+	}
+	return node
+}
+
+func (ts *TreeSet[E]) absHighest() *treeNode[E] {
+	var node *treeNode[E]
+	switch {
+	case ts.toEnd:
+		node = ts.tree.lastNode()
+	case ts.hiInclusive:
+		node = ts.tree.floorNode(ts.hi)
+	default:
+		node = ts.tree.lowerNode(ts.hi)
+	}
+	if node == nil || ts.tooLow(node.value) {
+		return nil
+	}
+	return node
+}
+
+func (ts *TreeSet[E]) absCeiling(e E) *treeNode[E] {
+	if ts.tooLow(e) {
+		return ts.absLowest()
+	}
+	node := ts.tree.ceilingNode(e)
+	if node == nil || ts.tooHigh(node.value) {
+		return nil
+	}
+	return node
+}
+
+func (ts *TreeSet[E]) absHigher(e E) *treeNode[E] {
+	if ts.tooLow(e) {
+		return ts.absLowest()
+	}
+	node := ts.tree.higherNode(e)
+	if node == nil || ts.tooHigh(node.value) {
+		return nil
+	}
+	return node
+}
+
+func (ts *TreeSet[E]) absFloor(e E) *treeNode[E] {
+	if ts.tooHigh(e) {
+		return ts.absHighest()
+	}
+	node := ts.tree.floorNode(e)
+	if node == nil || ts.tooLow(node.value) {
+		return nil
+	}
+	return node
+}
+
+func (ts *TreeSet[E]) absLower(e E) *treeNode[E] {
+	if ts.tooHigh(e) {
+		return ts.absHighest()
+	}
+	node := ts.tree.lowerNode(e)
+	if node == nil || ts.tooLow(node.value) {
+		return nil
+	}
+	return node
+}
+
+func (ts *TreeSet[E]) absSuccessor(node *treeNode[E]) *treeNode[E] {
+	next := successor(node)
+	if next == nil || ts.tooHigh(next.value) {
+		return nil
+	}
+	return next
+}
+
+func (ts *TreeSet[E]) absPredecessor(node *treeNode[E]) *treeNode[E] {
+	prev := predecessor(node)
+	if prev == nil || ts.tooLow(prev.value) {
+		return nil
+	}
+	return prev
+}
+
+// Navigation in the order of this view.
+
+func (ts *TreeSet[E]) first() *treeNode[E] {
+	if ts.descending {
+		return ts.absHighest()
+	}
+	return ts.absLowest()
+}
+
+func (ts *TreeSet[E]) last() *treeNode[E] {
+	if ts.descending {
+		return ts.absLowest()
+	}
+	return ts.absHighest()
+}
+
+func (ts *TreeSet[E]) next(node *treeNode[E]) *treeNode[E] {
+	if ts.descending {
+		return ts.absPredecessor(node)
+	}
+	return ts.absSuccessor(node)
+}
+
+// after returns the node that follows e in the order of this view, whether or not e is in the set.
+func (ts *TreeSet[E]) after(e E) *treeNode[E] {
+	if ts.descending {
+		return ts.absLower(e)
+	}
+	return ts.absHigher(e)
+}
+
+// valueOf returns a copy of the node's value, or NoSuchElementError if the node is nil.
+func valueOf[E comparable](op string, node *treeNode[E]) (*E, error) {
+	if node == nil {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "TreeSet", op)
+	}
+	value := node.value
+	return &value, nil
+}
+
+// treeSetIterator iterates over the elements of a TreeSet or one of its views.
+type treeSetIterator[E comparable] struct {
+	set              *TreeSet[E]
+	next             *treeNode[E]
+	expectedModCount int
+}
+
+// HasNext returns true if the iteration has more elements.
+func (it *treeSetIterator[E]) HasNext() bool {
+	return it.next != nil
+}
+
+// Next returns the next element in the iteration.
+func (it *treeSetIterator[E]) Next() (*E, error) {
+	it.set.tree.mu.RLock()
+	defer it.set.tree.mu.RUnlock()
+
+	if it.set.tree.modCount != it.expectedModCount {
+		return nil, errcodes.New(errcodes.ConcurrentModificationError, "TreeSet", "Iterator.Next")
+	}
+	if it.next == nil {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "TreeSet", "Iterator.Next")
+	}
+	value := it.next.value
+	it.next = it.set.next(it.next)
+	return &value, nil
+}
+
+// Helper methods for Red-Black tree operations.
+// All of them assume the appropriate lock is already held.
+
+func (t *tree[E]) getNode(e E) *treeNode[E] {
+	node := t.root
+	for node != nil {
+		cmp := t.comparator.Compare(e, node.value)
+		if cmp == 0 {
+			return node
+		}
+		if cmp < 0 {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return nil
+}
+
+// insert adds e to the tree and returns false if it was already present.
+func (t *tree[E]) insert(e E) bool {
+	var parent *treeNode[E]
+	cmp := 0
+	for current := t.root; current != nil; {
+		parent = current
+		cmp = t.comparator.Compare(e, current.value)
+		if cmp == 0 {
+			return false
+		}
+		if cmp < 0 {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+
+	newNode := &treeNode[E]{value: e, color: red, parent: parent}
+	switch {
+	case parent == nil:
+		t.root = newNode
+	case cmp < 0:
+		parent.left = newNode
+	default:
+		parent.right = newNode
+	}
+	t.fixInsert(newNode)
+	t.size++
+	t.modCount++
+	return true
+}
+
+// buildFromSorted replaces the contents of the tree with values, which must be strictly
+// increasing according to the comparator. It builds a balanced tree in linear time: every
+// level is black except the deepest, incomplete one, which is red.
+func (t *tree[E]) buildFromSorted(values []E) {
+	redLevel := 0
+	for m := len(values) - 1; m >= 0; m = m/2 - 1 {
+		redLevel++
+	}
+	var build func(level, lo, hi int) *treeNode[E]
+	build = func(level, lo, hi int) *treeNode[E] {
+		if lo > hi {
+			return nil
+		}
+		mid := int(uint(lo+hi) >> 1)
+		node := &treeNode[E]{value: values[mid], color: black}
+		if level == redLevel {
+			node.color = red
+		}
+		if node.left = build(level+1, lo, mid-1); node.left != nil {
+			node.left.parent = node
+		}
+		if node.right = build(level+1, mid+1, hi); node.right != nil {
+			node.right.parent = node
+		}
+		return node
+	}
+	t.root = build(0, 0, len(values)-1)
+	t.size = len(values)
+	t.modCount++
+}
+
+// delete removes the node from the tree.
+func (t *tree[E]) delete(node *treeNode[E]) {
+	t.size--
+	t.modCount++
+
+	// If the node has two children, move the successor's value into it and delete the successor instead
+	if node.left != nil && node.right != nil {
+		next := successor(node)
+		node.value = next.value
+		node = next
+	}
+
+	replacement := node.left
+	if replacement == nil {
+		replacement = node.right
+	}
+
+	if replacement != nil {
+		replacement.parent = node.parent
+		switch {
+		case node.parent == nil:
+			t.root = replacement
+		case node == node.parent.left:
+			node.parent.left = replacement
+		default:
+			node.parent.right = replacement
+		}
+		node.left, node.right, node.parent = nil, nil, nil
+		if node.color == black {
+			t.fixDelete(replacement)
+		}
+		return
+	}
+
+	if node.parent == nil {
+		t.root = nil
+		return
+	}
+
+	// The node is a leaf; use it as the phantom replacement while rebalancing, then unlink it
+	if node.color == black {
+		t.fixDelete(node)
+	}
+	if node.parent != nil {
+		if node == node.parent.left {
+			node.parent.left = nil
+		} else if node == node.parent.right {
+			node.parent.right = nil
+		}
+		node.parent = nil
+	}
+}
+
+func (t *tree[E]) fixInsert(node *treeNode[E]) {
+	for node != t.root && colorOf(node.parent) == red {
+		grandparent := node.parent.parent
+		if node.parent == grandparent.left {
+			uncle := grandparent.right
+			if colorOf(uncle) == red {
+				node.parent.color = black
+				uncle.color = black
+				grandparent.color = red
+				node = grandparent
+			} else {
+				if node == node.parent.right {
+					node = node.parent
+					t.rotateLeft(node)
+				}
+				node.parent.color = black
+				node.parent.parent.color = red
+				t.rotateRight(node.parent.parent)
+			}
+		} else {
+			uncle := grandparent.left
+			if colorOf(uncle) == red {
+				node.parent.color = black
+				uncle.color = black
+				grandparent.color = red
+				node = grandparent
+			} else {
+				if node == node.parent.left {
+					node = node.parent
+					t.rotateRight(node)
+				}
+				node.parent.color = black
+				node.parent.parent.color = red
+				t.rotateLeft(node.parent.parent)
+			}
+		}
+	}
+	t.root.color = black
+}
+
+func (t *tree[E]) fixDelete(node *treeNode[E]) {
+	for node != t.root && colorOf(node) == black {
+		if node == leftOf(parentOf(node)) {
+			sibling := rightOf(parentOf(node))
+			if colorOf(sibling) == red {
+				setColor(sibling, black)
+				setColor(parentOf(node), red)
+				t.rotateLeft(parentOf(node))
+				sibling = rightOf(parentOf(node))
+			}
+			if colorOf(leftOf(sibling)) == black && colorOf(rightOf(sibling)) == black {
+				setColor(sibling, red)
+				node = parentOf(node)
+			} else {
+				if colorOf(rightOf(sibling)) == black {
+					setColor(leftOf(sibling), black)
+					setColor(sibling, red)
+					t.rotateRight(sibling)
+					sibling = rightOf(parentOf(node))
+				}
+				setColor(sibling, colorOf(parentOf(node)))
+				setColor(parentOf(node), black)
+				setColor(rightOf(sibling), black)
+				t.rotateLeft(parentOf(node))
+				node = t.root
+			}
+		} else {
+			sibling := leftOf(parentOf(node))
+			if colorOf(sibling) == red {
+				setColor(sibling, black)
+				setColor(parentOf(node), red)
+				t.rotateRight(parentOf(node))
+				sibling = leftOf(parentOf(node))
+			}
+			if colorOf(rightOf(sibling)) == black && colorOf(leftOf(sibling)) == black {
+				setColor(sibling, red)
+				node = parentOf(node)
+			} else {
+				if colorOf(leftOf(sibling)) == black {
+					setColor(rightOf(sibling), black)
+					setColor(sibling, red)
+					t.rotateLeft(sibling)
+					sibling = leftOf(parentOf(node))
+				}
+				setColor(sibling, colorOf(parentOf(node)))
+				setColor(parentOf(node), black)
+				setColor(leftOf(sibling), black)
+				t.rotateRight(parentOf(node))
+				node = t.root
+			}
+		}
+	}
+	setColor(node, black)
+}
+
+func (t *tree[E]) rotateLeft(node *treeNode[E]) {
+	if node == nil || node.right == nil {
+		return
+	}
+	right := node.right
+	node.right = right.left
+	if right.left != nil {
+		right.left.parent = node
+	}
+	right.parent = node.parent
+	switch {
+	case node.parent == nil:
+		t.root = right
+	case node == node.parent.left:
+		node.parent.left = right
+	default:
+		node.parent.right = right
+	}
+	right.left = node
+	node.parent = right
+}
+
+func (t *tree[E]) rotateRight(node *treeNode[E]) {
+	if node == nil || node.left == nil {
+		return
+	}
+	left := node.left
+	node.left = left.right
+	if left.right != nil {
+		left.right.parent = node
+	}
+	left.parent = node.parent
+	switch {
+	case node.parent == nil:
+		t.root = left
+	case node == node.parent.right:
+		node.parent.right = left
+	default:
+		node.parent.left = left
+	}
+	left.right = node
+	node.parent = left
+}
+
+func (t *tree[E]) firstNode() *treeNode[E] {
+	node := t.root
+	if node == nil {
+		return nil
+	}
+	for node.left != nil {
+		node = node.left
+	}
+	return node
+}
+
+func (t *tree[E]) lastNode() *treeNode[E] {
+	node := t.root
+	if node == nil {
+		return nil
+	}
+	for node.right != nil {
+		node = node.right
+	}
+	return node
+}
+
+func (t *tree[E]) ceilingNode(e E) *treeNode[E] {
+	var result *treeNode[E]
+	for node := t.root; node != nil; {
+		cmp := t.comparator.Compare(e, node.value)
+		if cmp == 0 {
+			return node
+		}
+		if cmp < 0 {
+			result = node
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return result
+}
+
+func (t *tree[E]) floorNode(e E) *treeNode[E] {
+	var result *treeNode[E]
+	for node := t.root; node != nil; {
+		cmp := t.comparator.Compare(e, node.value)
+		if cmp == 0 {
+			return node
+		}
+		if cmp > 0 {
+			result = node
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	return result
+}
+
+func (t *tree[E]) higherNode(e E) *treeNode[E] {
+	var result *treeNode[E]
+	for node := t.root; node != nil; {
+		if t.comparator.Compare(e, node.value) < 0 {
+			result = node
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return result
+}
+
+func (t *tree[E]) lowerNode(e E) *treeNode[E] {
+	var result *treeNode[E]
+	for node := t.root; node != nil; {
+		if t.comparator.Compare(e, node.value) > 0 {
+			result = node
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	return result
+}
+
+// successor returns the node with the next higher value, or nil if there is none.
+func successor[E comparable](node *treeNode[E]) *treeNode[E] {
+	if node.right != nil {
+		node = node.right
+		for node.left != nil {
+			node = node.left
+		}
+		return node
+	}
+	parent := node.parent
+	for parent != nil && node == parent.right {
+		node = parent
+		parent = parent.parent
+	}
+	return parent
+}
+
+// predecessor returns the node with the next lower value, or nil if there is none.
+func predecessor[E comparable](node *treeNode[E]) *treeNode[E] {
+	if node.left != nil {
+		node = node.left
+		for node.right != nil {
+			node = node.right
+		}
+		return node
+	}
+	parent := node.parent
+	for parent != nil && node == parent.left {
+		node = parent
+		parent = parent.parent
+	}
+	return parent
+}
+
+// Nil-safe accessors used while rebalancing, where leaves are treated as black nodes.
+
+func colorOf[E comparable](node *treeNode[E]) treeColor {
+	if node == nil {
+		return black
+	}
+	return node.color
+}
+
+func setColor[E comparable](node *treeNode[E], color treeColor) {
+	if node != nil {
+		node.color = color
+	}
+}
+
+func parentOf[E comparable](node *treeNode[E]) *treeNode[E] {
+	if node == nil {
+		return nil
+	}
+	return node.parent
+}
+
+func leftOf[E comparable](node *treeNode[E]) *treeNode[E] {
+	if node == nil {
+		return nil
+	}
+	return node.left
+}
+
+func rightOf[E comparable](node *treeNode[E]) *treeNode[E] {
+	if node == nil {
+		return nil
+	}
+	return node.right
+}
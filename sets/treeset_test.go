@@ -0,0 +1,571 @@
+package sets
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+// IntComparator implements Comparator for integers
+type IntComparator struct{}
+
+func (c *IntComparator) Compare(a, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+func newIntTreeSet(values ...int) *TreeSet[int] {
+	set := NewTreeSet[int](&IntComparator{})
+	for _, v := range values {
+		set.Add(v)
+	}
+	return set
+}
+
+// verifyTree checks the Red-Black properties and the parent links of the tree
+func verifyTree[E comparable](t *testing.T, tr *tree[E]) {
+	t.Helper()
+	if tr.root == nil {
+		assert.Equal(t, 0, tr.size)
+		return
+	}
+	assert.Equal(t, black, tr.root.color, "root must be black")
+	assert.Nil(t, tr.root.parent, "root must not have a parent")
+
+	count := 0
+	var blackHeight func(node *treeNode[E]) int
+	blackHeight = func(node *treeNode[E]) int {
+		if node == nil {
+			return 1
+		}
+		count++
+		if node.left != nil {
+			assert.Same(t, node, node.left.parent, "broken parent link")
+			assert.Less(t, tr.comparator.Compare(node.left.value, node.value), 0, "left child out of order")
+		}
+		if node.right != nil {
+			assert.Same(t, node, node.right.parent, "broken parent link")
+			assert.Greater(t, tr.comparator.Compare(node.right.value, node.value), 0, "right child out of order")
+		}
+		if node.color == red {
+			assert.Equal(t, black, colorOf(node.left), "red node with red child")
+			assert.Equal(t, black, colorOf(node.right), "red node with red child")
+		}
+		left := blackHeight(node.left)
+		right := blackHeight(node.right)
+		assert.Equal(t, left, right, "unequal black height")
+		if node.color == black {
+			return left + 1
+		}
+		return left
+	}
+	blackHeight(tr.root)
+	assert.Equal(t, tr.size, count, "size does not match node count")
+}
+
+func TestNewTreeSet(t *testing.T) {
+	assert.Nil(t, NewTreeSet[int](nil), "NewTreeSet should return nil for a nil comparator")
+
+	set := NewTreeSet[int](&IntComparator{})
+	assert.NotNil(t, set)
+	assert.True(t, set.IsEmpty())
+	assert.Equal(t, 0, set.Size())
+
+	var _ collections.NavigableSet[int] = set
+}
+
+func TestNewTreeSetFromCollection(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{5, 3, 1, 3, 4})
+	set := NewTreeSetFromCollection[int](list, &IntComparator{})
+	assert.Equal(t, []int{1, 3, 4, 5}, set.ToArray())
+
+	set = NewTreeSetFromCollection[int](nil, &IntComparator{})
+	assert.True(t, set.IsEmpty())
+
+	assert.Nil(t, NewTreeSetFromCollection[int](list, nil))
+}
+
+func TestTreeSet_AddAndContains(t *testing.T) {
+	set := newIntTreeSet()
+	assert.True(t, set.Add(2))
+	assert.True(t, set.Add(1))
+	assert.True(t, set.Add(3))
+	assert.False(t, set.Add(2), "Add should return false for duplicate element")
+
+	assert.True(t, set.Contains(1))
+	assert.False(t, set.Contains(4))
+	assert.Equal(t, 3, set.Size())
+	assert.Equal(t, []int{1, 2, 3}, set.ToArray())
+}
+
+func TestTreeSet_AddAll(t *testing.T) {
+	set := newIntTreeSet(1)
+	assert.False(t, set.AddAll(nil))
+	assert.True(t, set.AddAll(lists.NewArrayListWithInitialCollection([]int{3, 2, 1})))
+	assert.False(t, set.AddAll(lists.NewArrayListWithInitialCollection([]int{1, 2})))
+	assert.Equal(t, []int{1, 2, 3}, set.ToArray())
+}
+
+func TestTreeSet_Remove(t *testing.T) {
+	set := newIntTreeSet(5, 3, 8, 1, 4)
+	assert.True(t, set.Remove(3))
+	assert.False(t, set.Remove(3))
+	assert.False(t, set.Remove(42))
+	assert.Equal(t, []int{1, 4, 5, 8}, set.ToArray())
+	verifyTree(t, set.tree)
+}
+
+func TestTreeSet_RemoveAllRetainAll(t *testing.T) {
+	set := newIntTreeSet(1, 2, 3, 4, 5, 6)
+	assert.False(t, set.RemoveAll(nil))
+	assert.True(t, set.RemoveAll(lists.NewArrayListWithInitialCollection([]int{2, 4, 7})))
+	assert.False(t, set.RemoveAll(lists.NewArrayListWithInitialCollection([]int{7})))
+	assert.Equal(t, []int{1, 3, 5, 6}, set.ToArray())
+
+	assert.False(t, set.RetainAll(nil))
+	assert.True(t, set.RetainAll(lists.NewArrayListWithInitialCollection([]int{3, 6, 9})))
+	assert.False(t, set.RetainAll(lists.NewArrayListWithInitialCollection([]int{3, 6})))
+	assert.Equal(t, []int{3, 6}, set.ToArray())
+	verifyTree(t, set.tree)
+}
+
+func TestTreeSet_RemoveIfAndForEach(t *testing.T) {
+	set := newIntTreeSet(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	assert.False(t, set.RemoveIf(nil))
+	assert.True(t, set.RemoveIf(func(v int) bool { return v%2 == 0 }))
+	assert.False(t, set.RemoveIf(func(v int) bool { return v > 100 }))
+
+	var visited []int
+	set.ForEach(func(v int) { visited = append(visited, v) })
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, visited)
+	set.ForEach(nil)
+	verifyTree(t, set.tree)
+}
+
+func TestTreeSet_ContainsAllAndEquals(t *testing.T) {
+	set := newIntTreeSet(1, 2, 3)
+
+	contains, err := set.ContainsAll(nil)
+	assert.False(t, contains)
+	assert.EqualError(t, err, string(errcodes.NullPointerError))
+
+	contains, err = set.ContainsAll(lists.NewArrayListWithInitialCollection([]int{1, 3}))
+	assert.NoError(t, err)
+	assert.True(t, contains)
+
+	contains, err = set.ContainsAll(lists.NewArrayListWithInitialCollection([]int{1, 4}))
+	assert.NoError(t, err)
+	assert.False(t, contains)
+
+	assert.False(t, set.Equals(nil))
+	assert.True(t, set.Equals(newIntTreeSet(3, 2, 1)))
+	assert.True(t, set.Equals(NewHashSetFromCollection[int](newIntTreeSet(1, 2, 3))))
+	assert.False(t, set.Equals(newIntTreeSet(1, 2)))
+	assert.False(t, set.Equals(newIntTreeSet(1, 2, 4)))
+}
+
+func TestTreeSet_Clear(t *testing.T) {
+	set := newIntTreeSet(1, 2, 3)
+	set.Clear()
+	assert.True(t, set.IsEmpty())
+	assert.Equal(t, 0, set.Size())
+	assert.True(t, set.Add(1))
+}
+
+func TestTreeSet_FirstLast(t *testing.T) {
+	set := newIntTreeSet()
+	_, err := set.First()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+	_, err = set.Last()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+
+	set.AddAll(lists.NewArrayListWithInitialCollection([]int{7, 3, 9, 1}))
+	first, err := set.First()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *first)
+	last, err := set.Last()
+	assert.NoError(t, err)
+	assert.Equal(t, 9, *last)
+}
+
+func TestTreeSet_Navigation(t *testing.T) {
+	set := newIntTreeSet(10, 20, 30, 40)
+
+	tests := []struct {
+		name string
+		fn   func(int) (*int, error)
+		arg  int
+		want int
+		ok   bool
+	}{
+		{"Ceiling exact", set.Ceiling, 20, 20, true},
+		{"Ceiling between", set.Ceiling, 25, 30, true},
+		{"Ceiling below", set.Ceiling, 5, 10, true},
+		{"Ceiling above", set.Ceiling, 45, 0, false},
+		{"Floor exact", set.Floor, 20, 20, true},
+		{"Floor between", set.Floor, 25, 20, true},
+		{"Floor below", set.Floor, 5, 0, false},
+		{"Floor above", set.Floor, 45, 40, true},
+		{"Higher exact", set.Higher, 20, 30, true},
+		{"Higher between", set.Higher, 25, 30, true},
+		{"Higher last", set.Higher, 40, 0, false},
+		{"Lower exact", set.Lower, 20, 10, true},
+		{"Lower between", set.Lower, 25, 20, true},
+		{"Lower first", set.Lower, 10, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(tt.arg)
+			if !tt.ok {
+				assert.Nil(t, got)
+				assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func TestTreeSet_Poll(t *testing.T) {
+	set := newIntTreeSet(2, 1, 3)
+	first, err := set.PollFirst()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *first)
+	last, err := set.PollLast()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *last)
+	assert.Equal(t, []int{2}, set.ToArray())
+
+	_, _ = set.PollFirst()
+	_, err = set.PollFirst()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+	_, err = set.PollLast()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+}
+
+func TestTreeSet_Iterator(t *testing.T) {
+	set := newIntTreeSet(3, 1, 2)
+	it := set.Iterator()
+	var values []int
+	for it.HasNext() {
+		v, err := it.Next()
+		assert.NoError(t, err)
+		values = append(values, *v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	_, err := it.Next()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+
+	descending := set.DescendingIterator()
+	values = nil
+	for descending.HasNext() {
+		v, _ := descending.Next()
+		values = append(values, *v)
+	}
+	assert.Equal(t, []int{3, 2, 1}, values)
+}
+
+func TestTreeSet_IteratorConcurrentModification(t *testing.T) {
+	set := newIntTreeSet(1, 2, 3)
+	it := set.Iterator()
+	v, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *v)
+
+	set.Add(4)
+	_, err = it.Next()
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+
+	// Adding a duplicate is not a structural modification
+	it = set.Iterator()
+	set.Add(1)
+	_, err = it.Next()
+	assert.NoError(t, err)
+}
+
+func TestTreeSet_SnapshotIterator(t *testing.T) {
+	set := newIntTreeSet(1, 2, 3)
+	it := set.SnapshotIterator()
+	set.Remove(2)
+	set.Add(4)
+
+	var result []int
+	for it.HasNext() {
+		v, err := it.Next()
+		assert.NoError(t, err)
+		result = append(result, *v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, result)
+}
+
+func TestTreeSet_Comparator(t *testing.T) {
+	comparator := &IntComparator{}
+	set := NewTreeSet[int](comparator)
+	assert.Same(t, comparator, set.Comparator())
+
+	reversed := set.DescendingSet().Comparator()
+	assert.Equal(t, 1, reversed.Compare(1, 2))
+	assert.Equal(t, -1, reversed.Compare(2, 1))
+}
+
+func TestTreeSet_HeadTailSubSet(t *testing.T) {
+	set := newIntTreeSet(1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	head, err := set.HeadSet(4)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, head.ToArray())
+	assert.Equal(t, 3, head.Size())
+
+	tail, err := set.TailSet(7)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{7, 8, 9}, tail.ToArray())
+
+	sub, err := set.SubSet(3, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4, 5}, sub.ToArray())
+
+	empty, err := set.SubSet(4, 4)
+	assert.NoError(t, err)
+	assert.True(t, empty.IsEmpty())
+
+	_, err = set.SubSet(6, 3)
+	assert.EqualError(t, err, string(errcodes.IllegalArgumentError))
+
+	// Nested views must stay within the range of the enclosing view
+	nested, err := sub.HeadSet(5)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, nested.ToArray())
+	nested, err = sub.HeadSet(6)
+	assert.NoError(t, err, "the exclusive bound itself is a valid nested bound")
+	assert.Equal(t, []int{3, 4, 5}, nested.ToArray())
+	_, err = sub.HeadSet(7)
+	assert.EqualError(t, err, string(errcodes.IllegalArgumentError))
+	_, err = sub.TailSet(2)
+	assert.EqualError(t, err, string(errcodes.IllegalArgumentError))
+	_, err = sub.SubSet(2, 5)
+	assert.EqualError(t, err, string(errcodes.IllegalArgumentError))
+}
+
+func TestTreeSet_ViewsAreLive(t *testing.T) {
+	set := newIntTreeSet(10, 20, 30, 40, 50)
+	sub, err := set.SubSet(20, 40)
+	assert.NoError(t, err)
+	view := sub.(*TreeSet[int])
+
+	// Changes to the backing set are visible in the view
+	set.Add(25)
+	set.Remove(20)
+	set.Add(45)
+	assert.Equal(t, []int{25, 30}, view.ToArray())
+
+	// Changes through the view are visible in the backing set
+	assert.True(t, view.Add(35))
+	assert.False(t, view.Add(40), "elements outside the range cannot be added through the view")
+	assert.False(t, view.Add(5))
+	assert.True(t, view.Remove(30))
+	assert.False(t, view.Remove(10), "elements outside the range cannot be removed through the view")
+	assert.Equal(t, []int{10, 25, 35, 40, 45, 50}, set.ToArray())
+
+	assert.True(t, view.Contains(25))
+	assert.False(t, view.Contains(10))
+
+	// Navigation is restricted to the range
+	ceiling, err := view.Ceiling(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 25, *ceiling)
+	_, err = view.Ceiling(36)
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+	floor, err := view.Floor(100)
+	assert.NoError(t, err)
+	assert.Equal(t, 35, *floor)
+	_, err = view.Lower(25)
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+	_, err = view.Higher(35)
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+
+	first, err := view.PollFirst()
+	assert.NoError(t, err)
+	assert.Equal(t, 25, *first)
+
+	// Clearing the view only removes elements in range
+	view.Clear()
+	assert.True(t, view.IsEmpty())
+	assert.Equal(t, []int{10, 40, 45, 50}, set.ToArray())
+	verifyTree(t, set.tree)
+}
+
+func TestTreeSet_DescendingSet(t *testing.T) {
+	set := newIntTreeSet(1, 2, 3, 4, 5)
+	desc := set.DescendingSet()
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, desc.ToArray())
+
+	first, _ := desc.First()
+	last, _ := desc.Last()
+	assert.Equal(t, 5, *first)
+	assert.Equal(t, 1, *last)
+
+	// Navigation is mirrored in a descending view
+	ceiling, _ := desc.Ceiling(3)
+	assert.Equal(t, 3, *ceiling)
+	higher, _ := desc.Higher(3)
+	assert.Equal(t, 2, *higher)
+	lower, _ := desc.Lower(3)
+	assert.Equal(t, 4, *lower)
+	floor, _ := desc.Floor(0)
+	assert.Equal(t, 1, *floor)
+	floor, _ = desc.Floor(6)
+	assert.Nil(t, floor)
+
+	// Head and tail follow the descending order
+	head, err := desc.HeadSet(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5, 4}, head.ToArray())
+	tail, err := desc.TailSet(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 2, 1}, tail.ToArray())
+	sub, err := desc.SubSet(4, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 3, 2}, sub.ToArray())
+	_, err = desc.SubSet(1, 4)
+	assert.EqualError(t, err, string(errcodes.IllegalArgumentError))
+
+	polled, _ := desc.PollFirst()
+	assert.Equal(t, 5, *polled)
+	polled, _ = desc.PollLast()
+	assert.Equal(t, 1, *polled)
+	assert.Equal(t, []int{2, 3, 4}, set.ToArray())
+
+	// The descending view of a descending view is in ascending order again
+	assert.Equal(t, []int{2, 3, 4}, desc.DescendingSet().ToArray())
+
+	var iterated []int
+	it := desc.DescendingIterator()
+	for it.HasNext() {
+		v, _ := it.Next()
+		iterated = append(iterated, *v)
+	}
+	assert.Equal(t, []int{2, 3, 4}, iterated)
+}
+
+func TestTreeSet_Clone(t *testing.T) {
+	set := newIntTreeSet(1, 2, 3, 4)
+	head, _ := set.HeadSet(3)
+	clone := head.(*TreeSet[int]).Clone()
+	assert.Equal(t, []int{1, 2}, clone.ToArray())
+
+	// The clone is independent and unbounded
+	assert.True(t, clone.Add(10))
+	assert.False(t, set.Contains(10))
+}
+
+func TestTreeSet_RandomizedAgainstSortedSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	set := newIntTreeSet()
+	reference := make(map[int]bool)
+
+	for i := 0; i < 5000; i++ {
+		v := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			assert.Equal(t, reference[v], set.Remove(v))
+			delete(reference, v)
+		} else {
+			assert.Equal(t, !reference[v], set.Add(v))
+			reference[v] = true
+		}
+	}
+	verifyTree(t, set.tree)
+
+	expected := make([]int, 0, len(reference))
+	for v := range reference {
+		expected = append(expected, v)
+	}
+	sort.Ints(expected)
+	assert.Equal(t, expected, set.ToArray())
+}
+
+func TestTreeSet_Concurrent(t *testing.T) {
+	set := newIntTreeSet()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(offset int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				set.Add(offset*1000 + i)
+				set.Contains(i)
+				if i%3 == 0 {
+					set.Remove(offset*1000 + i)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, 8*(200-67), set.Size())
+	verifyTree(t, set.tree)
+}
+
+func TestTreeSet_All(t *testing.T) {
+	set := NewTreeSet[int](&IntComparator{})
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		set.Add(v)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(set.All()))
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, slices.Collect(set.Backward()))
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, slices.Collect(set.DescendingSet().All()))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(set.DescendingSet().Backward()))
+
+	sub, err := set.SubSet(2, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4}, slices.Collect(sub.All()))
+	assert.Equal(t, []int{4, 3, 2}, slices.Collect(sub.(collections.NavigableSet[int]).Backward()))
+
+	// The loop body may modify the set; iteration resumes after the last element yielded
+	var seen []int
+	for v := range set.All() {
+		if v == 2 {
+			set.Remove(2)
+			set.Remove(3)
+			set.Add(6)
+		}
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []int{1, 2, 4, 5, 6}, seen)
+
+	seen = nil
+	for v := range set.Backward() {
+		seen = append(seen, v)
+		if v == 5 {
+			break
+		}
+	}
+	assert.Equal(t, []int{6, 5}, seen)
+}
+
+func TestTreeSet_ErrorDetails(t *testing.T) {
+	set := newIntTreeSet()
+	_, err := set.PollFirst()
+	assert.ErrorIs(t, err, errcodes.ErrNoSuchElement)
+	var e *errcodes.Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, errcodes.Error{Code: errcodes.NoSuchElementError, Type: "TreeSet", Op: "PollFirst"}, *e)
+
+	_, err = set.SubSet(5, 1)
+	assert.ErrorIs(t, err, errcodes.ErrIllegalArgument)
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, "SubSet", e.Op)
+}
@@ -0,0 +1,199 @@
+package streams
+
+import (
+	"strings"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/chiranjeevipavurala/gocollections/maps"
+	"github.com/chiranjeevipavurala/gocollections/sets"
+)
+
+// Collector describes how to reduce the elements of a stream into a single result,
+// such as a list, a map or a sum. Collectors are reusable: every call to Collect
+// starts a fresh accumulation.
+type Collector[E, R any] struct {
+	start func() (accumulate func(E) error, finish func() R)
+}
+
+// NewCollector returns a collector that creates its state with supplier, folds each
+// element into it with accumulator and turns it into the result with finisher.
+func NewCollector[E, A, R any](supplier func() A, accumulator func(A, E) A, finisher func(A) R) Collector[E, R] {
+	return Collector[E, R]{start: func() (func(E) error, func() R) {
+		state := supplier()
+		accumulate := func(element E) error {
+			state = accumulator(state, element)
+			return nil
+		}
+		return accumulate, func() R { return finisher(state) }
+	}}
+}
+
+// Collect runs the stream into the collector and returns the result.
+// Returns the source error if reading failed, or the collector's error if it rejected an element.
+func Collect[E, R any](s *Stream[E], collector Collector[E, R]) (R, error) {
+	accumulate, finish := collector.start()
+	for value := range s.seq {
+		if err := accumulate(value); err != nil {
+			var zero R
+			return zero, err
+		}
+	}
+	if err := s.Err(); err != nil {
+		var zero R
+		return zero, err
+	}
+	return finish(), nil
+}
+
+// Number is the set of types that Summing can add up.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// ToList collects the elements into a new ArrayList in encounter order.
+func ToList[E comparable]() Collector[E, *lists.ArrayList[E]] {
+	return NewCollector(lists.NewArrayList[E], func(list *lists.ArrayList[E], element E) *lists.ArrayList[E] {
+		list.Add(element)
+		return list
+	}, identity[*lists.ArrayList[E]])
+}
+
+// ToSet collects the elements into a new HashSet.
+func ToSet[E comparable]() Collector[E, collections.Set[E]] {
+	return NewCollector(sets.NewHashSet[E], func(set collections.Set[E], element E) collections.Set[E] {
+		set.Add(element)
+		return set
+	}, identity[collections.Set[E]])
+}
+
+// ToMap collects the elements into a new HashMap using key and value to build each entry.
+// When two elements have the same key, merge combines the existing value with the new one.
+// If merge is nil, a duplicate key makes Collect return IllegalStateError. The values may
+// be of any type; the map compares them as NewHashMapWithValueEquality does.
+func ToMap[E any, K comparable, V any](key func(E) K, value func(E) V, merge func(V, V) V) Collector[E, collections.Map[K, V]] {
+	return toMap(func() collections.Map[K, V] { return maps.NewHashMapWithValueEquality[K, V](nil) }, key, value, merge)
+}
+
+// ToTreeMap collects the elements into a new TreeMap ordered by comparator, using key and
+// value to build each entry. Duplicate keys are handled as in ToMap.
+func ToTreeMap[E any, K comparable, V any](comparator collections.Comparator[K], key func(E) K, value func(E) V, merge func(V, V) V) Collector[E, maps.SortedMap[K, V]] {
+	return toMap(func() maps.SortedMap[K, V] { return maps.NewTreeMapWithValueEquality[K, V](comparator, nil) }, key, value, merge)
+}
+
+// toMap is the shared implementation of ToMap and ToTreeMap.
+func toMap[E any, K comparable, V any, M collections.Map[K, V]](newMap func() M, key func(E) K, value func(E) V, merge func(V, V) V) Collector[E, M] {
+	return Collector[E, M]{start: func() (func(E) error, func() M) {
+		m := newMap()
+		accumulate := func(element E) error {
+			k, v := key(element), value(element)
+			if m.HasKey(k) {
+				if merge == nil {
+					return errcodes.New(errcodes.IllegalStateError, "Collector", "ToMap")
+				}
+				v = merge(*m.Get(k), v)
+			}
+			m.Put(k, v)
+			return nil
+		}
+		return accumulate, func() M { return m }
+	}}
+}
+
+// GroupingBy collects the elements into a HashMap from each key to the list of elements
+// with that key, in encounter order.
+func GroupingBy[E, K comparable](key func(E) K) Collector[E, *maps.HashMap[K, *lists.ArrayList[E]]] {
+	return GroupingByWith(key, ToList[E]())
+}
+
+// GroupingByWith groups the elements by key and reduces each group with the downstream
+// collector, for example Counting.
+func GroupingByWith[E any, K comparable, R any](key func(E) K, downstream Collector[E, R]) Collector[E, *maps.HashMap[K, R]] {
+	return Collector[E, *maps.HashMap[K, R]]{start: func() (func(E) error, func() *maps.HashMap[K, R]) {
+		type group struct {
+			accumulate func(E) error
+			finish     func() R
+		}
+		groups := make(map[K]*group)
+		accumulate := func(element E) error {
+			k := key(element)
+			g, ok := groups[k]
+			if !ok {
+				g = &group{}
+				g.accumulate, g.finish = downstream.start()
+				groups[k] = g
+			}
+			return g.accumulate(element)
+		}
+		finish := func() *maps.HashMap[K, R] {
+			result := maps.NewHashMapWithValueEquality[K, R](nil, maps.WithCapacity(len(groups))).(*maps.HashMap[K, R])
+			for k, g := range groups {
+				result.Put(k, g.finish())
+			}
+			return result
+		}
+		return accumulate, finish
+	}}
+}
+
+// PartitioningBy collects the elements into a HashMap with the keys true and false,
+// mapping each to the list of elements for which the predicate returned that value.
+// Both keys are always present.
+func PartitioningBy[E comparable](predicate func(E) bool) Collector[E, *maps.HashMap[bool, *lists.ArrayList[E]]] {
+	return PartitioningByWith(predicate, ToList[E]())
+}
+
+// PartitioningByWith partitions the elements by predicate and reduces each partition with
+// the downstream collector. Both keys are always present.
+func PartitioningByWith[E any, R any](predicate func(E) bool, downstream Collector[E, R]) Collector[E, *maps.HashMap[bool, R]] {
+	return Collector[E, *maps.HashMap[bool, R]]{start: func() (func(E) error, func() *maps.HashMap[bool, R]) {
+		acceptTrue, finishTrue := downstream.start()
+		acceptFalse, finishFalse := downstream.start()
+		accumulate := func(element E) error {
+			if predicate(element) {
+				return acceptTrue(element)
+			}
+			return acceptFalse(element)
+		}
+		finish := func() *maps.HashMap[bool, R] {
+			result := maps.NewHashMapWithValueEquality[bool, R](nil, maps.WithCapacity(2)).(*maps.HashMap[bool, R])
+			result.Put(true, finishTrue())
+			result.Put(false, finishFalse())
+			return result
+		}
+		return accumulate, finish
+	}}
+}
+
+// Joining concatenates the elements, separated by sep.
+func Joining(sep string) Collector[string, string] {
+	type joiner struct {
+		builder strings.Builder
+		started bool
+	}
+	return NewCollector(func() *joiner { return &joiner{} }, func(j *joiner, element string) *joiner {
+		if j.started {
+			j.builder.WriteString(sep)
+		}
+		j.builder.WriteString(element)
+		j.started = true
+		return j
+	}, func(j *joiner) string { return j.builder.String() })
+}
+
+// Counting counts the elements.
+func Counting[E any]() Collector[E, int] {
+	return NewCollector(func() int { return 0 }, func(count int, _ E) int { return count + 1 }, identity[int])
+}
+
+// Summing adds up the result of applying value to each element.
+func Summing[E any, N Number](value func(E) N) Collector[E, N] {
+	return NewCollector(func() N { return 0 }, func(sum N, element E) N { return sum + value(element) }, identity[N])
+}
+
+func identity[T any](value T) T {
+	return value
+}
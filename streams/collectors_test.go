@@ -0,0 +1,152 @@
+package streams
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+type order struct {
+	customer string
+	amount   int
+}
+
+var orders = []order{
+	{"alice", 30},
+	{"bob", 10},
+	{"alice", 5},
+	{"carol", 20},
+	{"bob", 15},
+}
+
+func customer(o order) string { return o.customer }
+
+func TestCollect_ToListAndToSet(t *testing.T) {
+	list, err := Collect(OfSlice(3, 1, 3, 2), ToList[int]())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 1, 3, 2}, list.ToArray())
+
+	set, err := Collect(OfSlice(3, 1, 3, 2), ToSet[int]())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, set.Size())
+
+	// Each Collect starts from a fresh container
+	collector := ToList[int]()
+	first, _ := Collect(OfSlice(1), collector)
+	second, _ := Collect(OfSlice(2), collector)
+	assert.Equal(t, []int{1}, first.ToArray())
+	assert.Equal(t, []int{2}, second.ToArray())
+}
+
+func TestCollect_ToMap(t *testing.T) {
+	totals, err := Collect(OfSlice(orders...), ToMap(customer, func(o order) int { return o.amount }, func(a, b int) int { return a + b }))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, totals.Size())
+	assert.Equal(t, 35, *totals.Get("alice"))
+	assert.Equal(t, 25, *totals.Get("bob"))
+
+	_, err = Collect(OfSlice(orders...), ToMap(customer, func(o order) int { return o.amount }, nil))
+	assert.EqualError(t, err, string(errcodes.IllegalStateError))
+
+	sorted, err := Collect(OfSlice(orders...), ToTreeMap(comparators.Reverse(comparators.Natural[string]()), customer,
+		func(o order) int { return o.amount }, func(a, b int) int { return max(a, b) }))
+	assert.NoError(t, err)
+	first, _ := sorted.FirstKey()
+	assert.Equal(t, "carol", first)
+	assert.Equal(t, 30, *sorted.Get("alice"))
+}
+
+func TestCollect_GroupingBy(t *testing.T) {
+	groups, err := Collect(OfSlice(orders...), GroupingBy(customer))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, groups.Size())
+	alice := *groups.Get("alice")
+	assert.Equal(t, []order{{"alice", 30}, {"alice", 5}}, alice.ToArray())
+
+	counts, err := Collect(OfSlice(orders...), GroupingByWith(customer, Counting[order]()))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *counts.Get("bob"))
+	assert.Equal(t, 1, *counts.Get("carol"))
+
+	sums, err := Collect(OfSlice(orders...), GroupingByWith(customer, Summing(func(o order) int { return o.amount })))
+	assert.NoError(t, err)
+	assert.Equal(t, 35, *sums.Get("alice"))
+
+	// Downstream errors stop the collection
+	_, err = Collect(OfSlice(orders...), GroupingByWith(func(o order) int { return len(o.customer) },
+		ToMap(customer, func(o order) int { return o.amount }, nil)))
+	assert.EqualError(t, err, string(errcodes.IllegalStateError))
+}
+
+func TestCollect_PartitioningBy(t *testing.T) {
+	large := func(o order) bool { return o.amount >= 20 }
+	parts, err := Collect(OfSlice(orders...), PartitioningBy(large))
+	assert.NoError(t, err)
+	bigOrders := *parts.Get(true)
+	assert.Equal(t, []order{{"alice", 30}, {"carol", 20}}, bigOrders.ToArray())
+	assert.Equal(t, 3, (*parts.Get(false)).Size())
+
+	empty, err := Collect(OfSlice[order](), PartitioningByWith(large, Counting[order]()))
+	assert.NoError(t, err)
+	assert.True(t, empty.HasKey(true))
+	assert.Equal(t, 0, *empty.Get(false))
+}
+
+func TestCollect_NonComparableValues(t *testing.T) {
+	amounts := func(o order) []int { return []int{o.amount} }
+	appended := func(a, b []int) []int { return append(a, b...) }
+
+	byCustomer, err := Collect(OfSlice(orders...), ToMap(customer, amounts, appended))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{30, 5}, *byCustomer.Get("alice"))
+	assert.True(t, byCustomer.HasValue([]int{20}))
+
+	sorted, err := Collect(OfSlice(orders...), ToTreeMap(comparators.Natural[string](), customer, amounts, appended))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{10, 15}, *sorted.Get("bob"))
+
+	toSlice := NewCollector(func() []order { return nil },
+		func(s []order, o order) []order { return append(s, o) },
+		func(s []order) []order { return s })
+	groups, err := Collect(OfSlice(orders...), GroupingByWith(customer, toSlice))
+	assert.NoError(t, err)
+	assert.Equal(t, []order{{"bob", 10}, {"bob", 15}}, *groups.Get("bob"))
+	assert.True(t, groups.HasValue([]order{{"carol", 20}}))
+
+	parts, err := Collect(OfSlice(orders...), PartitioningByWith(func(o order) bool { return o.amount >= 20 }, toSlice))
+	assert.NoError(t, err)
+	assert.Equal(t, []order{{"alice", 30}, {"carol", 20}}, *parts.Get(true))
+}
+
+func TestCollect_JoiningCountingSumming(t *testing.T) {
+	joined, err := Collect(Map(OfSlice(1, 2, 3), strconv.Itoa), Joining(", "))
+	assert.NoError(t, err)
+	assert.Equal(t, "1, 2, 3", joined)
+
+	joined, _ = Collect(OfSlice("", "a", ""), Joining("-"))
+	assert.Equal(t, "-a-", joined)
+	joined, _ = Collect(OfSlice[string](), Joining("-"))
+	assert.Equal(t, "", joined)
+
+	count, _ := Collect(OfSlice(orders...), Counting[order]())
+	assert.Equal(t, 5, count)
+
+	total, _ := Collect(OfSlice(1.5, 2.5), Summing(func(f float64) float64 { return f }))
+	assert.Equal(t, 4.0, total)
+
+	upper, _ := Collect(OfSlice("a", "b"), NewCollector(func() []string { return nil },
+		func(acc []string, s string) []string { return append(acc, strings.ToUpper(s)) },
+		func(acc []string) string { return strings.Join(acc, "") }))
+	assert.Equal(t, "AB", upper)
+}
+
+func TestCollect_SourceError(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{1, 2, 3})
+	_, err := Collect(Of[int](list).Peek(func(int) { list.Add(0) }), ToList[int]())
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+}
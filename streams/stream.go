@@ -0,0 +1,310 @@
+// Package streams provides lazy, single-pass pipelines over collections.
+//
+// Intermediate operations such as Filter, Map and Limit only describe the pipeline.
+// Nothing is read from the source until a terminal operation such as Count, Reduce or
+// CollectTo runs, and then each element flows through every stage before the next one
+// is read. Short-circuiting operations stop reading the source as soon as the result is known.
+package streams
+
+import (
+	"errors"
+	"iter"
+	"slices"
+
+	"github.com/chiranjeevipavurala/gocollections/collections"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+)
+
+// source records the first error returned by the iterator a pipeline reads from.
+// Every stage of a pipeline shares the same source.
+type source struct {
+	err error
+}
+
+// Stream is a lazy sequence of elements. Each terminal operation runs the whole
+// pipeline again from its source.
+type Stream[E any] struct {
+	seq iter.Seq[E]
+	src *source
+}
+
+// Of returns a stream over the elements returned by the iterable's Iterator.
+// If the iterator fails, for example with ConcurrentModificationError because the
+// source was modified during the pipeline, the stream ends and Err reports the error.
+func Of[E any](iterable collections.Iterable[E]) *Stream[E] {
+	src := &source{}
+	return &Stream[E]{
+		src: src,
+		seq: func(yield func(E) bool) {
+			src.err = nil
+			if iterable == nil {
+				return
+			}
+			it := iterable.Iterator()
+			for it.HasNext() {
+				value, err := it.Next()
+				if err != nil {
+					src.err = err
+					return
+				}
+				if !yield(*value) {
+					return
+				}
+			}
+			// Fail-fast iterators also report no next element once the source has been
+			// modified, so ask Next whether the iteration really reached the end.
+			if _, err := it.Next(); err != nil && !errors.Is(err, errcodes.ErrNoSuchElement) {
+				src.err = err
+			}
+		},
+	}
+}
+
+// OfSlice returns a stream over the given values.
+func OfSlice[E any](values ...E) *Stream[E] {
+	return FromSeq(slices.Values(values))
+}
+
+// FromSeq returns a stream over a range-over-func sequence, such as the All method of a collection.
+func FromSeq[E any](seq iter.Seq[E]) *Stream[E] {
+	return &Stream[E]{seq: seq, src: &source{}}
+}
+
+// derive returns a stream that shares the source of s.
+func derive[E, R any](s *Stream[E], seq iter.Seq[R]) *Stream[R] {
+	return &Stream[R]{seq: seq, src: s.src}
+}
+
+// Err returns the error that ended the most recent terminal operation early, or nil.
+func (s *Stream[E]) Err() error {
+	return s.src.err
+}
+
+// All returns the pipeline as a sequence for use with range.
+func (s *Stream[E]) All() iter.Seq[E] {
+	return s.seq
+}
+
+// Map returns a stream of the results of applying mapper to each element of s.
+func Map[E, R any](s *Stream[E], mapper func(E) R) *Stream[R] {
+	return derive(s, func(yield func(R) bool) {
+		for value := range s.seq {
+			if !yield(mapper(value)) {
+				return
+			}
+		}
+	})
+}
+
+// FlatMap returns a stream of the elements of the sequences produced by applying mapper to each element of s.
+func FlatMap[E, R any](s *Stream[E], mapper func(E) iter.Seq[R]) *Stream[R] {
+	return derive(s, func(yield func(R) bool) {
+		for value := range s.seq {
+			for inner := range mapper(value) {
+				if !yield(inner) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// Distinct returns a stream of the elements of s with duplicates removed, keeping the first occurrence of each.
+func Distinct[E comparable](s *Stream[E]) *Stream[E] {
+	return derive(s, func(yield func(E) bool) {
+		seen := make(map[E]struct{})
+		for value := range s.seq {
+			if _, ok := seen[value]; ok {
+				continue
+			}
+			seen[value] = struct{}{}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Filter returns a stream of the elements that match the predicate.
+func (s *Stream[E]) Filter(predicate func(E) bool) *Stream[E] {
+	return derive(s, func(yield func(E) bool) {
+		for value := range s.seq {
+			if predicate(value) && !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Sorted returns a stream of the elements sorted by comparator. The sort is stable.
+// Sorting has to see every element, so the stage reads the whole of its input before
+// passing anything on.
+func (s *Stream[E]) Sorted(comparator collections.Comparator[E]) *Stream[E] {
+	return derive(s, func(yield func(E) bool) {
+		values := slices.Collect(s.seq)
+		slices.SortStableFunc(values, comparator.Compare)
+		for _, value := range values {
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Limit returns a stream of at most the first maxSize elements.
+// The source is not read past the last element needed.
+func (s *Stream[E]) Limit(maxSize int) *Stream[E] {
+	return derive(s, func(yield func(E) bool) {
+		if maxSize <= 0 {
+			return
+		}
+		count := 0
+		for value := range s.seq {
+			count++
+			if !yield(value) || count >= maxSize {
+				return
+			}
+		}
+	})
+}
+
+// Skip returns a stream of the elements after the first n.
+func (s *Stream[E]) Skip(n int) *Stream[E] {
+	return derive(s, func(yield func(E) bool) {
+		skipped := 0
+		for value := range s.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Peek returns a stream of the same elements that also calls action on each one as it passes.
+func (s *Stream[E]) Peek(action func(E)) *Stream[E] {
+	return derive(s, func(yield func(E) bool) {
+		for value := range s.seq {
+			action(value)
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// ForEach performs action on each element.
+func (s *Stream[E]) ForEach(action func(E)) {
+	for value := range s.seq {
+		action(value)
+	}
+}
+
+// Reduce combines the elements with accumulator, starting from identity.
+func (s *Stream[E]) Reduce(identity E, accumulator func(E, E) E) E {
+	result := identity
+	for value := range s.seq {
+		result = accumulator(result, value)
+	}
+	return result
+}
+
+// Count returns the number of elements.
+func (s *Stream[E]) Count() int {
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+// AnyMatch returns true if any element matches the predicate. It stops at the first match.
+func (s *Stream[E]) AnyMatch(predicate func(E) bool) bool {
+	for value := range s.seq {
+		if predicate(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch returns true if every element matches the predicate, or the stream is empty.
+// It stops at the first element that does not match.
+func (s *Stream[E]) AllMatch(predicate func(E) bool) bool {
+	for value := range s.seq {
+		if !predicate(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneMatch returns true if no element matches the predicate. It stops at the first match.
+func (s *Stream[E]) NoneMatch(predicate func(E) bool) bool {
+	return !s.AnyMatch(predicate)
+}
+
+// Min returns the smallest element according to comparator.
+// Returns NoSuchElementError if the stream is empty, or the source error if reading failed.
+func (s *Stream[E]) Min(comparator collections.Comparator[E]) (*E, error) {
+	return s.extreme("Min", func(candidate, current E) bool {
+		return comparator.Compare(candidate, current) < 0
+	})
+}
+
+// Max returns the largest element according to comparator.
+// Returns NoSuchElementError if the stream is empty, or the source error if reading failed.
+func (s *Stream[E]) Max(comparator collections.Comparator[E]) (*E, error) {
+	return s.extreme("Max", func(candidate, current E) bool {
+		return comparator.Compare(candidate, current) > 0
+	})
+}
+
+// extreme returns the first element for which no later element is better.
+func (s *Stream[E]) extreme(op string, better func(candidate, current E) bool) (*E, error) {
+	var result *E
+	for value := range s.seq {
+		if result == nil || better(value, *result) {
+			result = &value
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errcodes.New(errcodes.NoSuchElementError, "Stream", op)
+	}
+	return result, nil
+}
+
+// FindFirst returns the first element. It reads no further than that element.
+// Returns NoSuchElementError if the stream is empty, or the source error if reading failed.
+func (s *Stream[E]) FindFirst() (*E, error) {
+	for value := range s.seq {
+		return &value, nil
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, errcodes.New(errcodes.NoSuchElementError, "Stream", "FindFirst")
+}
+
+// ToSlice returns the elements in a new slice.
+func (s *Stream[E]) ToSlice() []E {
+	return slices.Collect(s.seq)
+}
+
+// CollectTo adds every element to target.
+// Returns the source error if reading failed; elements read before the failure have already been added.
+func (s *Stream[E]) CollectTo(target collections.Collection[E]) error {
+	if target == nil {
+		return errcodes.New(errcodes.NullPointerError, "Stream", "CollectTo")
+	}
+	for value := range s.seq {
+		target.Add(value)
+	}
+	return s.Err()
+}
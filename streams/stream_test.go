@@ -0,0 +1,149 @@
+package streams
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/chiranjeevipavurala/gocollections/comparators"
+	errcodes "github.com/chiranjeevipavurala/gocollections/errors"
+	"github.com/chiranjeevipavurala/gocollections/lists"
+	"github.com/chiranjeevipavurala/gocollections/sets"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream_Of(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{1, 2, 3})
+	assert.Equal(t, []int{1, 2, 3}, Of[int](list).ToSlice())
+
+	stack := lists.NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	assert.Equal(t, 2, Of[int](stack).Count())
+
+	assert.Empty(t, Of[int](nil).ToSlice())
+	assert.Equal(t, []string{"a", "b"}, OfSlice("a", "b").ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, FromSeq(list.All()).ToSlice())
+
+	// Each terminal operation reads the source again
+	stream := Of[int](list)
+	assert.Equal(t, 3, stream.Count())
+	list.Add(4)
+	assert.Equal(t, 4, stream.Count())
+}
+
+func TestStream_IntermediateOperations(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{5, 3, 1, 4, 1, 5, 9, 2, 6})
+
+	evens := Of[int](list).Filter(func(n int) bool { return n%2 == 0 }).ToSlice()
+	assert.Equal(t, []int{4, 2, 6}, evens)
+
+	labels := Map(Of[int](list).Limit(3), strconv.Itoa).ToSlice()
+	assert.Equal(t, []string{"5", "3", "1"}, labels)
+
+	repeated := FlatMap(OfSlice(1, 2, 3), func(n int) iter.Seq[int] {
+		return slices.Values(slices.Repeat([]int{n}, n))
+	}).ToSlice()
+	assert.Equal(t, []int{1, 2, 2, 3, 3, 3}, repeated)
+
+	assert.Equal(t, []int{5, 3, 1, 4, 9, 2, 6}, Distinct(Of[int](list)).ToSlice())
+	assert.Equal(t, []int{1, 1, 2, 3, 4, 5, 5, 6, 9}, Of[int](list).Sorted(comparators.Natural[int]()).ToSlice())
+	assert.Equal(t, []int{9, 2, 6}, Of[int](list).Skip(6).ToSlice())
+	assert.Empty(t, Of[int](list).Skip(20).ToSlice())
+	assert.Empty(t, Of[int](list).Limit(0).ToSlice())
+
+	var peeked []int
+	Of[int](list).Peek(func(n int) { peeked = append(peeked, n) }).Limit(2).ForEach(func(int) {})
+	assert.Equal(t, []int{5, 3}, peeked)
+
+	var ranged []int
+	for n := range Of[int](list).Limit(2).All() {
+		ranged = append(ranged, n)
+	}
+	assert.Equal(t, []int{5, 3}, ranged)
+}
+
+func TestStream_SinglePassShortCircuit(t *testing.T) {
+	var reads []int
+	source := FromSeq(func(yield func(int) bool) {
+		for i := 1; i <= 100; i++ {
+			reads = append(reads, i)
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	// Each element passes through every stage before the next one is read
+	var order []string
+	first := Map(source.Peek(func(n int) { order = append(order, "peek "+strconv.Itoa(n)) }).
+		Filter(func(n int) bool { return n%3 == 0 }), func(n int) int { return n * 10 }).
+		Limit(2).ToSlice()
+	assert.Equal(t, []int{30, 60}, first)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, reads)
+	assert.Len(t, order, 6)
+
+	reads = nil
+	assert.True(t, source.AnyMatch(func(n int) bool { return n == 4 }))
+	assert.Equal(t, 4, len(reads))
+
+	reads = nil
+	assert.False(t, source.AllMatch(func(n int) bool { return n < 3 }))
+	assert.Equal(t, 3, len(reads))
+
+	reads = nil
+	value, err := source.FindFirst()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *value)
+	assert.Equal(t, 1, len(reads))
+}
+
+func TestStream_TerminalOperations(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{3, 1, 4, 1, 5})
+
+	assert.Equal(t, 14, Of[int](list).Reduce(0, func(a, b int) int { return a + b }))
+	assert.Equal(t, 5, Of[int](list).Count())
+	assert.True(t, Of[int](list).NoneMatch(func(n int) bool { return n > 5 }))
+	assert.True(t, Of[int](list).AllMatch(func(n int) bool { return n > 0 }))
+	assert.True(t, OfSlice[int]().AllMatch(func(n int) bool { return false }))
+
+	minimum, err := Of[int](list).Min(comparators.Natural[int]())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *minimum)
+	maximum, err := Of[int](list).Max(comparators.Natural[int]())
+	assert.NoError(t, err)
+	assert.Equal(t, 5, *maximum)
+
+	_, err = OfSlice[int]().Min(comparators.Natural[int]())
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+	_, err = OfSlice[int]().FindFirst()
+	assert.EqualError(t, err, string(errcodes.NoSuchElementError))
+
+	set := sets.NewHashSet[int]()
+	assert.NoError(t, Of[int](list).CollectTo(set))
+	assert.Equal(t, 4, set.Size())
+	assert.EqualError(t, Of[int](list).CollectTo(nil), string(errcodes.NullPointerError))
+}
+
+func TestStream_SourceModifiedDuringPipeline(t *testing.T) {
+	list := lists.NewArrayListWithInitialCollection([]int{1, 2, 3})
+	stream := Of[int](list)
+	doubled := Map(stream, func(n int) int { return n * 2 })
+
+	result := doubled.Peek(func(n int) {
+		if n == 2 {
+			list.Add(4)
+		}
+	}).ToSlice()
+	assert.Equal(t, []int{2}, result)
+	assert.EqualError(t, doubled.Err(), string(errcodes.ConcurrentModificationError))
+	assert.EqualError(t, stream.Err(), string(errcodes.ConcurrentModificationError))
+
+	_, err := Of[int](list).Peek(func(int) { list.Add(5) }).Max(comparators.Natural[int]())
+	assert.EqualError(t, err, string(errcodes.ConcurrentModificationError))
+
+	// A later run that succeeds clears the error
+	assert.Equal(t, 5, stream.Count())
+	assert.NoError(t, stream.Err())
+}